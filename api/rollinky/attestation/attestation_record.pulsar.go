@@ -0,0 +1,1131 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package attestation
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_AttestationRecord                   protoreflect.MessageDescriptor
+	fd_AttestationRecord_block_height      protoreflect.FieldDescriptor
+	fd_AttestationRecord_report_hash       protoreflect.FieldDescriptor
+	fd_AttestationRecord_signer_id         protoreflect.FieldDescriptor
+	fd_AttestationRecord_product_id        protoreflect.FieldDescriptor
+	fd_AttestationRecord_security_version  protoreflect.FieldDescriptor
+	fd_AttestationRecord_tcb_status        protoreflect.FieldDescriptor
+	fd_AttestationRecord_prices_hash       protoreflect.FieldDescriptor
+	fd_AttestationRecord_config_digest     protoreflect.FieldDescriptor
+	fd_AttestationRecord_market_map_digest protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_rollinky_attestation_attestation_record_proto_init()
+	md_AttestationRecord = File_rollinky_attestation_attestation_record_proto.Messages().ByName("AttestationRecord")
+	fd_AttestationRecord_block_height = md_AttestationRecord.Fields().ByName("block_height")
+	fd_AttestationRecord_report_hash = md_AttestationRecord.Fields().ByName("report_hash")
+	fd_AttestationRecord_signer_id = md_AttestationRecord.Fields().ByName("signer_id")
+	fd_AttestationRecord_product_id = md_AttestationRecord.Fields().ByName("product_id")
+	fd_AttestationRecord_security_version = md_AttestationRecord.Fields().ByName("security_version")
+	fd_AttestationRecord_tcb_status = md_AttestationRecord.Fields().ByName("tcb_status")
+	fd_AttestationRecord_prices_hash = md_AttestationRecord.Fields().ByName("prices_hash")
+	fd_AttestationRecord_config_digest = md_AttestationRecord.Fields().ByName("config_digest")
+	fd_AttestationRecord_market_map_digest = md_AttestationRecord.Fields().ByName("market_map_digest")
+}
+
+var _ protoreflect.Message = (*fastReflection_AttestationRecord)(nil)
+
+type fastReflection_AttestationRecord AttestationRecord
+
+func (x *AttestationRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AttestationRecord)(x)
+}
+
+func (x *AttestationRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_rollinky_attestation_attestation_record_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AttestationRecord_messageType fastReflection_AttestationRecord_messageType
+var _ protoreflect.MessageType = fastReflection_AttestationRecord_messageType{}
+
+type fastReflection_AttestationRecord_messageType struct{}
+
+func (x fastReflection_AttestationRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AttestationRecord)(nil)
+}
+func (x fastReflection_AttestationRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_AttestationRecord)
+}
+func (x fastReflection_AttestationRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AttestationRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AttestationRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_AttestationRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AttestationRecord) Type() protoreflect.MessageType {
+	return _fastReflection_AttestationRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AttestationRecord) New() protoreflect.Message {
+	return new(fastReflection_AttestationRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AttestationRecord) Interface() protoreflect.ProtoMessage {
+	return (*AttestationRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AttestationRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_AttestationRecord_block_height, value) {
+			return
+		}
+	}
+	if x.ReportHash != "" {
+		value := protoreflect.ValueOfString(x.ReportHash)
+		if !f(fd_AttestationRecord_report_hash, value) {
+			return
+		}
+	}
+	if x.SignerId != "" {
+		value := protoreflect.ValueOfString(x.SignerId)
+		if !f(fd_AttestationRecord_signer_id, value) {
+			return
+		}
+	}
+	if x.ProductId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ProductId)
+		if !f(fd_AttestationRecord_product_id, value) {
+			return
+		}
+	}
+	if x.SecurityVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SecurityVersion)
+		if !f(fd_AttestationRecord_security_version, value) {
+			return
+		}
+	}
+	if x.TcbStatus != "" {
+		value := protoreflect.ValueOfString(x.TcbStatus)
+		if !f(fd_AttestationRecord_tcb_status, value) {
+			return
+		}
+	}
+	if x.PricesHash != "" {
+		value := protoreflect.ValueOfString(x.PricesHash)
+		if !f(fd_AttestationRecord_prices_hash, value) {
+			return
+		}
+	}
+	if x.ConfigDigest != "" {
+		value := protoreflect.ValueOfString(x.ConfigDigest)
+		if !f(fd_AttestationRecord_config_digest, value) {
+			return
+		}
+	}
+	if x.MarketMapDigest != "" {
+		value := protoreflect.ValueOfString(x.MarketMapDigest)
+		if !f(fd_AttestationRecord_market_map_digest, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AttestationRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "rollinky.attestation.AttestationRecord.block_height":
+		return x.BlockHeight != uint64(0)
+	case "rollinky.attestation.AttestationRecord.report_hash":
+		return x.ReportHash != ""
+	case "rollinky.attestation.AttestationRecord.signer_id":
+		return x.SignerId != ""
+	case "rollinky.attestation.AttestationRecord.product_id":
+		return x.ProductId != uint32(0)
+	case "rollinky.attestation.AttestationRecord.security_version":
+		return x.SecurityVersion != uint64(0)
+	case "rollinky.attestation.AttestationRecord.tcb_status":
+		return x.TcbStatus != ""
+	case "rollinky.attestation.AttestationRecord.prices_hash":
+		return x.PricesHash != ""
+	case "rollinky.attestation.AttestationRecord.config_digest":
+		return x.ConfigDigest != ""
+	case "rollinky.attestation.AttestationRecord.market_map_digest":
+		return x.MarketMapDigest != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.AttestationRecord"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.AttestationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AttestationRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "rollinky.attestation.AttestationRecord.block_height":
+		x.BlockHeight = uint64(0)
+	case "rollinky.attestation.AttestationRecord.report_hash":
+		x.ReportHash = ""
+	case "rollinky.attestation.AttestationRecord.signer_id":
+		x.SignerId = ""
+	case "rollinky.attestation.AttestationRecord.product_id":
+		x.ProductId = uint32(0)
+	case "rollinky.attestation.AttestationRecord.security_version":
+		x.SecurityVersion = uint64(0)
+	case "rollinky.attestation.AttestationRecord.tcb_status":
+		x.TcbStatus = ""
+	case "rollinky.attestation.AttestationRecord.prices_hash":
+		x.PricesHash = ""
+	case "rollinky.attestation.AttestationRecord.config_digest":
+		x.ConfigDigest = ""
+	case "rollinky.attestation.AttestationRecord.market_map_digest":
+		x.MarketMapDigest = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.AttestationRecord"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.AttestationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AttestationRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "rollinky.attestation.AttestationRecord.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfUint64(value)
+	case "rollinky.attestation.AttestationRecord.report_hash":
+		value := x.ReportHash
+		return protoreflect.ValueOfString(value)
+	case "rollinky.attestation.AttestationRecord.signer_id":
+		value := x.SignerId
+		return protoreflect.ValueOfString(value)
+	case "rollinky.attestation.AttestationRecord.product_id":
+		value := x.ProductId
+		return protoreflect.ValueOfUint32(value)
+	case "rollinky.attestation.AttestationRecord.security_version":
+		value := x.SecurityVersion
+		return protoreflect.ValueOfUint64(value)
+	case "rollinky.attestation.AttestationRecord.tcb_status":
+		value := x.TcbStatus
+		return protoreflect.ValueOfString(value)
+	case "rollinky.attestation.AttestationRecord.prices_hash":
+		value := x.PricesHash
+		return protoreflect.ValueOfString(value)
+	case "rollinky.attestation.AttestationRecord.config_digest":
+		value := x.ConfigDigest
+		return protoreflect.ValueOfString(value)
+	case "rollinky.attestation.AttestationRecord.market_map_digest":
+		value := x.MarketMapDigest
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.AttestationRecord"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.AttestationRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AttestationRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "rollinky.attestation.AttestationRecord.block_height":
+		x.BlockHeight = value.Uint()
+	case "rollinky.attestation.AttestationRecord.report_hash":
+		x.ReportHash = value.Interface().(string)
+	case "rollinky.attestation.AttestationRecord.signer_id":
+		x.SignerId = value.Interface().(string)
+	case "rollinky.attestation.AttestationRecord.product_id":
+		x.ProductId = uint32(value.Uint())
+	case "rollinky.attestation.AttestationRecord.security_version":
+		x.SecurityVersion = value.Uint()
+	case "rollinky.attestation.AttestationRecord.tcb_status":
+		x.TcbStatus = value.Interface().(string)
+	case "rollinky.attestation.AttestationRecord.prices_hash":
+		x.PricesHash = value.Interface().(string)
+	case "rollinky.attestation.AttestationRecord.config_digest":
+		x.ConfigDigest = value.Interface().(string)
+	case "rollinky.attestation.AttestationRecord.market_map_digest":
+		x.MarketMapDigest = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.AttestationRecord"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.AttestationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AttestationRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rollinky.attestation.AttestationRecord.block_height":
+		panic(fmt.Errorf("field block_height of message rollinky.attestation.AttestationRecord is not mutable"))
+	case "rollinky.attestation.AttestationRecord.report_hash":
+		panic(fmt.Errorf("field report_hash of message rollinky.attestation.AttestationRecord is not mutable"))
+	case "rollinky.attestation.AttestationRecord.signer_id":
+		panic(fmt.Errorf("field signer_id of message rollinky.attestation.AttestationRecord is not mutable"))
+	case "rollinky.attestation.AttestationRecord.product_id":
+		panic(fmt.Errorf("field product_id of message rollinky.attestation.AttestationRecord is not mutable"))
+	case "rollinky.attestation.AttestationRecord.security_version":
+		panic(fmt.Errorf("field security_version of message rollinky.attestation.AttestationRecord is not mutable"))
+	case "rollinky.attestation.AttestationRecord.tcb_status":
+		panic(fmt.Errorf("field tcb_status of message rollinky.attestation.AttestationRecord is not mutable"))
+	case "rollinky.attestation.AttestationRecord.prices_hash":
+		panic(fmt.Errorf("field prices_hash of message rollinky.attestation.AttestationRecord is not mutable"))
+	case "rollinky.attestation.AttestationRecord.config_digest":
+		panic(fmt.Errorf("field config_digest of message rollinky.attestation.AttestationRecord is not mutable"))
+	case "rollinky.attestation.AttestationRecord.market_map_digest":
+		panic(fmt.Errorf("field market_map_digest of message rollinky.attestation.AttestationRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.AttestationRecord"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.AttestationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AttestationRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rollinky.attestation.AttestationRecord.block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "rollinky.attestation.AttestationRecord.report_hash":
+		return protoreflect.ValueOfString("")
+	case "rollinky.attestation.AttestationRecord.signer_id":
+		return protoreflect.ValueOfString("")
+	case "rollinky.attestation.AttestationRecord.product_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "rollinky.attestation.AttestationRecord.security_version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "rollinky.attestation.AttestationRecord.tcb_status":
+		return protoreflect.ValueOfString("")
+	case "rollinky.attestation.AttestationRecord.prices_hash":
+		return protoreflect.ValueOfString("")
+	case "rollinky.attestation.AttestationRecord.config_digest":
+		return protoreflect.ValueOfString("")
+	case "rollinky.attestation.AttestationRecord.market_map_digest":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.AttestationRecord"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.AttestationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AttestationRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in rollinky.attestation.AttestationRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AttestationRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AttestationRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AttestationRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AttestationRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AttestationRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		l = len(x.ReportHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SignerId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ProductId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProductId))
+		}
+		if x.SecurityVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.SecurityVersion))
+		}
+		l = len(x.TcbStatus)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PricesHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ConfigDigest)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MarketMapDigest)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AttestationRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MarketMapDigest) > 0 {
+			i -= len(x.MarketMapDigest)
+			copy(dAtA[i:], x.MarketMapDigest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MarketMapDigest)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.ConfigDigest) > 0 {
+			i -= len(x.ConfigDigest)
+			copy(dAtA[i:], x.ConfigDigest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConfigDigest)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.PricesHash) > 0 {
+			i -= len(x.PricesHash)
+			copy(dAtA[i:], x.PricesHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PricesHash)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.TcbStatus) > 0 {
+			i -= len(x.TcbStatus)
+			copy(dAtA[i:], x.TcbStatus)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TcbStatus)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.SecurityVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SecurityVersion))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ProductId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProductId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.SignerId) > 0 {
+			i -= len(x.SignerId)
+			copy(dAtA[i:], x.SignerId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignerId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ReportHash) > 0 {
+			i -= len(x.ReportHash)
+			copy(dAtA[i:], x.ReportHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReportHash)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AttestationRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AttestationRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AttestationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReportHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignerId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignerId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+				}
+				x.ProductId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProductId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SecurityVersion", wireType)
+				}
+				x.SecurityVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SecurityVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TcbStatus", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TcbStatus = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PricesHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PricesHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConfigDigest = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketMapDigest", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MarketMapDigest = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: rollinky/attestation/attestation_record.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AttestationRecord records the enclave report a block's prices were verified
+// against, so that it can later be proven which enclave produced the prices
+// written at a height.
+type AttestationRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// block_height is the height the prices were written at.
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// report_hash is the hex encoded SHA-256 hash of the enclave report.
+	ReportHash string `protobuf:"bytes,2,opt,name=report_hash,json=reportHash,proto3" json:"report_hash,omitempty"`
+	// signer_id is the hex encoded signer ID (MRSIGNER) of the enclave.
+	SignerId string `protobuf:"bytes,3,opt,name=signer_id,json=signerId,proto3" json:"signer_id,omitempty"`
+	// product_id is the product ID of the enclave.
+	ProductId uint32 `protobuf:"varint,4,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// security_version is the security version number (SVN) of the enclave.
+	SecurityVersion uint64 `protobuf:"varint,5,opt,name=security_version,json=securityVersion,proto3" json:"security_version,omitempty"`
+	// tcb_status is the TCB status of the platform the report was produced on.
+	TcbStatus string `protobuf:"bytes,6,opt,name=tcb_status,json=tcbStatus,proto3" json:"tcb_status,omitempty"`
+	// prices_hash is the hex encoded SHA-256 hash of the prices the report
+	// attested, as returned by the sidecar.
+	PricesHash string `protobuf:"bytes,7,opt,name=prices_hash,json=pricesHash,proto3" json:"prices_hash,omitempty"`
+	// config_digest is the hex encoded digest of the sidecar config the report
+	// attested.
+	ConfigDigest string `protobuf:"bytes,8,opt,name=config_digest,json=configDigest,proto3" json:"config_digest,omitempty"`
+	// market_map_digest is the hex encoded SHA-256 digest of the market map the
+	// report attested the prices were aggregated with.
+	MarketMapDigest string `protobuf:"bytes,9,opt,name=market_map_digest,json=marketMapDigest,proto3" json:"market_map_digest,omitempty"`
+}
+
+func (x *AttestationRecord) Reset() {
+	*x = AttestationRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rollinky_attestation_attestation_record_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttestationRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationRecord) ProtoMessage() {}
+
+// Deprecated: Use AttestationRecord.ProtoReflect.Descriptor instead.
+func (*AttestationRecord) Descriptor() ([]byte, []int) {
+	return file_rollinky_attestation_attestation_record_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AttestationRecord) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *AttestationRecord) GetReportHash() string {
+	if x != nil {
+		return x.ReportHash
+	}
+	return ""
+}
+
+func (x *AttestationRecord) GetSignerId() string {
+	if x != nil {
+		return x.SignerId
+	}
+	return ""
+}
+
+func (x *AttestationRecord) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *AttestationRecord) GetSecurityVersion() uint64 {
+	if x != nil {
+		return x.SecurityVersion
+	}
+	return 0
+}
+
+func (x *AttestationRecord) GetTcbStatus() string {
+	if x != nil {
+		return x.TcbStatus
+	}
+	return ""
+}
+
+func (x *AttestationRecord) GetPricesHash() string {
+	if x != nil {
+		return x.PricesHash
+	}
+	return ""
+}
+
+func (x *AttestationRecord) GetConfigDigest() string {
+	if x != nil {
+		return x.ConfigDigest
+	}
+	return ""
+}
+
+func (x *AttestationRecord) GetMarketMapDigest() string {
+	if x != nil {
+		return x.MarketMapDigest
+	}
+	return ""
+}
+
+var File_rollinky_attestation_attestation_record_proto protoreflect.FileDescriptor
+
+var file_rollinky_attestation_attestation_record_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x14, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xcf, 0x02, 0x0a, 0x11, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x63, 0x62, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x63, 0x62, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61,
+	0x70, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x42, 0xca, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x16, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x25,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0xa2, 0x02, 0x03, 0x52, 0x41, 0x58, 0xaa, 0x02, 0x14, 0x52, 0x6f,
+	0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0xca, 0x02, 0x14, 0x52, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0xe2, 0x02, 0x20, 0x52, 0x6f, 0x6c, 0x6c,
+	0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x52,
+	0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x3a, 0x3a, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rollinky_attestation_attestation_record_proto_rawDescOnce sync.Once
+	file_rollinky_attestation_attestation_record_proto_rawDescData = file_rollinky_attestation_attestation_record_proto_rawDesc
+)
+
+func file_rollinky_attestation_attestation_record_proto_rawDescGZIP() []byte {
+	file_rollinky_attestation_attestation_record_proto_rawDescOnce.Do(func() {
+		file_rollinky_attestation_attestation_record_proto_rawDescData = protoimpl.X.CompressGZIP(file_rollinky_attestation_attestation_record_proto_rawDescData)
+	})
+	return file_rollinky_attestation_attestation_record_proto_rawDescData
+}
+
+var file_rollinky_attestation_attestation_record_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rollinky_attestation_attestation_record_proto_goTypes = []interface{}{
+	(*AttestationRecord)(nil), // 0: rollinky.attestation.AttestationRecord
+}
+var file_rollinky_attestation_attestation_record_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rollinky_attestation_attestation_record_proto_init() }
+func file_rollinky_attestation_attestation_record_proto_init() {
+	if File_rollinky_attestation_attestation_record_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rollinky_attestation_attestation_record_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttestationRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rollinky_attestation_attestation_record_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rollinky_attestation_attestation_record_proto_goTypes,
+		DependencyIndexes: file_rollinky_attestation_attestation_record_proto_depIdxs,
+		MessageInfos:      file_rollinky_attestation_attestation_record_proto_msgTypes,
+	}.Build()
+	File_rollinky_attestation_attestation_record_proto = out.File
+	file_rollinky_attestation_attestation_record_proto_rawDesc = nil
+	file_rollinky_attestation_attestation_record_proto_goTypes = nil
+	file_rollinky_attestation_attestation_record_proto_depIdxs = nil
+}
@@ -0,0 +1,1340 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package attestation
+
+import (
+	_ "cosmossdk.io/api/amino"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_Params_1_list)(nil)
+
+type _Params_1_list struct {
+	list *[]string
+}
+
+func (x *_Params_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field ApprovedConfigDigests as it is not of Message kind"))
+}
+
+func (x *_Params_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_7_list)(nil)
+
+type _Params_7_list struct {
+	list *[]string
+}
+
+func (x *_Params_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedTcbStatuses as it is not of Message kind"))
+}
+
+func (x *_Params_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                              protoreflect.MessageDescriptor
+	fd_Params_approved_config_digests      protoreflect.FieldDescriptor
+	fd_Params_require_approved_config      protoreflect.FieldDescriptor
+	fd_Params_max_price_failure_ratio      protoreflect.FieldDescriptor
+	fd_Params_attestation_record_retention protoreflect.FieldDescriptor
+	fd_Params_sequencer_public_key         protoreflect.FieldDescriptor
+	fd_Params_max_provider_price_age       protoreflect.FieldDescriptor
+	fd_Params_allowed_tcb_statuses         protoreflect.FieldDescriptor
+	fd_Params_max_report_age               protoreflect.FieldDescriptor
+	fd_Params_fail_missing_prices          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_rollinky_attestation_params_proto_init()
+	md_Params = File_rollinky_attestation_params_proto.Messages().ByName("Params")
+	fd_Params_approved_config_digests = md_Params.Fields().ByName("approved_config_digests")
+	fd_Params_require_approved_config = md_Params.Fields().ByName("require_approved_config")
+	fd_Params_max_price_failure_ratio = md_Params.Fields().ByName("max_price_failure_ratio")
+	fd_Params_attestation_record_retention = md_Params.Fields().ByName("attestation_record_retention")
+	fd_Params_sequencer_public_key = md_Params.Fields().ByName("sequencer_public_key")
+	fd_Params_max_provider_price_age = md_Params.Fields().ByName("max_provider_price_age")
+	fd_Params_allowed_tcb_statuses = md_Params.Fields().ByName("allowed_tcb_statuses")
+	fd_Params_max_report_age = md_Params.Fields().ByName("max_report_age")
+	fd_Params_fail_missing_prices = md_Params.Fields().ByName("fail_missing_prices")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_rollinky_attestation_params_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ApprovedConfigDigests) != 0 {
+		value := protoreflect.ValueOfList(&_Params_1_list{list: &x.ApprovedConfigDigests})
+		if !f(fd_Params_approved_config_digests, value) {
+			return
+		}
+	}
+	if x.RequireApprovedConfig != false {
+		value := protoreflect.ValueOfBool(x.RequireApprovedConfig)
+		if !f(fd_Params_require_approved_config, value) {
+			return
+		}
+	}
+	if x.MaxPriceFailureRatio != "" {
+		value := protoreflect.ValueOfString(x.MaxPriceFailureRatio)
+		if !f(fd_Params_max_price_failure_ratio, value) {
+			return
+		}
+	}
+	if x.AttestationRecordRetention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AttestationRecordRetention)
+		if !f(fd_Params_attestation_record_retention, value) {
+			return
+		}
+	}
+	if x.SequencerPublicKey != "" {
+		value := protoreflect.ValueOfString(x.SequencerPublicKey)
+		if !f(fd_Params_sequencer_public_key, value) {
+			return
+		}
+	}
+	if x.MaxProviderPriceAge != nil {
+		value := protoreflect.ValueOfMessage(x.MaxProviderPriceAge.ProtoReflect())
+		if !f(fd_Params_max_provider_price_age, value) {
+			return
+		}
+	}
+	if len(x.AllowedTcbStatuses) != 0 {
+		value := protoreflect.ValueOfList(&_Params_7_list{list: &x.AllowedTcbStatuses})
+		if !f(fd_Params_allowed_tcb_statuses, value) {
+			return
+		}
+	}
+	if x.MaxReportAge != nil {
+		value := protoreflect.ValueOfMessage(x.MaxReportAge.ProtoReflect())
+		if !f(fd_Params_max_report_age, value) {
+			return
+		}
+	}
+	if x.FailMissingPrices != false {
+		value := protoreflect.ValueOfBool(x.FailMissingPrices)
+		if !f(fd_Params_fail_missing_prices, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "rollinky.attestation.Params.approved_config_digests":
+		return len(x.ApprovedConfigDigests) != 0
+	case "rollinky.attestation.Params.require_approved_config":
+		return x.RequireApprovedConfig != false
+	case "rollinky.attestation.Params.max_price_failure_ratio":
+		return x.MaxPriceFailureRatio != ""
+	case "rollinky.attestation.Params.attestation_record_retention":
+		return x.AttestationRecordRetention != uint64(0)
+	case "rollinky.attestation.Params.sequencer_public_key":
+		return x.SequencerPublicKey != ""
+	case "rollinky.attestation.Params.max_provider_price_age":
+		return x.MaxProviderPriceAge != nil
+	case "rollinky.attestation.Params.allowed_tcb_statuses":
+		return len(x.AllowedTcbStatuses) != 0
+	case "rollinky.attestation.Params.max_report_age":
+		return x.MaxReportAge != nil
+	case "rollinky.attestation.Params.fail_missing_prices":
+		return x.FailMissingPrices != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.Params"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "rollinky.attestation.Params.approved_config_digests":
+		x.ApprovedConfigDigests = nil
+	case "rollinky.attestation.Params.require_approved_config":
+		x.RequireApprovedConfig = false
+	case "rollinky.attestation.Params.max_price_failure_ratio":
+		x.MaxPriceFailureRatio = ""
+	case "rollinky.attestation.Params.attestation_record_retention":
+		x.AttestationRecordRetention = uint64(0)
+	case "rollinky.attestation.Params.sequencer_public_key":
+		x.SequencerPublicKey = ""
+	case "rollinky.attestation.Params.max_provider_price_age":
+		x.MaxProviderPriceAge = nil
+	case "rollinky.attestation.Params.allowed_tcb_statuses":
+		x.AllowedTcbStatuses = nil
+	case "rollinky.attestation.Params.max_report_age":
+		x.MaxReportAge = nil
+	case "rollinky.attestation.Params.fail_missing_prices":
+		x.FailMissingPrices = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.Params"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "rollinky.attestation.Params.approved_config_digests":
+		if len(x.ApprovedConfigDigests) == 0 {
+			return protoreflect.ValueOfList(&_Params_1_list{})
+		}
+		listValue := &_Params_1_list{list: &x.ApprovedConfigDigests}
+		return protoreflect.ValueOfList(listValue)
+	case "rollinky.attestation.Params.require_approved_config":
+		value := x.RequireApprovedConfig
+		return protoreflect.ValueOfBool(value)
+	case "rollinky.attestation.Params.max_price_failure_ratio":
+		value := x.MaxPriceFailureRatio
+		return protoreflect.ValueOfString(value)
+	case "rollinky.attestation.Params.attestation_record_retention":
+		value := x.AttestationRecordRetention
+		return protoreflect.ValueOfUint64(value)
+	case "rollinky.attestation.Params.sequencer_public_key":
+		value := x.SequencerPublicKey
+		return protoreflect.ValueOfString(value)
+	case "rollinky.attestation.Params.max_provider_price_age":
+		value := x.MaxProviderPriceAge
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "rollinky.attestation.Params.allowed_tcb_statuses":
+		if len(x.AllowedTcbStatuses) == 0 {
+			return protoreflect.ValueOfList(&_Params_7_list{})
+		}
+		listValue := &_Params_7_list{list: &x.AllowedTcbStatuses}
+		return protoreflect.ValueOfList(listValue)
+	case "rollinky.attestation.Params.max_report_age":
+		value := x.MaxReportAge
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "rollinky.attestation.Params.fail_missing_prices":
+		value := x.FailMissingPrices
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.Params"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "rollinky.attestation.Params.approved_config_digests":
+		lv := value.List()
+		clv := lv.(*_Params_1_list)
+		x.ApprovedConfigDigests = *clv.list
+	case "rollinky.attestation.Params.require_approved_config":
+		x.RequireApprovedConfig = value.Bool()
+	case "rollinky.attestation.Params.max_price_failure_ratio":
+		x.MaxPriceFailureRatio = value.Interface().(string)
+	case "rollinky.attestation.Params.attestation_record_retention":
+		x.AttestationRecordRetention = value.Uint()
+	case "rollinky.attestation.Params.sequencer_public_key":
+		x.SequencerPublicKey = value.Interface().(string)
+	case "rollinky.attestation.Params.max_provider_price_age":
+		x.MaxProviderPriceAge = value.Message().Interface().(*durationpb.Duration)
+	case "rollinky.attestation.Params.allowed_tcb_statuses":
+		lv := value.List()
+		clv := lv.(*_Params_7_list)
+		x.AllowedTcbStatuses = *clv.list
+	case "rollinky.attestation.Params.max_report_age":
+		x.MaxReportAge = value.Message().Interface().(*durationpb.Duration)
+	case "rollinky.attestation.Params.fail_missing_prices":
+		x.FailMissingPrices = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.Params"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rollinky.attestation.Params.approved_config_digests":
+		if x.ApprovedConfigDigests == nil {
+			x.ApprovedConfigDigests = []string{}
+		}
+		value := &_Params_1_list{list: &x.ApprovedConfigDigests}
+		return protoreflect.ValueOfList(value)
+	case "rollinky.attestation.Params.max_provider_price_age":
+		if x.MaxProviderPriceAge == nil {
+			x.MaxProviderPriceAge = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxProviderPriceAge.ProtoReflect())
+	case "rollinky.attestation.Params.allowed_tcb_statuses":
+		if x.AllowedTcbStatuses == nil {
+			x.AllowedTcbStatuses = []string{}
+		}
+		value := &_Params_7_list{list: &x.AllowedTcbStatuses}
+		return protoreflect.ValueOfList(value)
+	case "rollinky.attestation.Params.max_report_age":
+		if x.MaxReportAge == nil {
+			x.MaxReportAge = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxReportAge.ProtoReflect())
+	case "rollinky.attestation.Params.require_approved_config":
+		panic(fmt.Errorf("field require_approved_config of message rollinky.attestation.Params is not mutable"))
+	case "rollinky.attestation.Params.max_price_failure_ratio":
+		panic(fmt.Errorf("field max_price_failure_ratio of message rollinky.attestation.Params is not mutable"))
+	case "rollinky.attestation.Params.attestation_record_retention":
+		panic(fmt.Errorf("field attestation_record_retention of message rollinky.attestation.Params is not mutable"))
+	case "rollinky.attestation.Params.sequencer_public_key":
+		panic(fmt.Errorf("field sequencer_public_key of message rollinky.attestation.Params is not mutable"))
+	case "rollinky.attestation.Params.fail_missing_prices":
+		panic(fmt.Errorf("field fail_missing_prices of message rollinky.attestation.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.Params"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rollinky.attestation.Params.approved_config_digests":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
+	case "rollinky.attestation.Params.require_approved_config":
+		return protoreflect.ValueOfBool(false)
+	case "rollinky.attestation.Params.max_price_failure_ratio":
+		return protoreflect.ValueOfString("")
+	case "rollinky.attestation.Params.attestation_record_retention":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "rollinky.attestation.Params.sequencer_public_key":
+		return protoreflect.ValueOfString("")
+	case "rollinky.attestation.Params.max_provider_price_age":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "rollinky.attestation.Params.allowed_tcb_statuses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_7_list{list: &list})
+	case "rollinky.attestation.Params.max_report_age":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "rollinky.attestation.Params.fail_missing_prices":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.Params"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in rollinky.attestation.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.ApprovedConfigDigests) > 0 {
+			for _, s := range x.ApprovedConfigDigests {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RequireApprovedConfig {
+			n += 2
+		}
+		l = len(x.MaxPriceFailureRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AttestationRecordRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.AttestationRecordRetention))
+		}
+		l = len(x.SequencerPublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxProviderPriceAge != nil {
+			l = options.Size(x.MaxProviderPriceAge)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AllowedTcbStatuses) > 0 {
+			for _, s := range x.AllowedTcbStatuses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxReportAge != nil {
+			l = options.Size(x.MaxReportAge)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FailMissingPrices {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FailMissingPrices {
+			i--
+			if x.FailMissingPrices {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.MaxReportAge != nil {
+			encoded, err := options.Marshal(x.MaxReportAge)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.AllowedTcbStatuses) > 0 {
+			for iNdEx := len(x.AllowedTcbStatuses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedTcbStatuses[iNdEx])
+				copy(dAtA[i:], x.AllowedTcbStatuses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedTcbStatuses[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.MaxProviderPriceAge != nil {
+			encoded, err := options.Marshal(x.MaxProviderPriceAge)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.SequencerPublicKey) > 0 {
+			i -= len(x.SequencerPublicKey)
+			copy(dAtA[i:], x.SequencerPublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SequencerPublicKey)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.AttestationRecordRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AttestationRecordRetention))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.MaxPriceFailureRatio) > 0 {
+			i -= len(x.MaxPriceFailureRatio)
+			copy(dAtA[i:], x.MaxPriceFailureRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPriceFailureRatio)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.RequireApprovedConfig {
+			i--
+			if x.RequireApprovedConfig {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ApprovedConfigDigests) > 0 {
+			for iNdEx := len(x.ApprovedConfigDigests) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ApprovedConfigDigests[iNdEx])
+				copy(dAtA[i:], x.ApprovedConfigDigests[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ApprovedConfigDigests[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApprovedConfigDigests", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ApprovedConfigDigests = append(x.ApprovedConfigDigests, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequireApprovedConfig", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RequireApprovedConfig = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceFailureRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPriceFailureRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AttestationRecordRetention", wireType)
+				}
+				x.AttestationRecordRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AttestationRecordRetention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SequencerPublicKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SequencerPublicKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxProviderPriceAge", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxProviderPriceAge == nil {
+					x.MaxProviderPriceAge = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxProviderPriceAge); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedTcbStatuses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedTcbStatuses = append(x.AllowedTcbStatuses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxReportAge", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxReportAge == nil {
+					x.MaxReportAge = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxReportAge); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailMissingPrices", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.FailMissingPrices = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: rollinky/attestation/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the parameters for the module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// approved_config_digests are the hex encoded SHA-256 digests of the sidecar
+	// oracle configs the chain accepts prices from. The market map isn't part of
+	// the digest, it is attested with every report instead.
+	ApprovedConfigDigests []string `protobuf:"bytes,1,rep,name=approved_config_digests,json=approvedConfigDigests,proto3" json:"approved_config_digests,omitempty"`
+	// require_approved_config, if set, rejects oracle envelopes whose attested
+	// config digest is not in approved_config_digests.
+	RequireApprovedConfig bool `protobuf:"varint,2,opt,name=require_approved_config,json=requireApprovedConfig,proto3" json:"require_approved_config,omitempty"`
+	// max_price_failure_ratio is the highest share of the prices carried by a
+	// block that may fail validation (malformed currency pairs or prices, or
+	// prices breaking their market's rules). If more fail, none of the block's
+	// prices are written.
+	MaxPriceFailureRatio string `protobuf:"bytes,3,opt,name=max_price_failure_ratio,json=maxPriceFailureRatio,proto3" json:"max_price_failure_ratio,omitempty"`
+	// attestation_record_retention is the number of most recent heights whose
+	// attestation records are kept, older records are pruned. Zero keeps all.
+	AttestationRecordRetention uint64 `protobuf:"varint,4,opt,name=attestation_record_retention,json=attestationRecordRetention,proto3" json:"attestation_record_retention,omitempty"`
+	// sequencer_public_key is the hex encoded ed25519 public key of the
+	// sequencer. If set, oracle envelopes must be signed by it for a height
+	// above the last one accepted and not above the block's. If empty,
+	// envelopes are accepted from any sequencer.
+	SequencerPublicKey string `protobuf:"bytes,5,opt,name=sequencer_public_key,json=sequencerPublicKey,proto3" json:"sequencer_public_key,omitempty"`
+	// max_provider_price_age is how long before the block time a provider price
+	// attested by the sidecar may have been received. Older provider prices are
+	// dropped before the median is taken. Zero accepts provider prices of any
+	// age.
+	MaxProviderPriceAge *durationpb.Duration `protobuf:"bytes,6,opt,name=max_provider_price_age,json=maxProviderPriceAge,proto3" json:"max_provider_price_age,omitempty"`
+	// allowed_tcb_statuses are the TCB statuses an enclave report's platform may
+	// have: "UpToDate", "OutOfDate", "Revoked", "ConfigurationNeeded",
+	// "OutOfDateConfigurationNeeded", "SWHardeningNeeded",
+	// "ConfigurationAndSWHardeningNeeded" or "Unknown". At least one must be
+	// allowed.
+	AllowedTcbStatuses []string `protobuf:"bytes,7,rep,name=allowed_tcb_statuses,json=allowedTcbStatuses,proto3" json:"allowed_tcb_statuses,omitempty"`
+	// max_report_age is how far from the block time the enclave report carried
+	// in a block may have been created.
+	MaxReportAge *durationpb.Duration `protobuf:"bytes,8,opt,name=max_report_age,json=maxReportAge,proto3" json:"max_report_age,omitempty"`
+	// fail_missing_prices, if set, counts an enabled market without a price in
+	// the block as a failure towards max_price_failure_ratio. Otherwise the
+	// pair is skipped and the other prices are written.
+	FailMissingPrices bool `protobuf:"varint,9,opt,name=fail_missing_prices,json=failMissingPrices,proto3" json:"fail_missing_prices,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rollinky_attestation_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_rollinky_attestation_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetApprovedConfigDigests() []string {
+	if x != nil {
+		return x.ApprovedConfigDigests
+	}
+	return nil
+}
+
+func (x *Params) GetRequireApprovedConfig() bool {
+	if x != nil {
+		return x.RequireApprovedConfig
+	}
+	return false
+}
+
+func (x *Params) GetMaxPriceFailureRatio() string {
+	if x != nil {
+		return x.MaxPriceFailureRatio
+	}
+	return ""
+}
+
+func (x *Params) GetAttestationRecordRetention() uint64 {
+	if x != nil {
+		return x.AttestationRecordRetention
+	}
+	return 0
+}
+
+func (x *Params) GetSequencerPublicKey() string {
+	if x != nil {
+		return x.SequencerPublicKey
+	}
+	return ""
+}
+
+func (x *Params) GetMaxProviderPriceAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxProviderPriceAge
+	}
+	return nil
+}
+
+func (x *Params) GetAllowedTcbStatuses() []string {
+	if x != nil {
+		return x.AllowedTcbStatuses
+	}
+	return nil
+}
+
+func (x *Params) GetMaxReportAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxReportAge
+	}
+	return nil
+}
+
+func (x *Params) GetFailMissingPrices() bool {
+	if x != nil {
+		return x.FailMissingPrices
+	}
+	return false
+}
+
+var File_rollinky_attestation_params_proto protoreflect.FileDescriptor
+
+var file_rollinky_attestation_params_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x14, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x61, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
+	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8a, 0x05,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x64, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x6d, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x40, 0x0a, 0x1c, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1a, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x58, 0x0a, 0x16, 0x6d,
+	0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01,
+	0x52, 0x13, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x41, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x74, 0x63, 0x62, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x54, 0x63, 0x62, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00,
+	0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x41,
+	0x67, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x11, 0x66, 0x61, 0x69, 0x6c, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x3a, 0x26, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1d, 0x72, 0x6f, 0x6c,
+	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x78, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xbf, 0x01, 0x0a, 0x18, 0x63,
+	0x6f, 0x6d, 0x2e, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x61, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x25, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b,
+	0x79, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0xa2, 0x02, 0x03,
+	0x52, 0x41, 0x58, 0xaa, 0x02, 0x14, 0x52, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0xca, 0x02, 0x14, 0x52, 0x6f, 0x6c,
+	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0xe2, 0x02, 0x20, 0x52, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x41, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x52, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x3a,
+	0x3a, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rollinky_attestation_params_proto_rawDescOnce sync.Once
+	file_rollinky_attestation_params_proto_rawDescData = file_rollinky_attestation_params_proto_rawDesc
+)
+
+func file_rollinky_attestation_params_proto_rawDescGZIP() []byte {
+	file_rollinky_attestation_params_proto_rawDescOnce.Do(func() {
+		file_rollinky_attestation_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_rollinky_attestation_params_proto_rawDescData)
+	})
+	return file_rollinky_attestation_params_proto_rawDescData
+}
+
+var file_rollinky_attestation_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rollinky_attestation_params_proto_goTypes = []interface{}{
+	(*Params)(nil),              // 0: rollinky.attestation.Params
+	(*durationpb.Duration)(nil), // 1: google.protobuf.Duration
+}
+var file_rollinky_attestation_params_proto_depIdxs = []int32{
+	1, // 0: rollinky.attestation.Params.max_provider_price_age:type_name -> google.protobuf.Duration
+	1, // 1: rollinky.attestation.Params.max_report_age:type_name -> google.protobuf.Duration
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rollinky_attestation_params_proto_init() }
+func file_rollinky_attestation_params_proto_init() {
+	if File_rollinky_attestation_params_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rollinky_attestation_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rollinky_attestation_params_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rollinky_attestation_params_proto_goTypes,
+		DependencyIndexes: file_rollinky_attestation_params_proto_depIdxs,
+		MessageInfos:      file_rollinky_attestation_params_proto_msgTypes,
+	}.Build()
+	File_rollinky_attestation_params_proto = out.File
+	file_rollinky_attestation_params_proto_rawDesc = nil
+	file_rollinky_attestation_params_proto_goTypes = nil
+	file_rollinky_attestation_params_proto_depIdxs = nil
+}
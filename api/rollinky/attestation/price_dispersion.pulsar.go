@@ -0,0 +1,978 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package attestation
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_PriceDispersion                protoreflect.MessageDescriptor
+	fd_PriceDispersion_currency_pair  protoreflect.FieldDescriptor
+	fd_PriceDispersion_block_height   protoreflect.FieldDescriptor
+	fd_PriceDispersion_provider_count protoreflect.FieldDescriptor
+	fd_PriceDispersion_min_price      protoreflect.FieldDescriptor
+	fd_PriceDispersion_max_price      protoreflect.FieldDescriptor
+	fd_PriceDispersion_median_price   protoreflect.FieldDescriptor
+	fd_PriceDispersion_spread_bps     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_rollinky_attestation_price_dispersion_proto_init()
+	md_PriceDispersion = File_rollinky_attestation_price_dispersion_proto.Messages().ByName("PriceDispersion")
+	fd_PriceDispersion_currency_pair = md_PriceDispersion.Fields().ByName("currency_pair")
+	fd_PriceDispersion_block_height = md_PriceDispersion.Fields().ByName("block_height")
+	fd_PriceDispersion_provider_count = md_PriceDispersion.Fields().ByName("provider_count")
+	fd_PriceDispersion_min_price = md_PriceDispersion.Fields().ByName("min_price")
+	fd_PriceDispersion_max_price = md_PriceDispersion.Fields().ByName("max_price")
+	fd_PriceDispersion_median_price = md_PriceDispersion.Fields().ByName("median_price")
+	fd_PriceDispersion_spread_bps = md_PriceDispersion.Fields().ByName("spread_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceDispersion)(nil)
+
+type fastReflection_PriceDispersion PriceDispersion
+
+func (x *PriceDispersion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceDispersion)(x)
+}
+
+func (x *PriceDispersion) slowProtoReflect() protoreflect.Message {
+	mi := &file_rollinky_attestation_price_dispersion_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceDispersion_messageType fastReflection_PriceDispersion_messageType
+var _ protoreflect.MessageType = fastReflection_PriceDispersion_messageType{}
+
+type fastReflection_PriceDispersion_messageType struct{}
+
+func (x fastReflection_PriceDispersion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceDispersion)(nil)
+}
+func (x fastReflection_PriceDispersion_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceDispersion)
+}
+func (x fastReflection_PriceDispersion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceDispersion
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceDispersion) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceDispersion
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceDispersion) Type() protoreflect.MessageType {
+	return _fastReflection_PriceDispersion_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceDispersion) New() protoreflect.Message {
+	return new(fastReflection_PriceDispersion)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceDispersion) Interface() protoreflect.ProtoMessage {
+	return (*PriceDispersion)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceDispersion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CurrencyPair != "" {
+		value := protoreflect.ValueOfString(x.CurrencyPair)
+		if !f(fd_PriceDispersion_currency_pair, value) {
+			return
+		}
+	}
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_PriceDispersion_block_height, value) {
+			return
+		}
+	}
+	if x.ProviderCount != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ProviderCount)
+		if !f(fd_PriceDispersion_provider_count, value) {
+			return
+		}
+	}
+	if x.MinPrice != "" {
+		value := protoreflect.ValueOfString(x.MinPrice)
+		if !f(fd_PriceDispersion_min_price, value) {
+			return
+		}
+	}
+	if x.MaxPrice != "" {
+		value := protoreflect.ValueOfString(x.MaxPrice)
+		if !f(fd_PriceDispersion_max_price, value) {
+			return
+		}
+	}
+	if x.MedianPrice != "" {
+		value := protoreflect.ValueOfString(x.MedianPrice)
+		if !f(fd_PriceDispersion_median_price, value) {
+			return
+		}
+	}
+	if x.SpreadBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SpreadBps)
+		if !f(fd_PriceDispersion_spread_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceDispersion) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "rollinky.attestation.PriceDispersion.currency_pair":
+		return x.CurrencyPair != ""
+	case "rollinky.attestation.PriceDispersion.block_height":
+		return x.BlockHeight != uint64(0)
+	case "rollinky.attestation.PriceDispersion.provider_count":
+		return x.ProviderCount != uint32(0)
+	case "rollinky.attestation.PriceDispersion.min_price":
+		return x.MinPrice != ""
+	case "rollinky.attestation.PriceDispersion.max_price":
+		return x.MaxPrice != ""
+	case "rollinky.attestation.PriceDispersion.median_price":
+		return x.MedianPrice != ""
+	case "rollinky.attestation.PriceDispersion.spread_bps":
+		return x.SpreadBps != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.PriceDispersion"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.PriceDispersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceDispersion) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "rollinky.attestation.PriceDispersion.currency_pair":
+		x.CurrencyPair = ""
+	case "rollinky.attestation.PriceDispersion.block_height":
+		x.BlockHeight = uint64(0)
+	case "rollinky.attestation.PriceDispersion.provider_count":
+		x.ProviderCount = uint32(0)
+	case "rollinky.attestation.PriceDispersion.min_price":
+		x.MinPrice = ""
+	case "rollinky.attestation.PriceDispersion.max_price":
+		x.MaxPrice = ""
+	case "rollinky.attestation.PriceDispersion.median_price":
+		x.MedianPrice = ""
+	case "rollinky.attestation.PriceDispersion.spread_bps":
+		x.SpreadBps = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.PriceDispersion"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.PriceDispersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceDispersion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "rollinky.attestation.PriceDispersion.currency_pair":
+		value := x.CurrencyPair
+		return protoreflect.ValueOfString(value)
+	case "rollinky.attestation.PriceDispersion.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfUint64(value)
+	case "rollinky.attestation.PriceDispersion.provider_count":
+		value := x.ProviderCount
+		return protoreflect.ValueOfUint32(value)
+	case "rollinky.attestation.PriceDispersion.min_price":
+		value := x.MinPrice
+		return protoreflect.ValueOfString(value)
+	case "rollinky.attestation.PriceDispersion.max_price":
+		value := x.MaxPrice
+		return protoreflect.ValueOfString(value)
+	case "rollinky.attestation.PriceDispersion.median_price":
+		value := x.MedianPrice
+		return protoreflect.ValueOfString(value)
+	case "rollinky.attestation.PriceDispersion.spread_bps":
+		value := x.SpreadBps
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.PriceDispersion"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.PriceDispersion does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceDispersion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "rollinky.attestation.PriceDispersion.currency_pair":
+		x.CurrencyPair = value.Interface().(string)
+	case "rollinky.attestation.PriceDispersion.block_height":
+		x.BlockHeight = value.Uint()
+	case "rollinky.attestation.PriceDispersion.provider_count":
+		x.ProviderCount = uint32(value.Uint())
+	case "rollinky.attestation.PriceDispersion.min_price":
+		x.MinPrice = value.Interface().(string)
+	case "rollinky.attestation.PriceDispersion.max_price":
+		x.MaxPrice = value.Interface().(string)
+	case "rollinky.attestation.PriceDispersion.median_price":
+		x.MedianPrice = value.Interface().(string)
+	case "rollinky.attestation.PriceDispersion.spread_bps":
+		x.SpreadBps = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.PriceDispersion"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.PriceDispersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceDispersion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rollinky.attestation.PriceDispersion.currency_pair":
+		panic(fmt.Errorf("field currency_pair of message rollinky.attestation.PriceDispersion is not mutable"))
+	case "rollinky.attestation.PriceDispersion.block_height":
+		panic(fmt.Errorf("field block_height of message rollinky.attestation.PriceDispersion is not mutable"))
+	case "rollinky.attestation.PriceDispersion.provider_count":
+		panic(fmt.Errorf("field provider_count of message rollinky.attestation.PriceDispersion is not mutable"))
+	case "rollinky.attestation.PriceDispersion.min_price":
+		panic(fmt.Errorf("field min_price of message rollinky.attestation.PriceDispersion is not mutable"))
+	case "rollinky.attestation.PriceDispersion.max_price":
+		panic(fmt.Errorf("field max_price of message rollinky.attestation.PriceDispersion is not mutable"))
+	case "rollinky.attestation.PriceDispersion.median_price":
+		panic(fmt.Errorf("field median_price of message rollinky.attestation.PriceDispersion is not mutable"))
+	case "rollinky.attestation.PriceDispersion.spread_bps":
+		panic(fmt.Errorf("field spread_bps of message rollinky.attestation.PriceDispersion is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.PriceDispersion"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.PriceDispersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceDispersion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rollinky.attestation.PriceDispersion.currency_pair":
+		return protoreflect.ValueOfString("")
+	case "rollinky.attestation.PriceDispersion.block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "rollinky.attestation.PriceDispersion.provider_count":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "rollinky.attestation.PriceDispersion.min_price":
+		return protoreflect.ValueOfString("")
+	case "rollinky.attestation.PriceDispersion.max_price":
+		return protoreflect.ValueOfString("")
+	case "rollinky.attestation.PriceDispersion.median_price":
+		return protoreflect.ValueOfString("")
+	case "rollinky.attestation.PriceDispersion.spread_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.attestation.PriceDispersion"))
+		}
+		panic(fmt.Errorf("message rollinky.attestation.PriceDispersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceDispersion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in rollinky.attestation.PriceDispersion", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceDispersion) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceDispersion) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceDispersion) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceDispersion) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceDispersion)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.CurrencyPair)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.ProviderCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProviderCount))
+		}
+		l = len(x.MinPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MedianPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SpreadBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.SpreadBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceDispersion)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SpreadBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SpreadBps))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.MedianPrice) > 0 {
+			i -= len(x.MedianPrice)
+			copy(dAtA[i:], x.MedianPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MedianPrice)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.MaxPrice) > 0 {
+			i -= len(x.MaxPrice)
+			copy(dAtA[i:], x.MaxPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPrice)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.MinPrice) > 0 {
+			i -= len(x.MinPrice)
+			copy(dAtA[i:], x.MinPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinPrice)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ProviderCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProviderCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.CurrencyPair) > 0 {
+			i -= len(x.CurrencyPair)
+			copy(dAtA[i:], x.CurrencyPair)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CurrencyPair)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceDispersion)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceDispersion: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceDispersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CurrencyPair = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderCount", wireType)
+				}
+				x.ProviderCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProviderCount |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MedianPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MedianPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpreadBps", wireType)
+				}
+				x.SpreadBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SpreadBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: rollinky/attestation/price_dispersion.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PriceDispersion records how far apart the attested provider prices were
+// when a currency pair's price was last recomputed from them. It serves as a
+// confidence metric for the price.
+type PriceDispersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// currency_pair is the currency pair, e.g. "BTC/USD".
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// block_height is the height at which the price was recomputed.
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// provider_count is the number of provider prices the price was computed from.
+	ProviderCount uint32 `protobuf:"varint,3,opt,name=provider_count,json=providerCount,proto3" json:"provider_count,omitempty"`
+	// min_price is the lowest provider price.
+	MinPrice string `protobuf:"bytes,4,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	// max_price is the highest provider price.
+	MaxPrice string `protobuf:"bytes,5,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	// median_price is the median of the provider prices, the price written to
+	// the oracle module.
+	MedianPrice string `protobuf:"bytes,6,opt,name=median_price,json=medianPrice,proto3" json:"median_price,omitempty"`
+	// spread_bps is (max_price - min_price) / median_price, in basis points.
+	SpreadBps uint64 `protobuf:"varint,7,opt,name=spread_bps,json=spreadBps,proto3" json:"spread_bps,omitempty"`
+}
+
+func (x *PriceDispersion) Reset() {
+	*x = PriceDispersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rollinky_attestation_price_dispersion_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceDispersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceDispersion) ProtoMessage() {}
+
+// Deprecated: Use PriceDispersion.ProtoReflect.Descriptor instead.
+func (*PriceDispersion) Descriptor() ([]byte, []int) {
+	return file_rollinky_attestation_price_dispersion_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PriceDispersion) GetCurrencyPair() string {
+	if x != nil {
+		return x.CurrencyPair
+	}
+	return ""
+}
+
+func (x *PriceDispersion) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *PriceDispersion) GetProviderCount() uint32 {
+	if x != nil {
+		return x.ProviderCount
+	}
+	return 0
+}
+
+func (x *PriceDispersion) GetMinPrice() string {
+	if x != nil {
+		return x.MinPrice
+	}
+	return ""
+}
+
+func (x *PriceDispersion) GetMaxPrice() string {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return ""
+}
+
+func (x *PriceDispersion) GetMedianPrice() string {
+	if x != nil {
+		return x.MedianPrice
+	}
+	return ""
+}
+
+func (x *PriceDispersion) GetSpreadBps() uint64 {
+	if x != nil {
+		return x.SpreadBps
+	}
+	return 0
+}
+
+var File_rollinky_attestation_price_dispersion_proto protoreflect.FileDescriptor
+
+var file_rollinky_attestation_price_dispersion_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x69, 0x73,
+	0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x72,
+	0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xfc, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x44, 0x69, 0x73,
+	0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x70,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x42,
+	0x70, 0x73, 0x42, 0xc8, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x6f, 0x6c, 0x6c, 0x69,
+	0x6e, 0x6b, 0x79, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x14, 0x50, 0x72, 0x69, 0x63, 0x65, 0x44, 0x69, 0x73, 0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x25, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e,
+	0x6b, 0x79, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0xa2, 0x02,
+	0x03, 0x52, 0x41, 0x58, 0xaa, 0x02, 0x14, 0x52, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e,
+	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0xca, 0x02, 0x14, 0x52, 0x6f,
+	0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0xe2, 0x02, 0x20, 0x52, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x52, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
+	0x3a, 0x3a, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rollinky_attestation_price_dispersion_proto_rawDescOnce sync.Once
+	file_rollinky_attestation_price_dispersion_proto_rawDescData = file_rollinky_attestation_price_dispersion_proto_rawDesc
+)
+
+func file_rollinky_attestation_price_dispersion_proto_rawDescGZIP() []byte {
+	file_rollinky_attestation_price_dispersion_proto_rawDescOnce.Do(func() {
+		file_rollinky_attestation_price_dispersion_proto_rawDescData = protoimpl.X.CompressGZIP(file_rollinky_attestation_price_dispersion_proto_rawDescData)
+	})
+	return file_rollinky_attestation_price_dispersion_proto_rawDescData
+}
+
+var file_rollinky_attestation_price_dispersion_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rollinky_attestation_price_dispersion_proto_goTypes = []interface{}{
+	(*PriceDispersion)(nil), // 0: rollinky.attestation.PriceDispersion
+}
+var file_rollinky_attestation_price_dispersion_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rollinky_attestation_price_dispersion_proto_init() }
+func file_rollinky_attestation_price_dispersion_proto_init() {
+	if File_rollinky_attestation_price_dispersion_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rollinky_attestation_price_dispersion_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceDispersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rollinky_attestation_price_dispersion_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rollinky_attestation_price_dispersion_proto_goTypes,
+		DependencyIndexes: file_rollinky_attestation_price_dispersion_proto_depIdxs,
+		MessageInfos:      file_rollinky_attestation_price_dispersion_proto_msgTypes,
+	}.Build()
+	File_rollinky_attestation_price_dispersion_proto = out.File
+	file_rollinky_attestation_price_dispersion_proto_rawDesc = nil
+	file_rollinky_attestation_price_dispersion_proto_goTypes = nil
+	file_rollinky_attestation_price_dispersion_proto_depIdxs = nil
+}
@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rollinky/attestation/query.proto
+
+package attestation
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName               = "/rollinky.attestation.Query/Params"
+	Query_PriceDispersion_FullMethodName      = "/rollinky.attestation.Query/PriceDispersion"
+	Query_PriceDispersionAll_FullMethodName   = "/rollinky.attestation.Query/PriceDispersionAll"
+	Query_AttestationAtHeight_FullMethodName  = "/rollinky.attestation.Query/AttestationAtHeight"
+	Query_AttestationRecordAll_FullMethodName = "/rollinky.attestation.Query/AttestationRecordAll"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a PriceDispersion by currency pair.
+	PriceDispersion(ctx context.Context, in *QueryGetPriceDispersionRequest, opts ...grpc.CallOption) (*QueryGetPriceDispersionResponse, error)
+	// Queries a list of PriceDispersion items.
+	PriceDispersionAll(ctx context.Context, in *QueryAllPriceDispersionRequest, opts ...grpc.CallOption) (*QueryAllPriceDispersionResponse, error)
+	// Queries the AttestationRecord of the prices written at a height.
+	AttestationAtHeight(ctx context.Context, in *QueryAttestationAtHeightRequest, opts ...grpc.CallOption) (*QueryAttestationAtHeightResponse, error)
+	// Queries a list of AttestationRecord items.
+	AttestationRecordAll(ctx context.Context, in *QueryAllAttestationRecordRequest, opts ...grpc.CallOption) (*QueryAllAttestationRecordResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceDispersion(ctx context.Context, in *QueryGetPriceDispersionRequest, opts ...grpc.CallOption) (*QueryGetPriceDispersionResponse, error) {
+	out := new(QueryGetPriceDispersionResponse)
+	err := c.cc.Invoke(ctx, Query_PriceDispersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceDispersionAll(ctx context.Context, in *QueryAllPriceDispersionRequest, opts ...grpc.CallOption) (*QueryAllPriceDispersionResponse, error) {
+	out := new(QueryAllPriceDispersionResponse)
+	err := c.cc.Invoke(ctx, Query_PriceDispersionAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationAtHeight(ctx context.Context, in *QueryAttestationAtHeightRequest, opts ...grpc.CallOption) (*QueryAttestationAtHeightResponse, error) {
+	out := new(QueryAttestationAtHeightResponse)
+	err := c.cc.Invoke(ctx, Query_AttestationAtHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationRecordAll(ctx context.Context, in *QueryAllAttestationRecordRequest, opts ...grpc.CallOption) (*QueryAllAttestationRecordResponse, error) {
+	out := new(QueryAllAttestationRecordResponse)
+	err := c.cc.Invoke(ctx, Query_AttestationRecordAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a PriceDispersion by currency pair.
+	PriceDispersion(context.Context, *QueryGetPriceDispersionRequest) (*QueryGetPriceDispersionResponse, error)
+	// Queries a list of PriceDispersion items.
+	PriceDispersionAll(context.Context, *QueryAllPriceDispersionRequest) (*QueryAllPriceDispersionResponse, error)
+	// Queries the AttestationRecord of the prices written at a height.
+	AttestationAtHeight(context.Context, *QueryAttestationAtHeightRequest) (*QueryAttestationAtHeightResponse, error)
+	// Queries a list of AttestationRecord items.
+	AttestationRecordAll(context.Context, *QueryAllAttestationRecordRequest) (*QueryAllAttestationRecordResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) PriceDispersion(context.Context, *QueryGetPriceDispersionRequest) (*QueryGetPriceDispersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceDispersion not implemented")
+}
+func (UnimplementedQueryServer) PriceDispersionAll(context.Context, *QueryAllPriceDispersionRequest) (*QueryAllPriceDispersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceDispersionAll not implemented")
+}
+func (UnimplementedQueryServer) AttestationAtHeight(context.Context, *QueryAttestationAtHeightRequest) (*QueryAttestationAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationAtHeight not implemented")
+}
+func (UnimplementedQueryServer) AttestationRecordAll(context.Context, *QueryAllAttestationRecordRequest) (*QueryAllAttestationRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationRecordAll not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceDispersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPriceDispersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceDispersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceDispersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceDispersion(ctx, req.(*QueryGetPriceDispersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceDispersionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPriceDispersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceDispersionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceDispersionAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceDispersionAll(ctx, req.(*QueryAllPriceDispersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AttestationAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationAtHeight(ctx, req.(*QueryAttestationAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationRecordAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAttestationRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationRecordAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AttestationRecordAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationRecordAll(ctx, req.(*QueryAllAttestationRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rollinky.attestation.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PriceDispersion",
+			Handler:    _Query_PriceDispersion_Handler,
+		},
+		{
+			MethodName: "PriceDispersionAll",
+			Handler:    _Query_PriceDispersionAll_Handler,
+		},
+		{
+			MethodName: "AttestationAtHeight",
+			Handler:    _Query_AttestationAtHeight_Handler,
+		},
+		{
+			MethodName: "AttestationRecordAll",
+			Handler:    _Query_AttestationRecordAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rollinky/attestation/query.proto",
+}
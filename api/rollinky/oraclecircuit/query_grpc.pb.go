@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rollinky/oraclecircuit/query.proto
+
+package oraclecircuit
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName           = "/rollinky.oraclecircuit.Query/Params"
+	Query_CircuitState_FullMethodName     = "/rollinky.oraclecircuit.Query/CircuitState"
+	Query_PairStatusAll_FullMethodName    = "/rollinky.oraclecircuit.Query/PairStatusAll"
+	Query_CircuitRecord_FullMethodName    = "/rollinky.oraclecircuit.Query/CircuitRecord"
+	Query_CircuitRecordAll_FullMethodName = "/rollinky.oraclecircuit.Query/CircuitRecordAll"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// CircuitState queries the current state of the circuit.
+	CircuitState(ctx context.Context, in *QueryGetCircuitStateRequest, opts ...grpc.CallOption) (*QueryGetCircuitStateResponse, error)
+	// Queries a list of PairStatus items.
+	PairStatusAll(ctx context.Context, in *QueryAllPairStatusRequest, opts ...grpc.CallOption) (*QueryAllPairStatusResponse, error)
+	// Queries a CircuitRecord by id.
+	CircuitRecord(ctx context.Context, in *QueryGetCircuitRecordRequest, opts ...grpc.CallOption) (*QueryGetCircuitRecordResponse, error)
+	// Queries a list of CircuitRecord items.
+	CircuitRecordAll(ctx context.Context, in *QueryAllCircuitRecordRequest, opts ...grpc.CallOption) (*QueryAllCircuitRecordResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitState(ctx context.Context, in *QueryGetCircuitStateRequest, opts ...grpc.CallOption) (*QueryGetCircuitStateResponse, error) {
+	out := new(QueryGetCircuitStateResponse)
+	err := c.cc.Invoke(ctx, Query_CircuitState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PairStatusAll(ctx context.Context, in *QueryAllPairStatusRequest, opts ...grpc.CallOption) (*QueryAllPairStatusResponse, error) {
+	out := new(QueryAllPairStatusResponse)
+	err := c.cc.Invoke(ctx, Query_PairStatusAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitRecord(ctx context.Context, in *QueryGetCircuitRecordRequest, opts ...grpc.CallOption) (*QueryGetCircuitRecordResponse, error) {
+	out := new(QueryGetCircuitRecordResponse)
+	err := c.cc.Invoke(ctx, Query_CircuitRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitRecordAll(ctx context.Context, in *QueryAllCircuitRecordRequest, opts ...grpc.CallOption) (*QueryAllCircuitRecordResponse, error) {
+	out := new(QueryAllCircuitRecordResponse)
+	err := c.cc.Invoke(ctx, Query_CircuitRecordAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// CircuitState queries the current state of the circuit.
+	CircuitState(context.Context, *QueryGetCircuitStateRequest) (*QueryGetCircuitStateResponse, error)
+	// Queries a list of PairStatus items.
+	PairStatusAll(context.Context, *QueryAllPairStatusRequest) (*QueryAllPairStatusResponse, error)
+	// Queries a CircuitRecord by id.
+	CircuitRecord(context.Context, *QueryGetCircuitRecordRequest) (*QueryGetCircuitRecordResponse, error)
+	// Queries a list of CircuitRecord items.
+	CircuitRecordAll(context.Context, *QueryAllCircuitRecordRequest) (*QueryAllCircuitRecordResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) CircuitState(context.Context, *QueryGetCircuitStateRequest) (*QueryGetCircuitStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitState not implemented")
+}
+func (UnimplementedQueryServer) PairStatusAll(context.Context, *QueryAllPairStatusRequest) (*QueryAllPairStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PairStatusAll not implemented")
+}
+func (UnimplementedQueryServer) CircuitRecord(context.Context, *QueryGetCircuitRecordRequest) (*QueryGetCircuitRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitRecord not implemented")
+}
+func (UnimplementedQueryServer) CircuitRecordAll(context.Context, *QueryAllCircuitRecordRequest) (*QueryAllCircuitRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitRecordAll not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCircuitStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CircuitState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitState(ctx, req.(*QueryGetCircuitStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PairStatusAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPairStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PairStatusAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PairStatusAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PairStatusAll(ctx, req.(*QueryAllPairStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCircuitRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CircuitRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitRecord(ctx, req.(*QueryGetCircuitRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitRecordAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCircuitRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitRecordAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CircuitRecordAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitRecordAll(ctx, req.(*QueryAllCircuitRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rollinky.oraclecircuit.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "CircuitState",
+			Handler:    _Query_CircuitState_Handler,
+		},
+		{
+			MethodName: "PairStatusAll",
+			Handler:    _Query_PairStatusAll_Handler,
+		},
+		{
+			MethodName: "CircuitRecord",
+			Handler:    _Query_CircuitRecord_Handler,
+		},
+		{
+			MethodName: "CircuitRecordAll",
+			Handler:    _Query_CircuitRecordAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rollinky/oraclecircuit/query.proto",
+}
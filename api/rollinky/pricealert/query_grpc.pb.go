@@ -0,0 +1,228 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rollinky/pricealert/query.proto
+
+package pricealert
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName        = "/rollinky.pricealert.Query/Params"
+	Query_Alert_FullMethodName         = "/rollinky.pricealert.Query/Alert"
+	Query_AlertAll_FullMethodName      = "/rollinky.pricealert.Query/AlertAll"
+	Query_AlertsByOwner_FullMethodName = "/rollinky.pricealert.Query/AlertsByOwner"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a Alert by id.
+	Alert(ctx context.Context, in *QueryGetAlertRequest, opts ...grpc.CallOption) (*QueryGetAlertResponse, error)
+	// Queries a list of Alert items.
+	AlertAll(ctx context.Context, in *QueryAllAlertRequest, opts ...grpc.CallOption) (*QueryAllAlertResponse, error)
+	// Queries the alerts of an owner.
+	AlertsByOwner(ctx context.Context, in *QueryAlertsByOwnerRequest, opts ...grpc.CallOption) (*QueryAlertsByOwnerResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Alert(ctx context.Context, in *QueryGetAlertRequest, opts ...grpc.CallOption) (*QueryGetAlertResponse, error) {
+	out := new(QueryGetAlertResponse)
+	err := c.cc.Invoke(ctx, Query_Alert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AlertAll(ctx context.Context, in *QueryAllAlertRequest, opts ...grpc.CallOption) (*QueryAllAlertResponse, error) {
+	out := new(QueryAllAlertResponse)
+	err := c.cc.Invoke(ctx, Query_AlertAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AlertsByOwner(ctx context.Context, in *QueryAlertsByOwnerRequest, opts ...grpc.CallOption) (*QueryAlertsByOwnerResponse, error) {
+	out := new(QueryAlertsByOwnerResponse)
+	err := c.cc.Invoke(ctx, Query_AlertsByOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a Alert by id.
+	Alert(context.Context, *QueryGetAlertRequest) (*QueryGetAlertResponse, error)
+	// Queries a list of Alert items.
+	AlertAll(context.Context, *QueryAllAlertRequest) (*QueryAllAlertResponse, error)
+	// Queries the alerts of an owner.
+	AlertsByOwner(context.Context, *QueryAlertsByOwnerRequest) (*QueryAlertsByOwnerResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) Alert(context.Context, *QueryGetAlertRequest) (*QueryGetAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Alert not implemented")
+}
+func (UnimplementedQueryServer) AlertAll(context.Context, *QueryAllAlertRequest) (*QueryAllAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlertAll not implemented")
+}
+func (UnimplementedQueryServer) AlertsByOwner(context.Context, *QueryAlertsByOwnerRequest) (*QueryAlertsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlertsByOwner not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Alert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Alert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Alert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Alert(ctx, req.(*QueryGetAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AlertAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AlertAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AlertAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AlertAll(ctx, req.(*QueryAllAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AlertsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAlertsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AlertsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AlertsByOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AlertsByOwner(ctx, req.(*QueryAlertsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rollinky.pricealert.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Alert",
+			Handler:    _Query_Alert_Handler,
+		},
+		{
+			MethodName: "AlertAll",
+			Handler:    _Query_AlertAll_Handler,
+		},
+		{
+			MethodName: "AlertsByOwner",
+			Handler:    _Query_AlertsByOwner_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rollinky/pricealert/query.proto",
+}
@@ -0,0 +1,234 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rollinky/pricehistory/query.proto
+
+package pricehistory
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName        = "/rollinky.pricehistory.Query/Params"
+	Query_PriceAtHeight_FullMethodName = "/rollinky.pricehistory.Query/PriceAtHeight"
+	Query_PriceAtTime_FullMethodName   = "/rollinky.pricehistory.Query/PriceAtTime"
+	Query_PriceRange_FullMethodName    = "/rollinky.pricehistory.Query/PriceRange"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PriceAtHeight queries the price of a currency pair at a height: the last
+	// price written at or before it.
+	PriceAtHeight(ctx context.Context, in *QueryPriceAtHeightRequest, opts ...grpc.CallOption) (*QueryPriceAtHeightResponse, error)
+	// PriceAtTime queries the price of a currency pair at a time: the last price
+	// written in a block at or before it.
+	PriceAtTime(ctx context.Context, in *QueryPriceAtTimeRequest, opts ...grpc.CallOption) (*QueryPriceAtTimeResponse, error)
+	// PriceRange queries the prices of a currency pair written in blocks within
+	// a time range, oldest first.
+	PriceRange(ctx context.Context, in *QueryPriceRangeRequest, opts ...grpc.CallOption) (*QueryPriceRangeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceAtHeight(ctx context.Context, in *QueryPriceAtHeightRequest, opts ...grpc.CallOption) (*QueryPriceAtHeightResponse, error) {
+	out := new(QueryPriceAtHeightResponse)
+	err := c.cc.Invoke(ctx, Query_PriceAtHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceAtTime(ctx context.Context, in *QueryPriceAtTimeRequest, opts ...grpc.CallOption) (*QueryPriceAtTimeResponse, error) {
+	out := new(QueryPriceAtTimeResponse)
+	err := c.cc.Invoke(ctx, Query_PriceAtTime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceRange(ctx context.Context, in *QueryPriceRangeRequest, opts ...grpc.CallOption) (*QueryPriceRangeResponse, error) {
+	out := new(QueryPriceRangeResponse)
+	err := c.cc.Invoke(ctx, Query_PriceRange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PriceAtHeight queries the price of a currency pair at a height: the last
+	// price written at or before it.
+	PriceAtHeight(context.Context, *QueryPriceAtHeightRequest) (*QueryPriceAtHeightResponse, error)
+	// PriceAtTime queries the price of a currency pair at a time: the last price
+	// written in a block at or before it.
+	PriceAtTime(context.Context, *QueryPriceAtTimeRequest) (*QueryPriceAtTimeResponse, error)
+	// PriceRange queries the prices of a currency pair written in blocks within
+	// a time range, oldest first.
+	PriceRange(context.Context, *QueryPriceRangeRequest) (*QueryPriceRangeResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) PriceAtHeight(context.Context, *QueryPriceAtHeightRequest) (*QueryPriceAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceAtHeight not implemented")
+}
+func (UnimplementedQueryServer) PriceAtTime(context.Context, *QueryPriceAtTimeRequest) (*QueryPriceAtTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceAtTime not implemented")
+}
+func (UnimplementedQueryServer) PriceRange(context.Context, *QueryPriceRangeRequest) (*QueryPriceRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceRange not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceAtHeight(ctx, req.(*QueryPriceAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceAtTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceAtTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceAtTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceAtTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceAtTime(ctx, req.(*QueryPriceAtTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceRange(ctx, req.(*QueryPriceRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rollinky.pricehistory.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PriceAtHeight",
+			Handler:    _Query_PriceAtHeight_Handler,
+		},
+		{
+			MethodName: "PriceAtTime",
+			Handler:    _Query_PriceAtTime_Handler,
+		},
+		{
+			MethodName: "PriceRange",
+			Handler:    _Query_PriceRange_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rollinky/pricehistory/query.proto",
+}
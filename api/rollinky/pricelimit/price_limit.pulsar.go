@@ -0,0 +1,1714 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package pricelimit
+
+import (
+	_ "cosmossdk.io/api/amino"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_PriceLimit                   protoreflect.MessageDescriptor
+	fd_PriceLimit_currency_pair     protoreflect.FieldDescriptor
+	fd_PriceLimit_max_block_change  protoreflect.FieldDescriptor
+	fd_PriceLimit_max_minute_change protoreflect.FieldDescriptor
+	fd_PriceLimit_confirmations     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_rollinky_pricelimit_price_limit_proto_init()
+	md_PriceLimit = File_rollinky_pricelimit_price_limit_proto.Messages().ByName("PriceLimit")
+	fd_PriceLimit_currency_pair = md_PriceLimit.Fields().ByName("currency_pair")
+	fd_PriceLimit_max_block_change = md_PriceLimit.Fields().ByName("max_block_change")
+	fd_PriceLimit_max_minute_change = md_PriceLimit.Fields().ByName("max_minute_change")
+	fd_PriceLimit_confirmations = md_PriceLimit.Fields().ByName("confirmations")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceLimit)(nil)
+
+type fastReflection_PriceLimit PriceLimit
+
+func (x *PriceLimit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceLimit)(x)
+}
+
+func (x *PriceLimit) slowProtoReflect() protoreflect.Message {
+	mi := &file_rollinky_pricelimit_price_limit_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceLimit_messageType fastReflection_PriceLimit_messageType
+var _ protoreflect.MessageType = fastReflection_PriceLimit_messageType{}
+
+type fastReflection_PriceLimit_messageType struct{}
+
+func (x fastReflection_PriceLimit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceLimit)(nil)
+}
+func (x fastReflection_PriceLimit_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceLimit)
+}
+func (x fastReflection_PriceLimit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceLimit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceLimit) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceLimit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceLimit) Type() protoreflect.MessageType {
+	return _fastReflection_PriceLimit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceLimit) New() protoreflect.Message {
+	return new(fastReflection_PriceLimit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceLimit) Interface() protoreflect.ProtoMessage {
+	return (*PriceLimit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceLimit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CurrencyPair != "" {
+		value := protoreflect.ValueOfString(x.CurrencyPair)
+		if !f(fd_PriceLimit_currency_pair, value) {
+			return
+		}
+	}
+	if x.MaxBlockChange != "" {
+		value := protoreflect.ValueOfString(x.MaxBlockChange)
+		if !f(fd_PriceLimit_max_block_change, value) {
+			return
+		}
+	}
+	if x.MaxMinuteChange != "" {
+		value := protoreflect.ValueOfString(x.MaxMinuteChange)
+		if !f(fd_PriceLimit_max_minute_change, value) {
+			return
+		}
+	}
+	if x.Confirmations != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Confirmations)
+		if !f(fd_PriceLimit_confirmations, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceLimit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "rollinky.pricelimit.PriceLimit.currency_pair":
+		return x.CurrencyPair != ""
+	case "rollinky.pricelimit.PriceLimit.max_block_change":
+		return x.MaxBlockChange != ""
+	case "rollinky.pricelimit.PriceLimit.max_minute_change":
+		return x.MaxMinuteChange != ""
+	case "rollinky.pricelimit.PriceLimit.confirmations":
+		return x.Confirmations != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.pricelimit.PriceLimit"))
+		}
+		panic(fmt.Errorf("message rollinky.pricelimit.PriceLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceLimit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "rollinky.pricelimit.PriceLimit.currency_pair":
+		x.CurrencyPair = ""
+	case "rollinky.pricelimit.PriceLimit.max_block_change":
+		x.MaxBlockChange = ""
+	case "rollinky.pricelimit.PriceLimit.max_minute_change":
+		x.MaxMinuteChange = ""
+	case "rollinky.pricelimit.PriceLimit.confirmations":
+		x.Confirmations = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.pricelimit.PriceLimit"))
+		}
+		panic(fmt.Errorf("message rollinky.pricelimit.PriceLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceLimit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "rollinky.pricelimit.PriceLimit.currency_pair":
+		value := x.CurrencyPair
+		return protoreflect.ValueOfString(value)
+	case "rollinky.pricelimit.PriceLimit.max_block_change":
+		value := x.MaxBlockChange
+		return protoreflect.ValueOfString(value)
+	case "rollinky.pricelimit.PriceLimit.max_minute_change":
+		value := x.MaxMinuteChange
+		return protoreflect.ValueOfString(value)
+	case "rollinky.pricelimit.PriceLimit.confirmations":
+		value := x.Confirmations
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.pricelimit.PriceLimit"))
+		}
+		panic(fmt.Errorf("message rollinky.pricelimit.PriceLimit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceLimit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "rollinky.pricelimit.PriceLimit.currency_pair":
+		x.CurrencyPair = value.Interface().(string)
+	case "rollinky.pricelimit.PriceLimit.max_block_change":
+		x.MaxBlockChange = value.Interface().(string)
+	case "rollinky.pricelimit.PriceLimit.max_minute_change":
+		x.MaxMinuteChange = value.Interface().(string)
+	case "rollinky.pricelimit.PriceLimit.confirmations":
+		x.Confirmations = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.pricelimit.PriceLimit"))
+		}
+		panic(fmt.Errorf("message rollinky.pricelimit.PriceLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceLimit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rollinky.pricelimit.PriceLimit.currency_pair":
+		panic(fmt.Errorf("field currency_pair of message rollinky.pricelimit.PriceLimit is not mutable"))
+	case "rollinky.pricelimit.PriceLimit.max_block_change":
+		panic(fmt.Errorf("field max_block_change of message rollinky.pricelimit.PriceLimit is not mutable"))
+	case "rollinky.pricelimit.PriceLimit.max_minute_change":
+		panic(fmt.Errorf("field max_minute_change of message rollinky.pricelimit.PriceLimit is not mutable"))
+	case "rollinky.pricelimit.PriceLimit.confirmations":
+		panic(fmt.Errorf("field confirmations of message rollinky.pricelimit.PriceLimit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.pricelimit.PriceLimit"))
+		}
+		panic(fmt.Errorf("message rollinky.pricelimit.PriceLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceLimit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rollinky.pricelimit.PriceLimit.currency_pair":
+		return protoreflect.ValueOfString("")
+	case "rollinky.pricelimit.PriceLimit.max_block_change":
+		return protoreflect.ValueOfString("")
+	case "rollinky.pricelimit.PriceLimit.max_minute_change":
+		return protoreflect.ValueOfString("")
+	case "rollinky.pricelimit.PriceLimit.confirmations":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.pricelimit.PriceLimit"))
+		}
+		panic(fmt.Errorf("message rollinky.pricelimit.PriceLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceLimit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in rollinky.pricelimit.PriceLimit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceLimit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceLimit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceLimit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceLimit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceLimit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.CurrencyPair)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxBlockChange)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxMinuteChange)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Confirmations != 0 {
+			n += 1 + runtime.Sov(uint64(x.Confirmations))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceLimit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Confirmations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Confirmations))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.MaxMinuteChange) > 0 {
+			i -= len(x.MaxMinuteChange)
+			copy(dAtA[i:], x.MaxMinuteChange)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxMinuteChange)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MaxBlockChange) > 0 {
+			i -= len(x.MaxBlockChange)
+			copy(dAtA[i:], x.MaxBlockChange)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxBlockChange)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.CurrencyPair) > 0 {
+			i -= len(x.CurrencyPair)
+			copy(dAtA[i:], x.CurrencyPair)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CurrencyPair)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceLimit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceLimit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CurrencyPair = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBlockChange", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxBlockChange = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMinuteChange", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxMinuteChange = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+				}
+				x.Confirmations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Confirmations |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PriceLimitState                      protoreflect.MessageDescriptor
+	fd_PriceLimitState_currency_pair        protoreflect.FieldDescriptor
+	fd_PriceLimitState_reference_price      protoreflect.FieldDescriptor
+	fd_PriceLimitState_reference_time       protoreflect.FieldDescriptor
+	fd_PriceLimitState_pending_price        protoreflect.FieldDescriptor
+	fd_PriceLimitState_pending_updates      protoreflect.FieldDescriptor
+	fd_PriceLimitState_withheld_count       protoreflect.FieldDescriptor
+	fd_PriceLimitState_last_withheld_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_rollinky_pricelimit_price_limit_proto_init()
+	md_PriceLimitState = File_rollinky_pricelimit_price_limit_proto.Messages().ByName("PriceLimitState")
+	fd_PriceLimitState_currency_pair = md_PriceLimitState.Fields().ByName("currency_pair")
+	fd_PriceLimitState_reference_price = md_PriceLimitState.Fields().ByName("reference_price")
+	fd_PriceLimitState_reference_time = md_PriceLimitState.Fields().ByName("reference_time")
+	fd_PriceLimitState_pending_price = md_PriceLimitState.Fields().ByName("pending_price")
+	fd_PriceLimitState_pending_updates = md_PriceLimitState.Fields().ByName("pending_updates")
+	fd_PriceLimitState_withheld_count = md_PriceLimitState.Fields().ByName("withheld_count")
+	fd_PriceLimitState_last_withheld_height = md_PriceLimitState.Fields().ByName("last_withheld_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceLimitState)(nil)
+
+type fastReflection_PriceLimitState PriceLimitState
+
+func (x *PriceLimitState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceLimitState)(x)
+}
+
+func (x *PriceLimitState) slowProtoReflect() protoreflect.Message {
+	mi := &file_rollinky_pricelimit_price_limit_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceLimitState_messageType fastReflection_PriceLimitState_messageType
+var _ protoreflect.MessageType = fastReflection_PriceLimitState_messageType{}
+
+type fastReflection_PriceLimitState_messageType struct{}
+
+func (x fastReflection_PriceLimitState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceLimitState)(nil)
+}
+func (x fastReflection_PriceLimitState_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceLimitState)
+}
+func (x fastReflection_PriceLimitState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceLimitState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceLimitState) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceLimitState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceLimitState) Type() protoreflect.MessageType {
+	return _fastReflection_PriceLimitState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceLimitState) New() protoreflect.Message {
+	return new(fastReflection_PriceLimitState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceLimitState) Interface() protoreflect.ProtoMessage {
+	return (*PriceLimitState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceLimitState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CurrencyPair != "" {
+		value := protoreflect.ValueOfString(x.CurrencyPair)
+		if !f(fd_PriceLimitState_currency_pair, value) {
+			return
+		}
+	}
+	if x.ReferencePrice != "" {
+		value := protoreflect.ValueOfString(x.ReferencePrice)
+		if !f(fd_PriceLimitState_reference_price, value) {
+			return
+		}
+	}
+	if x.ReferenceTime != nil {
+		value := protoreflect.ValueOfMessage(x.ReferenceTime.ProtoReflect())
+		if !f(fd_PriceLimitState_reference_time, value) {
+			return
+		}
+	}
+	if x.PendingPrice != "" {
+		value := protoreflect.ValueOfString(x.PendingPrice)
+		if !f(fd_PriceLimitState_pending_price, value) {
+			return
+		}
+	}
+	if x.PendingUpdates != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PendingUpdates)
+		if !f(fd_PriceLimitState_pending_updates, value) {
+			return
+		}
+	}
+	if x.WithheldCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WithheldCount)
+		if !f(fd_PriceLimitState_withheld_count, value) {
+			return
+		}
+	}
+	if x.LastWithheldHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastWithheldHeight)
+		if !f(fd_PriceLimitState_last_withheld_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceLimitState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "rollinky.pricelimit.PriceLimitState.currency_pair":
+		return x.CurrencyPair != ""
+	case "rollinky.pricelimit.PriceLimitState.reference_price":
+		return x.ReferencePrice != ""
+	case "rollinky.pricelimit.PriceLimitState.reference_time":
+		return x.ReferenceTime != nil
+	case "rollinky.pricelimit.PriceLimitState.pending_price":
+		return x.PendingPrice != ""
+	case "rollinky.pricelimit.PriceLimitState.pending_updates":
+		return x.PendingUpdates != uint64(0)
+	case "rollinky.pricelimit.PriceLimitState.withheld_count":
+		return x.WithheldCount != uint64(0)
+	case "rollinky.pricelimit.PriceLimitState.last_withheld_height":
+		return x.LastWithheldHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.pricelimit.PriceLimitState"))
+		}
+		panic(fmt.Errorf("message rollinky.pricelimit.PriceLimitState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceLimitState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "rollinky.pricelimit.PriceLimitState.currency_pair":
+		x.CurrencyPair = ""
+	case "rollinky.pricelimit.PriceLimitState.reference_price":
+		x.ReferencePrice = ""
+	case "rollinky.pricelimit.PriceLimitState.reference_time":
+		x.ReferenceTime = nil
+	case "rollinky.pricelimit.PriceLimitState.pending_price":
+		x.PendingPrice = ""
+	case "rollinky.pricelimit.PriceLimitState.pending_updates":
+		x.PendingUpdates = uint64(0)
+	case "rollinky.pricelimit.PriceLimitState.withheld_count":
+		x.WithheldCount = uint64(0)
+	case "rollinky.pricelimit.PriceLimitState.last_withheld_height":
+		x.LastWithheldHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.pricelimit.PriceLimitState"))
+		}
+		panic(fmt.Errorf("message rollinky.pricelimit.PriceLimitState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceLimitState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "rollinky.pricelimit.PriceLimitState.currency_pair":
+		value := x.CurrencyPair
+		return protoreflect.ValueOfString(value)
+	case "rollinky.pricelimit.PriceLimitState.reference_price":
+		value := x.ReferencePrice
+		return protoreflect.ValueOfString(value)
+	case "rollinky.pricelimit.PriceLimitState.reference_time":
+		value := x.ReferenceTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "rollinky.pricelimit.PriceLimitState.pending_price":
+		value := x.PendingPrice
+		return protoreflect.ValueOfString(value)
+	case "rollinky.pricelimit.PriceLimitState.pending_updates":
+		value := x.PendingUpdates
+		return protoreflect.ValueOfUint64(value)
+	case "rollinky.pricelimit.PriceLimitState.withheld_count":
+		value := x.WithheldCount
+		return protoreflect.ValueOfUint64(value)
+	case "rollinky.pricelimit.PriceLimitState.last_withheld_height":
+		value := x.LastWithheldHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.pricelimit.PriceLimitState"))
+		}
+		panic(fmt.Errorf("message rollinky.pricelimit.PriceLimitState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceLimitState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "rollinky.pricelimit.PriceLimitState.currency_pair":
+		x.CurrencyPair = value.Interface().(string)
+	case "rollinky.pricelimit.PriceLimitState.reference_price":
+		x.ReferencePrice = value.Interface().(string)
+	case "rollinky.pricelimit.PriceLimitState.reference_time":
+		x.ReferenceTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "rollinky.pricelimit.PriceLimitState.pending_price":
+		x.PendingPrice = value.Interface().(string)
+	case "rollinky.pricelimit.PriceLimitState.pending_updates":
+		x.PendingUpdates = value.Uint()
+	case "rollinky.pricelimit.PriceLimitState.withheld_count":
+		x.WithheldCount = value.Uint()
+	case "rollinky.pricelimit.PriceLimitState.last_withheld_height":
+		x.LastWithheldHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.pricelimit.PriceLimitState"))
+		}
+		panic(fmt.Errorf("message rollinky.pricelimit.PriceLimitState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceLimitState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rollinky.pricelimit.PriceLimitState.reference_time":
+		if x.ReferenceTime == nil {
+			x.ReferenceTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ReferenceTime.ProtoReflect())
+	case "rollinky.pricelimit.PriceLimitState.currency_pair":
+		panic(fmt.Errorf("field currency_pair of message rollinky.pricelimit.PriceLimitState is not mutable"))
+	case "rollinky.pricelimit.PriceLimitState.reference_price":
+		panic(fmt.Errorf("field reference_price of message rollinky.pricelimit.PriceLimitState is not mutable"))
+	case "rollinky.pricelimit.PriceLimitState.pending_price":
+		panic(fmt.Errorf("field pending_price of message rollinky.pricelimit.PriceLimitState is not mutable"))
+	case "rollinky.pricelimit.PriceLimitState.pending_updates":
+		panic(fmt.Errorf("field pending_updates of message rollinky.pricelimit.PriceLimitState is not mutable"))
+	case "rollinky.pricelimit.PriceLimitState.withheld_count":
+		panic(fmt.Errorf("field withheld_count of message rollinky.pricelimit.PriceLimitState is not mutable"))
+	case "rollinky.pricelimit.PriceLimitState.last_withheld_height":
+		panic(fmt.Errorf("field last_withheld_height of message rollinky.pricelimit.PriceLimitState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.pricelimit.PriceLimitState"))
+		}
+		panic(fmt.Errorf("message rollinky.pricelimit.PriceLimitState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceLimitState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rollinky.pricelimit.PriceLimitState.currency_pair":
+		return protoreflect.ValueOfString("")
+	case "rollinky.pricelimit.PriceLimitState.reference_price":
+		return protoreflect.ValueOfString("")
+	case "rollinky.pricelimit.PriceLimitState.reference_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "rollinky.pricelimit.PriceLimitState.pending_price":
+		return protoreflect.ValueOfString("")
+	case "rollinky.pricelimit.PriceLimitState.pending_updates":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "rollinky.pricelimit.PriceLimitState.withheld_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "rollinky.pricelimit.PriceLimitState.last_withheld_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rollinky.pricelimit.PriceLimitState"))
+		}
+		panic(fmt.Errorf("message rollinky.pricelimit.PriceLimitState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceLimitState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in rollinky.pricelimit.PriceLimitState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceLimitState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceLimitState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceLimitState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceLimitState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceLimitState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.CurrencyPair)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReferencePrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReferenceTime != nil {
+			l = options.Size(x.ReferenceTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PendingPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PendingUpdates != 0 {
+			n += 1 + runtime.Sov(uint64(x.PendingUpdates))
+		}
+		if x.WithheldCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.WithheldCount))
+		}
+		if x.LastWithheldHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastWithheldHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceLimitState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastWithheldHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastWithheldHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.WithheldCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WithheldCount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.PendingUpdates != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PendingUpdates))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.PendingPrice) > 0 {
+			i -= len(x.PendingPrice)
+			copy(dAtA[i:], x.PendingPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingPrice)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ReferenceTime != nil {
+			encoded, err := options.Marshal(x.ReferenceTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ReferencePrice) > 0 {
+			i -= len(x.ReferencePrice)
+			copy(dAtA[i:], x.ReferencePrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReferencePrice)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.CurrencyPair) > 0 {
+			i -= len(x.CurrencyPair)
+			copy(dAtA[i:], x.CurrencyPair)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CurrencyPair)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceLimitState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceLimitState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceLimitState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CurrencyPair = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReferencePrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferenceTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ReferenceTime == nil {
+					x.ReferenceTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReferenceTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingUpdates", wireType)
+				}
+				x.PendingUpdates = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PendingUpdates |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithheldCount", wireType)
+				}
+				x.WithheldCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WithheldCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastWithheldHeight", wireType)
+				}
+				x.LastWithheldHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastWithheldHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: rollinky/pricelimit/price_limit.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PriceLimit bounds how far the price of a currency pair may move before the
+// move is withheld until further updates confirm it.
+type PriceLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// currency_pair is the currency pair of the oracle the limit applies to,
+	// e.g. "BTC/USD".
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// max_block_change is the largest change from the last written price a
+	// price may make, as a fraction (e.g. 0.05 for 5%). Zero disables it.
+	MaxBlockChange string `protobuf:"bytes,2,opt,name=max_block_change,json=maxBlockChange,proto3" json:"max_block_change,omitempty"`
+	// max_minute_change is the largest change a price may make from the price
+	// written about a minute before, as a fraction. Zero disables it.
+	MaxMinuteChange string `protobuf:"bytes,3,opt,name=max_minute_change,json=maxMinuteChange,proto3" json:"max_minute_change,omitempty"`
+	// confirmations is the number of consecutive updates beyond the limits, in
+	// the same direction, that must follow a withheld price before the move is
+	// accepted.
+	Confirmations uint64 `protobuf:"varint,4,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+}
+
+func (x *PriceLimit) Reset() {
+	*x = PriceLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rollinky_pricelimit_price_limit_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceLimit) ProtoMessage() {}
+
+// Deprecated: Use PriceLimit.ProtoReflect.Descriptor instead.
+func (*PriceLimit) Descriptor() ([]byte, []int) {
+	return file_rollinky_pricelimit_price_limit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PriceLimit) GetCurrencyPair() string {
+	if x != nil {
+		return x.CurrencyPair
+	}
+	return ""
+}
+
+func (x *PriceLimit) GetMaxBlockChange() string {
+	if x != nil {
+		return x.MaxBlockChange
+	}
+	return ""
+}
+
+func (x *PriceLimit) GetMaxMinuteChange() string {
+	if x != nil {
+		return x.MaxMinuteChange
+	}
+	return ""
+}
+
+func (x *PriceLimit) GetConfirmations() uint64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+// PriceLimitState is what the limit of a currency pair measures prices
+// against.
+type PriceLimitState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// currency_pair is the currency pair of the oracle, e.g. "BTC/USD".
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// reference_price is the written price the change per minute is measured
+	// from. It moves to the last written price once it is a minute old.
+	ReferencePrice string `protobuf:"bytes,2,opt,name=reference_price,json=referencePrice,proto3" json:"reference_price,omitempty"`
+	// reference_time is the block time reference_price was written at.
+	ReferenceTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=reference_time,json=referenceTime,proto3" json:"reference_time,omitempty"`
+	// pending_price is the latest withheld price of the move waiting for
+	// confirmations, if pending_updates is not zero.
+	PendingPrice string `protobuf:"bytes,4,opt,name=pending_price,json=pendingPrice,proto3" json:"pending_price,omitempty"`
+	// pending_updates is the number of consecutive updates withheld for the
+	// move, zero if no move is pending.
+	PendingUpdates uint64 `protobuf:"varint,5,opt,name=pending_updates,json=pendingUpdates,proto3" json:"pending_updates,omitempty"`
+	// withheld_count is the number of prices withheld for the currency pair.
+	WithheldCount uint64 `protobuf:"varint,6,opt,name=withheld_count,json=withheldCount,proto3" json:"withheld_count,omitempty"`
+	// last_withheld_height is the height of the last price withheld.
+	LastWithheldHeight int64 `protobuf:"varint,7,opt,name=last_withheld_height,json=lastWithheldHeight,proto3" json:"last_withheld_height,omitempty"`
+}
+
+func (x *PriceLimitState) Reset() {
+	*x = PriceLimitState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rollinky_pricelimit_price_limit_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceLimitState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceLimitState) ProtoMessage() {}
+
+// Deprecated: Use PriceLimitState.ProtoReflect.Descriptor instead.
+func (*PriceLimitState) Descriptor() ([]byte, []int) {
+	return file_rollinky_pricelimit_price_limit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PriceLimitState) GetCurrencyPair() string {
+	if x != nil {
+		return x.CurrencyPair
+	}
+	return ""
+}
+
+func (x *PriceLimitState) GetReferencePrice() string {
+	if x != nil {
+		return x.ReferencePrice
+	}
+	return ""
+}
+
+func (x *PriceLimitState) GetReferenceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReferenceTime
+	}
+	return nil
+}
+
+func (x *PriceLimitState) GetPendingPrice() string {
+	if x != nil {
+		return x.PendingPrice
+	}
+	return ""
+}
+
+func (x *PriceLimitState) GetPendingUpdates() uint64 {
+	if x != nil {
+		return x.PendingUpdates
+	}
+	return 0
+}
+
+func (x *PriceLimitState) GetWithheldCount() uint64 {
+	if x != nil {
+		return x.WithheldCount
+	}
+	return 0
+}
+
+func (x *PriceLimitState) GetLastWithheldHeight() int64 {
+	if x != nil {
+		return x.LastWithheldHeight
+	}
+	return 0
+}
+
+var File_rollinky_pricelimit_price_limit_proto protoreflect.FileDescriptor
+
+var file_rollinky_pricelimit_price_limit_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b,
+	0x79, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x11, 0x61, 0x6d,
+	0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x9d, 0x02, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x60, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xb7, 0x03, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x59, 0x0a, 0x0f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x55, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68,
+	0x68, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x57, 0x69, 0x74,
+	0x68, 0x68, 0x65, 0x6c, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0xbd, 0x01, 0x0a, 0x17,
+	0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x0f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0xa2, 0x02, 0x03, 0x52, 0x50, 0x58, 0xaa, 0x02, 0x13, 0x52, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b,
+	0x79, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0xca, 0x02, 0x13, 0x52,
+	0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0xe2, 0x02, 0x1f, 0x52, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x52, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x3a,
+	0x3a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rollinky_pricelimit_price_limit_proto_rawDescOnce sync.Once
+	file_rollinky_pricelimit_price_limit_proto_rawDescData = file_rollinky_pricelimit_price_limit_proto_rawDesc
+)
+
+func file_rollinky_pricelimit_price_limit_proto_rawDescGZIP() []byte {
+	file_rollinky_pricelimit_price_limit_proto_rawDescOnce.Do(func() {
+		file_rollinky_pricelimit_price_limit_proto_rawDescData = protoimpl.X.CompressGZIP(file_rollinky_pricelimit_price_limit_proto_rawDescData)
+	})
+	return file_rollinky_pricelimit_price_limit_proto_rawDescData
+}
+
+var file_rollinky_pricelimit_price_limit_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rollinky_pricelimit_price_limit_proto_goTypes = []interface{}{
+	(*PriceLimit)(nil),            // 0: rollinky.pricelimit.PriceLimit
+	(*PriceLimitState)(nil),       // 1: rollinky.pricelimit.PriceLimitState
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_rollinky_pricelimit_price_limit_proto_depIdxs = []int32{
+	2, // 0: rollinky.pricelimit.PriceLimitState.reference_time:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rollinky_pricelimit_price_limit_proto_init() }
+func file_rollinky_pricelimit_price_limit_proto_init() {
+	if File_rollinky_pricelimit_price_limit_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rollinky_pricelimit_price_limit_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rollinky_pricelimit_price_limit_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceLimitState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rollinky_pricelimit_price_limit_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rollinky_pricelimit_price_limit_proto_goTypes,
+		DependencyIndexes: file_rollinky_pricelimit_price_limit_proto_depIdxs,
+		MessageInfos:      file_rollinky_pricelimit_price_limit_proto_msgTypes,
+	}.Build()
+	File_rollinky_pricelimit_price_limit_proto = out.File
+	file_rollinky_pricelimit_price_limit_proto_rawDesc = nil
+	file_rollinky_pricelimit_price_limit_proto_goTypes = nil
+	file_rollinky_pricelimit_price_limit_proto_depIdxs = nil
+}
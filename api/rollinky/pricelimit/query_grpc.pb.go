@@ -0,0 +1,269 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rollinky/pricelimit/query.proto
+
+package pricelimit
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName             = "/rollinky.pricelimit.Query/Params"
+	Query_PriceLimit_FullMethodName         = "/rollinky.pricelimit.Query/PriceLimit"
+	Query_PriceLimitAll_FullMethodName      = "/rollinky.pricelimit.Query/PriceLimitAll"
+	Query_PriceLimitState_FullMethodName    = "/rollinky.pricelimit.Query/PriceLimitState"
+	Query_PriceLimitStateAll_FullMethodName = "/rollinky.pricelimit.Query/PriceLimitStateAll"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a PriceLimit by currency pair.
+	PriceLimit(ctx context.Context, in *QueryGetPriceLimitRequest, opts ...grpc.CallOption) (*QueryGetPriceLimitResponse, error)
+	// Queries a list of PriceLimit items.
+	PriceLimitAll(ctx context.Context, in *QueryAllPriceLimitRequest, opts ...grpc.CallOption) (*QueryAllPriceLimitResponse, error)
+	// Queries a PriceLimitState by currency pair, with the withheld prices of
+	// the pair.
+	PriceLimitState(ctx context.Context, in *QueryGetPriceLimitStateRequest, opts ...grpc.CallOption) (*QueryGetPriceLimitStateResponse, error)
+	// Queries a list of PriceLimitState items.
+	PriceLimitStateAll(ctx context.Context, in *QueryAllPriceLimitStateRequest, opts ...grpc.CallOption) (*QueryAllPriceLimitStateResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceLimit(ctx context.Context, in *QueryGetPriceLimitRequest, opts ...grpc.CallOption) (*QueryGetPriceLimitResponse, error) {
+	out := new(QueryGetPriceLimitResponse)
+	err := c.cc.Invoke(ctx, Query_PriceLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceLimitAll(ctx context.Context, in *QueryAllPriceLimitRequest, opts ...grpc.CallOption) (*QueryAllPriceLimitResponse, error) {
+	out := new(QueryAllPriceLimitResponse)
+	err := c.cc.Invoke(ctx, Query_PriceLimitAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceLimitState(ctx context.Context, in *QueryGetPriceLimitStateRequest, opts ...grpc.CallOption) (*QueryGetPriceLimitStateResponse, error) {
+	out := new(QueryGetPriceLimitStateResponse)
+	err := c.cc.Invoke(ctx, Query_PriceLimitState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceLimitStateAll(ctx context.Context, in *QueryAllPriceLimitStateRequest, opts ...grpc.CallOption) (*QueryAllPriceLimitStateResponse, error) {
+	out := new(QueryAllPriceLimitStateResponse)
+	err := c.cc.Invoke(ctx, Query_PriceLimitStateAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a PriceLimit by currency pair.
+	PriceLimit(context.Context, *QueryGetPriceLimitRequest) (*QueryGetPriceLimitResponse, error)
+	// Queries a list of PriceLimit items.
+	PriceLimitAll(context.Context, *QueryAllPriceLimitRequest) (*QueryAllPriceLimitResponse, error)
+	// Queries a PriceLimitState by currency pair, with the withheld prices of
+	// the pair.
+	PriceLimitState(context.Context, *QueryGetPriceLimitStateRequest) (*QueryGetPriceLimitStateResponse, error)
+	// Queries a list of PriceLimitState items.
+	PriceLimitStateAll(context.Context, *QueryAllPriceLimitStateRequest) (*QueryAllPriceLimitStateResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) PriceLimit(context.Context, *QueryGetPriceLimitRequest) (*QueryGetPriceLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceLimit not implemented")
+}
+func (UnimplementedQueryServer) PriceLimitAll(context.Context, *QueryAllPriceLimitRequest) (*QueryAllPriceLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceLimitAll not implemented")
+}
+func (UnimplementedQueryServer) PriceLimitState(context.Context, *QueryGetPriceLimitStateRequest) (*QueryGetPriceLimitStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceLimitState not implemented")
+}
+func (UnimplementedQueryServer) PriceLimitStateAll(context.Context, *QueryAllPriceLimitStateRequest) (*QueryAllPriceLimitStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceLimitStateAll not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPriceLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceLimit(ctx, req.(*QueryGetPriceLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceLimitAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPriceLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceLimitAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceLimitAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceLimitAll(ctx, req.(*QueryAllPriceLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceLimitState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPriceLimitStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceLimitState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceLimitState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceLimitState(ctx, req.(*QueryGetPriceLimitStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceLimitStateAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPriceLimitStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceLimitStateAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceLimitStateAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceLimitStateAll(ctx, req.(*QueryAllPriceLimitStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rollinky.pricelimit.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PriceLimit",
+			Handler:    _Query_PriceLimit_Handler,
+		},
+		{
+			MethodName: "PriceLimitAll",
+			Handler:    _Query_PriceLimitAll_Handler,
+		},
+		{
+			MethodName: "PriceLimitState",
+			Handler:    _Query_PriceLimitState_Handler,
+		},
+		{
+			MethodName: "PriceLimitStateAll",
+			Handler:    _Query_PriceLimitStateAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rollinky/pricelimit/query.proto",
+}
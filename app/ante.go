@@ -0,0 +1,212 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+
+	attestationtypes "rollinky/x/attestation/types"
+)
+
+// PriceRequirement declares the oracle prices a message reads: a price for
+// each of its currency pairs, written at most MaxAge before the block.
+type PriceRequirement struct {
+	CurrencyPairs []connecttypes.CurrencyPair
+	MaxAge        time.Duration
+}
+
+// PriceRequirements maps the type URL of each message that reads oracle
+// prices to the prices it needs.
+type PriceRequirements map[string]PriceRequirement
+
+// Register declares the prices msg needs. Requirements are registered when the
+// app is built, so an invalid or repeated one panics.
+func (r PriceRequirements) Register(msg sdk.Msg, req PriceRequirement) {
+	msgURL := sdk.MsgTypeURL(msg)
+	if _, ok := r[msgURL]; ok {
+		panic(fmt.Sprintf("price requirement of %s registered twice", msgURL))
+	}
+	if len(req.CurrencyPairs) == 0 {
+		panic(fmt.Sprintf("price requirement of %s has no currency pairs", msgURL))
+	}
+	if req.MaxAge <= 0 {
+		panic(fmt.Sprintf("price requirement of %s must have a positive max age", msgURL))
+	}
+	for _, cp := range req.CurrencyPairs {
+		if err := cp.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("price requirement of %s: %s", msgURL, err))
+		}
+	}
+
+	r[msgURL] = req
+}
+
+// priceRequirements returns the prices the messages of the app read. A message
+// that reads oracle prices registers them here, for instance:
+//
+//	requirements.Register(&lendingtypes.MsgLiquidate{}, PriceRequirement{
+//		CurrencyPairs: []connecttypes.CurrencyPair{connecttypes.NewCurrencyPair("BTC", "USD")},
+//		MaxAge:        30 * time.Second,
+//	})
+func priceRequirements() PriceRequirements {
+	requirements := PriceRequirements{}
+
+	return requirements
+}
+
+// PriceReader reads the latest price of a currency pair from the oracle.
+type PriceReader interface {
+	GetPriceForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (oracletypes.QuotePrice, error)
+}
+
+// PriceFreshnessDecorator rejects a tx with ErrStalePrice if one of its
+// messages, including those executed through authz, needs a price that is
+// missing or older than the message allows at the time of the block.
+type PriceFreshnessDecorator struct {
+	requirements PriceRequirements
+	ok           PriceReader
+}
+
+func NewPriceFreshnessDecorator(requirements PriceRequirements, ok PriceReader) PriceFreshnessDecorator {
+	return PriceFreshnessDecorator{
+		requirements: requirements,
+		ok:           ok,
+	}
+}
+
+func (d PriceFreshnessDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (d PriceFreshnessDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if exec, ok := msg.(*authz.MsgExec); ok {
+			execMsgs, err := exec.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := d.checkMsgs(ctx, execMsgs); err != nil {
+				return err
+			}
+			continue
+		}
+
+		msgURL := sdk.MsgTypeURL(msg)
+		req, ok := d.requirements[msgURL]
+		if !ok {
+			continue
+		}
+
+		for _, cp := range req.CurrencyPairs {
+			price, err := d.ok.GetPriceForCurrencyPair(ctx, cp)
+			if err != nil {
+				return errorsmod.Wrapf(attestationtypes.ErrStalePrice, "%s needs a price of %s, there is none", msgURL, cp)
+			}
+
+			if age := ctx.BlockTime().Sub(price.BlockTimestamp); age > req.MaxAge {
+				return errorsmod.Wrapf(
+					attestationtypes.ErrStalePrice,
+					"%s needs a price of %s at most %s old, the latest is %s old",
+					msgURL, cp, req.MaxAge, age,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// FeeChecker checks that the fee of a tx is worth its gas.
+type FeeChecker interface {
+	CheckFee(ctx context.Context, fee sdk.Coins, gas uint64) error
+}
+
+// NewOracleTxFeeChecker returns the fee checker of the ante handler's
+// DeductFeeDecorator. On top of the node's minimum gas prices, it rejects a tx
+// whose fee isn't worth its gas limit at the USD min gas price of the
+// oraclefee module, valuing each fee denom at the latest oracle price of its
+// currency pair, or at the fallback min gas prices while a price is missing or
+// stale. It runs before the fee is deducted, and not for simulations.
+func NewOracleTxFeeChecker(fc FeeChecker) ante.TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "tx must be a FeeTx")
+		}
+		fee, gas := feeTx.GetFee(), feeTx.GetGas()
+
+		// the node's minimum gas prices only guard its own mempool, like the
+		// default fee checker they are only enforced in CheckTx.
+		if minGasPrices := ctx.MinGasPrices(); ctx.IsCheckTx() && !minGasPrices.IsZero() {
+			required := make(sdk.Coins, len(minGasPrices))
+			for i, gp := range minGasPrices {
+				required[i] = sdk.NewCoin(gp.Denom, gp.Amount.MulInt64(int64(gas)).Ceil().RoundInt()) //nolint:gosec
+			}
+			if !fee.IsAnyGTE(required) {
+				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", fee, required)
+			}
+		}
+
+		// genesis txs run before any price is written.
+		if ctx.BlockHeight() > 0 {
+			if err := fc.CheckFee(ctx, fee, gas); err != nil {
+				return nil, 0, err
+			}
+		}
+
+		return fee, txPriority(fee, gas), nil
+	}
+}
+
+// txPriority returns the priority the default fee checker gives a tx: its
+// gas price in the denom it pays the least of.
+func txPriority(fee sdk.Coins, gas uint64) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	var priority int64
+	for _, coin := range fee {
+		p := int64(math.MaxInt64)
+		if gasPrice := coin.Amount.Quo(sdkmath.NewIntFromUint64(gas)); gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+		if priority == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return priority
+}
+
+// appendAnteDecorators returns an ante handler that runs the decorators after
+// ah.
+func appendAnteDecorators(ah sdk.AnteHandler, decorators ...sdk.AnteDecorator) sdk.AnteHandler {
+	next := sdk.ChainAnteDecorators(decorators...)
+	if ah == nil {
+		return next
+	}
+
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		newCtx, err := ah(ctx, tx, simulate)
+		if err != nil {
+			return newCtx, err
+		}
+
+		return next(newCtx, tx, simulate)
+	}
+}
@@ -0,0 +1,167 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	attestationtypes "rollinky/x/attestation/types"
+	oraclefeetypes "rollinky/x/oraclefee/types"
+)
+
+func (k *mockOracleKeeper) GetPriceForCurrencyPair(_ context.Context, cp connecttypes.CurrencyPair) (oracletypes.QuotePrice, error) {
+	price, ok := k.prices[cp]
+	if !ok {
+		return oracletypes.QuotePrice{}, oracletypes.QuotePriceNotExistError{}
+	}
+	return price, nil
+}
+
+type mockTx []sdk.Msg
+
+func (tx mockTx) GetMsgs() []sdk.Msg { return tx }
+
+func (tx mockTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func TestPriceFreshnessDecorator(t *testing.T) {
+	btc := connecttypes.NewCurrencyPair("BTC", "USD")
+	eth := connecttypes.NewCurrencyPair("ETH", "USD")
+	blockTime := time.Unix(1700000000, 0).UTC()
+
+	requirements := PriceRequirements{}
+	requirements.Register(&banktypes.MsgSend{}, PriceRequirement{
+		CurrencyPairs: []connecttypes.CurrencyPair{btc, eth},
+		MaxAge:        time.Minute,
+	})
+	require.Panics(t, func() {
+		requirements.Register(&banktypes.MsgSend{}, PriceRequirement{CurrencyPairs: []connecttypes.CurrencyPair{btc}, MaxAge: time.Minute})
+	})
+	require.Panics(t, func() {
+		requirements.Register(&banktypes.MsgMultiSend{}, PriceRequirement{CurrencyPairs: []connecttypes.CurrencyPair{btc}})
+	})
+
+	ok := &mockOracleKeeper{prices: map[connecttypes.CurrencyPair]oracletypes.QuotePrice{
+		btc: {Price: math.NewInt(100), BlockTimestamp: blockTime.Add(-time.Minute)},
+	}}
+	ante := appendAnteDecorators(nil, NewPriceFreshnessDecorator(requirements, ok))
+	ctx := sdk.Context{}.WithBlockHeader(cmtproto.Header{Time: blockTime})
+
+	exec := authz.NewMsgExec(sdk.AccAddress("grantee"), []sdk.Msg{&banktypes.MsgSend{}})
+
+	for _, tc := range []struct {
+		desc   string
+		tx     mockTx
+		eth    time.Time
+		expErr string
+	}{
+		{
+			desc: "message without requirement",
+			tx:   mockTx{&banktypes.MsgMultiSend{}},
+		},
+		{
+			desc:   "missing price",
+			tx:     mockTx{&banktypes.MsgSend{}},
+			expErr: "/cosmos.bank.v1beta1.MsgSend needs a price of ETH/USD, there is none",
+		},
+		{
+			desc: "fresh prices",
+			tx:   mockTx{&banktypes.MsgSend{}},
+			eth:  blockTime,
+		},
+		{
+			desc:   "stale price",
+			tx:     mockTx{&banktypes.MsgMultiSend{}, &banktypes.MsgSend{}},
+			eth:    blockTime.Add(-time.Minute - time.Second),
+			expErr: "needs a price of ETH/USD at most 1m0s old, the latest is 1m1s old",
+		},
+		{
+			desc:   "stale price through authz",
+			tx:     mockTx{&exec},
+			eth:    blockTime.Add(-time.Hour),
+			expErr: "needs a price of ETH/USD at most 1m0s old",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			delete(ok.prices, eth)
+			if !tc.eth.IsZero() {
+				ok.prices[eth] = oracletypes.QuotePrice{Price: math.NewInt(200), BlockTimestamp: tc.eth}
+			}
+
+			_, err := ante(ctx, tc.tx, false)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, attestationtypes.ErrStalePrice)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+type mockFeeTx struct {
+	mockTx
+	fee sdk.Coins
+	gas uint64
+}
+
+func (tx mockFeeTx) GetGas() uint64     { return tx.gas }
+func (tx mockFeeTx) GetFee() sdk.Coins  { return tx.fee }
+func (tx mockFeeTx) FeePayer() []byte   { return nil }
+func (tx mockFeeTx) FeeGranter() []byte { return nil }
+
+type mockFeeChecker struct {
+	fee sdk.Coins
+	gas uint64
+	err error
+}
+
+func (c *mockFeeChecker) CheckFee(_ context.Context, fee sdk.Coins, gas uint64) error {
+	c.fee, c.gas = fee, gas
+	return c.err
+}
+
+func TestOracleTxFeeChecker(t *testing.T) {
+	tx := mockFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100_000)), gas: 200_000}
+	ctx := sdk.Context{}.WithBlockHeader(cmtproto.Header{Height: 2})
+
+	fc := &mockFeeChecker{}
+	checkFee := NewOracleTxFeeChecker(fc)
+	fee, _, err := checkFee(ctx, tx)
+	require.NoError(t, err)
+	require.Equal(t, tx.fee, fee)
+	require.Equal(t, tx.fee, fc.fee)
+	require.Equal(t, tx.gas, fc.gas)
+
+	fc.err = oraclefeetypes.ErrInsufficientFee
+	_, _, err = checkFee(ctx, tx)
+	require.ErrorIs(t, err, oraclefeetypes.ErrInsufficientFee)
+
+	// genesis txs aren't checked against oracle prices.
+	_, _, err = checkFee(ctx.WithBlockHeight(0), tx)
+	require.NoError(t, err)
+
+	// the node's minimum gas prices still apply to its mempool.
+	fc.err = nil
+	ctx = ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("0.6"))))
+	_, _, err = checkFee(ctx, tx)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	tx.fee = sdk.NewCoins(sdk.NewInt64Coin("uatom", 400_000))
+	_, priority, err := checkFee(ctx, tx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), priority)
+
+	_, _, err = checkFee(ctx, mockTx{})
+	require.ErrorIs(t, err, sdkerrors.ErrTxDecode)
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -32,6 +33,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	_ "github.com/cosmos/cosmos-sdk/x/auth" // import for side-effects
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config" // import for side-effects
@@ -81,6 +83,14 @@ import (
 	_ "github.com/skip-mev/connect/v2/x/oracle"
 	oraclekeeper "github.com/skip-mev/connect/v2/x/oracle/keeper"
 
+	attestationmodulekeeper "rollinky/x/attestation/keeper"
+	attestationtypes "rollinky/x/attestation/types"
+	oraclecircuitmodulekeeper "rollinky/x/oraclecircuit/keeper"
+	oraclefeemodulekeeper "rollinky/x/oraclefee/keeper"
+	pricealertmodulekeeper "rollinky/x/pricealert/keeper"
+	pricehistorymodulekeeper "rollinky/x/pricehistory/keeper"
+	pricelimitmodulekeeper "rollinky/x/pricelimit/keeper"
+	twapmodulekeeper "rollinky/x/twap/keeper"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 
 	"rollinky/docs"
@@ -149,6 +159,14 @@ type App struct {
 	OracleKeeper    *oraclekeeper.Keeper
 	MarketMapKeeper *marketmapkeeper.Keeper
 
+	AttestationKeeper   attestationmodulekeeper.Keeper
+	PricehistoryKeeper  pricehistorymodulekeeper.Keeper
+	TwapKeeper          twapmodulekeeper.Keeper
+	OraclecircuitKeeper oraclecircuitmodulekeeper.Keeper
+	OraclefeeKeeper     oraclefeemodulekeeper.Keeper
+	PricealertKeeper    pricealertmodulekeeper.Keeper
+	PricelimitKeeper    pricelimitmodulekeeper.Keeper
+
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// simulation manager
@@ -207,6 +225,9 @@ func New(
 		app        = &App{}
 		appBuilder *runtime.AppBuilder
 
+		// the oracle price hooks modules register, called by the PreBlocker.
+		oraclePriceHooks map[string]attestationtypes.OraclePriceHooksWrapper
+
 		// merge the AppConfig and other configuration in one config
 		appConfig = depinject.Configs(
 			AppConfig(),
@@ -289,6 +310,14 @@ func New(
 		&app.CircuitBreakerKeeper,
 		&app.MarketMapKeeper,
 		&app.OracleKeeper,
+		&app.AttestationKeeper,
+		&app.PricehistoryKeeper,
+		&app.TwapKeeper,
+		&app.OraclecircuitKeeper,
+		&app.OraclefeeKeeper,
+		&app.PricealertKeeper,
+		&app.PricelimitKeeper,
+		&oraclePriceHooks,
 		// this line is used by starport scaffolding # stargate/app/keeperDefinition
 	); err != nil {
 		panic(err)
@@ -328,19 +357,62 @@ func New(
 
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
+	attestationCfg, err := ReadAttestationConfig(appOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read attestation config: %w", err)
+	}
+
 	rh := RollkitHandler{
 		logger:  app.Logger(),
 		metrics: metrics.NewNopMetrics(),
 		ok:      app.OracleKeeper,
+		ak:      app.AttestationKeeper,
+		mmk:     app.MarketMapKeeper,
+		pl:      app.PricelimitKeeper,
+		hooks:   newOraclePriceHooks(oraclePriceHooks),
+
+		signerIDs:      attestationCfg.DecodedSignerIDs(),
+		attestationCfg: attestationCfg,
 	}
+	if attestationCfg.Metrics {
+		rh.metrics = metrics.NewMetrics(app.ChainID())
+	}
+
+	switch mode, _ := appOpts.Get("oracle-mode").(string); mode {
+	case "", OracleModeRollkit:
+		if err := attestationCfg.RequireSignerIDs(); err != nil {
+			return nil, err
+		}
+		app.App.SetPreBlocker(rh.PreBlocker(app.ModuleManager))
 
-	signerId := appOpts.Get("signer-id").(string)
-	if signerId == "" {
-		panic("signer-id is required")
+		app.App.SetPrepareProposal(baseapp.NoOpPrepareProposal())
+	case OracleModeVoteExtensions:
+		if err := app.setVoteExtensionHandlers(&rh, appOpts); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("invalid oracle mode %q, expected %q or %q", mode, OracleModeRollkit, OracleModeVoteExtensions)
 	}
-	app.App.SetPreBlocker(rh.PreBlocker(app.ModuleManager, signerId))
 
-	app.App.SetPrepareProposal(baseapp.NoOpPrepareProposal())
+	// the tx module's ante handler takes no fee checker, it is built here
+	// instead. It rejects txs whose fee isn't worth their gas at oracle prices
+	// before deducting it, and txs with messages that read oracle prices while
+	// those are stale.
+	anteHandler, err := ante.NewAnteHandler(ante.HandlerOptions{
+		AccountKeeper:   app.AccountKeeper,
+		BankKeeper:      app.BankKeeper,
+		SignModeHandler: app.txConfig.SignModeHandler(),
+		FeegrantKeeper:  app.FeeGrantKeeper,
+		SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+		TxFeeChecker:    NewOracleTxFeeChecker(app.OraclefeeKeeper),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ante handler: %w", err)
+	}
+	app.App.SetAnteHandler(appendAnteDecorators(
+		anteHandler,
+		NewPriceFreshnessDecorator(priceRequirements(), app.OracleKeeper),
+	))
 
 	// Register legacy modules
 	if err := app.registerIBCModules(appOpts); err != nil {
@@ -57,6 +57,28 @@ import (
 	oraclemodulev1 "github.com/skip-mev/connect/v2/api/connect/oracle/module/v2"
 	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+
+	attestationmodulev1 "rollinky/api/rollinky/attestation/module"
+	oraclecircuitmodulev1 "rollinky/api/rollinky/oraclecircuit/module"
+	oraclefeemodulev1 "rollinky/api/rollinky/oraclefee/module"
+	pricealertmodulev1 "rollinky/api/rollinky/pricealert/module"
+	pricehistorymodulev1 "rollinky/api/rollinky/pricehistory/module"
+	pricelimitmodulev1 "rollinky/api/rollinky/pricelimit/module"
+	twapmodulev1 "rollinky/api/rollinky/twap/module"
+	_ "rollinky/x/attestation/module" // import for side-effects
+	attestationmoduletypes "rollinky/x/attestation/types"
+	_ "rollinky/x/oraclecircuit/module" // import for side-effects
+	oraclecircuitmoduletypes "rollinky/x/oraclecircuit/types"
+	_ "rollinky/x/oraclefee/module" // import for side-effects
+	oraclefeemoduletypes "rollinky/x/oraclefee/types"
+	_ "rollinky/x/pricealert/module" // import for side-effects
+	pricealertmoduletypes "rollinky/x/pricealert/types"
+	_ "rollinky/x/pricehistory/module" // import for side-effects
+	pricehistorymoduletypes "rollinky/x/pricehistory/types"
+	_ "rollinky/x/pricelimit/module" // import for side-effects
+	pricelimitmoduletypes "rollinky/x/pricelimit/types"
+	_ "rollinky/x/twap/module" // import for side-effects
+	twapmoduletypes "rollinky/x/twap/types"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 )
 
@@ -97,6 +119,13 @@ var (
 		oracletypes.ModuleName,
 		// market map genesis must be called AFTER all consuming modules (i.e. x/oracle, etc.)
 		marketmaptypes.ModuleName,
+		attestationmoduletypes.ModuleName,
+		pricehistorymoduletypes.ModuleName,
+		twapmoduletypes.ModuleName,
+		oraclecircuitmoduletypes.ModuleName,
+		oraclefeemoduletypes.ModuleName,
+		pricealertmoduletypes.ModuleName,
+		pricelimitmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 	}
 
@@ -123,6 +152,13 @@ var (
 		// chain modules
 		oracletypes.ModuleName,
 		marketmaptypes.ModuleName,
+		attestationmoduletypes.ModuleName,
+		pricehistorymoduletypes.ModuleName,
+		twapmoduletypes.ModuleName,
+		oraclecircuitmoduletypes.ModuleName,
+		oraclefeemoduletypes.ModuleName,
+		pricealertmoduletypes.ModuleName,
+		pricelimitmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/beginBlockers
 	}
 
@@ -143,6 +179,13 @@ var (
 		// chain modules
 		oracletypes.ModuleName,
 		marketmaptypes.ModuleName,
+		attestationmoduletypes.ModuleName,
+		pricehistorymoduletypes.ModuleName,
+		twapmoduletypes.ModuleName,
+		oraclecircuitmoduletypes.ModuleName,
+		oraclefeemoduletypes.ModuleName,
+		pricealertmoduletypes.ModuleName,
+		pricelimitmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/endBlockers
 	}
 
@@ -163,6 +206,7 @@ var (
 		{Account: ibctransfertypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: ibcfeetypes.ModuleName},
 		{Account: icatypes.ModuleName},
+		{Account: pricealertmoduletypes.ModuleName},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 
@@ -244,8 +288,9 @@ var (
 				Config: appconfig.WrapAny(&paramsmodulev1.Module{}),
 			},
 			{
-				Name:   "tx",
-				Config: appconfig.WrapAny(&txconfigv1.Config{}),
+				Name: "tx",
+				// the ante handler is set in app.go, with the oracle fee checker.
+				Config: appconfig.WrapAny(&txconfigv1.Config{SkipAnteHandler: true}),
 			},
 			{
 				Name:   genutiltypes.ModuleName,
@@ -308,6 +353,34 @@ var (
 					Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 				}),
 			},
+			{
+				Name:   attestationmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&attestationmodulev1.Module{}),
+			},
+			{
+				Name:   pricehistorymoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&pricehistorymodulev1.Module{}),
+			},
+			{
+				Name:   twapmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&twapmodulev1.Module{}),
+			},
+			{
+				Name:   oraclecircuitmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&oraclecircuitmodulev1.Module{}),
+			},
+			{
+				Name:   oraclefeemoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&oraclefeemodulev1.Module{}),
+			},
+			{
+				Name:   pricealertmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&pricealertmodulev1.Module{}),
+			},
+			{
+				Name:   pricelimitmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&pricelimitmodulev1.Module{}),
+			},
 			// this line is used by starport scaffolding # stargate/app/moduleConfig
 		},
 	})
@@ -0,0 +1,246 @@
+package app
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+
+	attestationtypes "rollinky/x/attestation/types"
+)
+
+// The keys of the [rollinky.attestation] section of app.toml. Every key can
+// also be set with the flag of the same name, or with an environment variable
+// named after it, e.g. ROLLINKYD_ROLLINKY_ATTESTATION_SIGNER_IDS.
+const (
+	FlagAttestationSignerIDs           = "rollinky.attestation.signer-ids"
+	FlagAttestationAllowedTCBStatuses  = "rollinky.attestation.allowed-tcb-statuses"
+	FlagAttestationMaxReportAge        = "rollinky.attestation.max-report-age"
+	FlagAttestationMissingPrices       = "rollinky.attestation.missing-prices"
+	FlagAttestationCollateralCachePath = "rollinky.attestation.collateral-cache-path"
+	FlagAttestationMetrics             = "rollinky.attestation.metrics"
+
+	// flagSignerID is the flag the signer ID was set with before the
+	// [rollinky.attestation] section, it still takes precedence over it.
+	flagSignerID = "signer-id"
+)
+
+// The missing prices policy selects how a block without a price for an
+// enabled market is treated.
+const (
+	// MissingPricesParams follows the fail_missing_prices attestation param.
+	MissingPricesParams = ""
+	// MissingPricesSkip skips the pair and writes the other prices.
+	MissingPricesSkip = "skip"
+	// MissingPricesFail counts the missing price as a failure towards the max
+	// price failure ratio.
+	MissingPricesFail = "fail"
+)
+
+// signerIDSize is the size of an enclave signer ID (MRSIGNER), the SHA-256
+// hash of the enclave signing key's modulus.
+const signerIDSize = 32
+
+// AttestationConfigTemplate is the [rollinky.attestation] section of app.toml.
+const AttestationConfigTemplate = `
+
+###############################################################################
+###                          Rollinky Attestation                           ###
+###############################################################################
+[rollinky.attestation]
+# Signer IDs (MRSIGNER) of the sidecar enclaves whose reports are accepted, hex
+# encoded 32 byte values. At least one is required in the rollkit oracle mode.
+signer-ids = [{{ range $i, $id := .Rollinky.Attestation.SignerIDs }}{{ if $i }}, {{ end }}"{{ $id }}"{{ end }}]
+
+# The verification and missing prices policies below override the attestation
+# params of the chain. Every node must reach the same verdict for a block, so
+# only set them if every node of the chain sets them the same. Leave them unset
+# to follow the params.
+
+# TCB statuses a report's platform may have, e.g. ["UpToDate"]. Empty follows
+# the allowed_tcb_statuses param.
+allowed-tcb-statuses = [{{ range $i, $status := .Rollinky.Attestation.AllowedTCBStatuses }}{{ if $i }}, {{ end }}"{{ $status }}"{{ end }}]
+
+# How far from the block time an enclave report may have been created. Zero
+# follows the max_report_age param.
+max-report-age = "{{ .Rollinky.Attestation.MaxReportAge }}"
+
+# What a block without a price for an enabled market does: "skip" writes the
+# other prices, "fail" counts it towards the max price failure ratio. Empty
+# follows the fail_missing_prices param.
+missing-prices = "{{ .Rollinky.Attestation.MissingPrices }}"
+
+# File the node keeps a copy of the DCAP collateral pinned in state in, updated
+# whenever governance pins another one. Empty keeps no copy.
+collateral-cache-path = "{{ .Rollinky.Attestation.CollateralCachePath }}"
+
+# Whether to export the oracle price and PreBlocker metrics to Prometheus.
+metrics = {{ .Rollinky.Attestation.Metrics }}
+`
+
+// RollinkyConfig is the [rollinky] section of app.toml.
+type RollinkyConfig struct {
+	Attestation AttestationConfig `mapstructure:"attestation" json:"attestation"`
+}
+
+// AttestationConfig configures how the node verifies the oracle prices the
+// sequencer attests.
+type AttestationConfig struct {
+	// SignerIDs are the hex encoded signer IDs of the accepted enclaves.
+	SignerIDs []string `mapstructure:"signer-ids" json:"signer-ids"`
+	// AllowedTCBStatuses, if set, override the allowed TCB statuses param.
+	AllowedTCBStatuses []string `mapstructure:"allowed-tcb-statuses" json:"allowed-tcb-statuses"`
+	// MaxReportAge, if set, overrides the max report age param.
+	MaxReportAge time.Duration `mapstructure:"max-report-age" json:"max-report-age"`
+	// MissingPrices, if set, overrides the fail missing prices param.
+	MissingPrices string `mapstructure:"missing-prices" json:"missing-prices"`
+	// CollateralCachePath is the file the pinned collateral is copied to.
+	CollateralCachePath string `mapstructure:"collateral-cache-path" json:"collateral-cache-path"`
+	// Metrics enables the oracle metrics.
+	Metrics bool `mapstructure:"metrics" json:"metrics"`
+}
+
+// DefaultAttestationConfig returns the default [rollinky.attestation] section.
+func DefaultAttestationConfig() AttestationConfig {
+	return AttestationConfig{}
+}
+
+// ReadAttestationConfig reads the [rollinky.attestation] section from the app
+// options, with the signer-id flag taking precedence, and validates it.
+func ReadAttestationConfig(opts servertypes.AppOptions) (AttestationConfig, error) {
+	cfg := DefaultAttestationConfig()
+
+	var err error
+	if v := opts.Get(FlagAttestationSignerIDs); v != nil {
+		if cfg.SignerIDs, err = toStringSlice(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagAttestationSignerIDs, err)
+		}
+	}
+	if v := opts.Get(FlagAttestationAllowedTCBStatuses); v != nil {
+		if cfg.AllowedTCBStatuses, err = toStringSlice(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagAttestationAllowedTCBStatuses, err)
+		}
+	}
+	if v := opts.Get(FlagAttestationMaxReportAge); v != nil {
+		if cfg.MaxReportAge, err = cast.ToDurationE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagAttestationMaxReportAge, err)
+		}
+	}
+	if v := opts.Get(FlagAttestationMissingPrices); v != nil {
+		if cfg.MissingPrices, err = cast.ToStringE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagAttestationMissingPrices, err)
+		}
+	}
+	if v := opts.Get(FlagAttestationCollateralCachePath); v != nil {
+		if cfg.CollateralCachePath, err = cast.ToStringE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagAttestationCollateralCachePath, err)
+		}
+	}
+	if v := opts.Get(FlagAttestationMetrics); v != nil {
+		if cfg.Metrics, err = cast.ToBoolE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagAttestationMetrics, err)
+		}
+	}
+
+	// the flag predating the section.
+	if signerID, _ := opts.Get(flagSignerID).(string); signerID != "" {
+		cfg.SignerIDs = []string{signerID}
+	}
+
+	return cfg, cfg.Validate()
+}
+
+// Validate checks the [rollinky.attestation] section. Signer IDs are only
+// required by the rollkit oracle mode, see RequireSignerIDs.
+func (c AttestationConfig) Validate() error {
+	for _, id := range c.SignerIDs {
+		bz, err := hex.DecodeString(id)
+		if err != nil || id == "" {
+			return fmt.Errorf("invalid %s: signer ID %q is not hex encoded", FlagAttestationSignerIDs, id)
+		}
+		if len(bz) != signerIDSize {
+			return fmt.Errorf("invalid %s: signer ID %q is %d bytes long, expected %d", FlagAttestationSignerIDs, id, len(bz), signerIDSize)
+		}
+	}
+
+	for _, status := range c.AllowedTCBStatuses {
+		if !attestationtypes.IsTCBStatus(status) {
+			return fmt.Errorf("invalid %s: unknown TCB status %q", FlagAttestationAllowedTCBStatuses, status)
+		}
+	}
+
+	if c.MaxReportAge < 0 {
+		return fmt.Errorf("invalid %s: %s must not be negative", FlagAttestationMaxReportAge, c.MaxReportAge)
+	}
+
+	switch c.MissingPrices {
+	case MissingPricesParams, MissingPricesSkip, MissingPricesFail:
+	default:
+		return fmt.Errorf("invalid %s %q, expected %q or %q", FlagAttestationMissingPrices, c.MissingPrices, MissingPricesSkip, MissingPricesFail)
+	}
+
+	return nil
+}
+
+// ApplyTo returns the attestation params with the policies the section sets
+// in place of theirs.
+func (c AttestationConfig) ApplyTo(params attestationtypes.Params) attestationtypes.Params {
+	if len(c.AllowedTCBStatuses) > 0 {
+		params.AllowedTcbStatuses = c.AllowedTCBStatuses
+	}
+	if c.MaxReportAge > 0 {
+		params.MaxReportAge = c.MaxReportAge
+	}
+	switch c.MissingPrices {
+	case MissingPricesSkip:
+		params.FailMissingPrices = false
+	case MissingPricesFail:
+		params.FailMissingPrices = true
+	}
+
+	return params
+}
+
+// RequireSignerIDs checks that at least one signer ID is set.
+func (c AttestationConfig) RequireSignerIDs() error {
+	if len(c.SignerIDs) == 0 {
+		return fmt.Errorf("%s is required in the %q oracle mode: set it in app.toml, with --%s or with --%s",
+			FlagAttestationSignerIDs, OracleModeRollkit, FlagAttestationSignerIDs, flagSignerID)
+	}
+
+	return nil
+}
+
+// DecodedSignerIDs returns the signer IDs, which must be valid.
+func (c AttestationConfig) DecodedSignerIDs() [][]byte {
+	ids := make([][]byte, len(c.SignerIDs))
+	for i, id := range c.SignerIDs {
+		ids[i], _ = hex.DecodeString(id)
+	}
+
+	return ids
+}
+
+// toStringSlice reads a list from app.toml, or a comma separated list from a
+// flag or an environment variable. The server sets the flags it binds from
+// app.toml with the values formatted by fmt, so a list also reads as a flag
+// value like "[ab cd]".
+func toStringSlice(v interface{}) ([]string, error) {
+	items, err := cast.ToStringSliceE(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []string
+	for _, item := range items {
+		list = append(list, strings.FieldsFunc(strings.Trim(item, "[]"), func(r rune) bool {
+			return r == ',' || unicode.IsSpace(r)
+		})...)
+	}
+
+	return list, nil
+}
@@ -0,0 +1,164 @@
+package app
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/stretchr/testify/require"
+
+	attestationtypes "rollinky/x/attestation/types"
+)
+
+func TestReadAttestationConfig(t *testing.T) {
+	var (
+		idAB = strings.Repeat("ab", signerIDSize)
+		idCD = strings.Repeat("cd", signerIDSize)
+		idEF = strings.Repeat("ef", signerIDSize)
+	)
+
+	testCases := []struct {
+		name string
+		opts simtestutil.AppOptionsMap
+		cfg  AttestationConfig
+		err  string
+	}{
+		{
+			name: "defaults",
+			opts: simtestutil.AppOptionsMap{},
+			cfg:  DefaultAttestationConfig(),
+		},
+		{
+			name: "app.toml",
+			opts: simtestutil.AppOptionsMap{
+				FlagAttestationSignerIDs:           []interface{}{idAB, idCD},
+				FlagAttestationAllowedTCBStatuses:  []interface{}{"UpToDate", "SWHardeningNeeded"},
+				FlagAttestationMaxReportAge:        "30s",
+				FlagAttestationMissingPrices:       MissingPricesFail,
+				FlagAttestationCollateralCachePath: "/var/lib/rollinky/collateral.json",
+				FlagAttestationMetrics:             true,
+			},
+			cfg: AttestationConfig{
+				SignerIDs:           []string{idAB, idCD},
+				AllowedTCBStatuses:  []string{"UpToDate", "SWHardeningNeeded"},
+				MaxReportAge:        30 * time.Second,
+				MissingPrices:       MissingPricesFail,
+				CollateralCachePath: "/var/lib/rollinky/collateral.json",
+				Metrics:             true,
+			},
+		},
+		{
+			name: "flags and env vars",
+			opts: simtestutil.AppOptionsMap{
+				FlagAttestationSignerIDs:    idAB + "," + idCD,
+				FlagAttestationMaxReportAge: "1m",
+			},
+			cfg: func() AttestationConfig {
+				cfg := DefaultAttestationConfig()
+				cfg.SignerIDs = []string{idAB, idCD}
+				cfg.MaxReportAge = time.Minute
+				return cfg
+			}(),
+		},
+		{
+			name: "flag set from app.toml",
+			opts: simtestutil.AppOptionsMap{
+				FlagAttestationSignerIDs: []string{"[" + idAB + " " + idCD + "]"},
+			},
+			cfg: func() AttestationConfig {
+				cfg := DefaultAttestationConfig()
+				cfg.SignerIDs = []string{idAB, idCD}
+				return cfg
+			}(),
+		},
+		{
+			name: "legacy flag takes precedence",
+			opts: simtestutil.AppOptionsMap{
+				FlagAttestationSignerIDs: []interface{}{idAB},
+				flagSignerID:             idEF,
+			},
+			cfg: func() AttestationConfig {
+				cfg := DefaultAttestationConfig()
+				cfg.SignerIDs = []string{idEF}
+				return cfg
+			}(),
+		},
+		{
+			name: "signer ID not hex",
+			opts: simtestutil.AppOptionsMap{FlagAttestationSignerIDs: "zz"},
+			err:  `signer ID "zz" is not hex encoded`,
+		},
+		{
+			name: "signer ID not 32 bytes",
+			opts: simtestutil.AppOptionsMap{FlagAttestationSignerIDs: "abcd"},
+			err:  `signer ID "abcd" is 2 bytes long, expected 32`,
+		},
+		{
+			name: "unknown TCB status",
+			opts: simtestutil.AppOptionsMap{FlagAttestationAllowedTCBStatuses: "UpToDate,uptodate"},
+			err:  `unknown TCB status "uptodate"`,
+		},
+		{
+			name: "negative max report age",
+			opts: simtestutil.AppOptionsMap{FlagAttestationMaxReportAge: "-1s"},
+			err:  "-1s must not be negative",
+		},
+		{
+			name: "max report age not a duration",
+			opts: simtestutil.AppOptionsMap{FlagAttestationMaxReportAge: "soon"},
+			err:  "invalid rollinky.attestation.max-report-age",
+		},
+		{
+			name: "unknown missing prices policy",
+			opts: simtestutil.AppOptionsMap{FlagAttestationMissingPrices: "drop"},
+			err:  `invalid rollinky.attestation.missing-prices "drop"`,
+		},
+		{
+			name: "metrics not a bool",
+			opts: simtestutil.AppOptionsMap{FlagAttestationMetrics: "maybe"},
+			err:  "invalid rollinky.attestation.metrics",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := ReadAttestationConfig(tc.opts)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.cfg, cfg)
+		})
+	}
+}
+
+func TestAttestationConfigRequireSignerIDs(t *testing.T) {
+	cfg := DefaultAttestationConfig()
+	require.ErrorContains(t, cfg.RequireSignerIDs(), "rollinky.attestation.signer-ids is required")
+
+	cfg.SignerIDs = []string{strings.Repeat("ab", signerIDSize)}
+	require.NoError(t, cfg.RequireSignerIDs())
+	require.Equal(t, [][]byte{bytes.Repeat([]byte{0xab}, signerIDSize)}, cfg.DecodedSignerIDs())
+}
+
+func TestAttestationConfigApplyTo(t *testing.T) {
+	params := attestationtypes.DefaultParams()
+	params.FailMissingPrices = true
+
+	// an unset policy follows the params.
+	require.Equal(t, params, DefaultAttestationConfig().ApplyTo(params))
+
+	cfg := AttestationConfig{
+		AllowedTCBStatuses: []string{"SWHardeningNeeded"},
+		MaxReportAge:       time.Hour,
+		MissingPrices:      MissingPricesSkip,
+	}
+	applied := cfg.ApplyTo(params)
+	require.Equal(t, []string{"SWHardeningNeeded"}, applied.AllowedTcbStatuses)
+	require.Equal(t, time.Hour, applied.MaxReportAge)
+	require.False(t, applied.FailMissingPrices)
+	require.Equal(t, params.MaxPriceFailureRatio, applied.MaxPriceFailureRatio)
+}
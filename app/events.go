@@ -0,0 +1,122 @@
+package app
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashicorp/go-metrics"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+
+	attestationtypes "rollinky/x/attestation/types"
+)
+
+// The PreBlocker emits an EventPriceUpdated or EventPriceSkipped event for
+// every currency pair of the oracle module, and an EventPriceSkipped for every
+// malformed pair in the block. The reason of a skipped price is one of these.
+const (
+	// The block carried no price the chain wanted for the pair.
+	PriceReasonMarketNotFound        = "market_not_found"
+	PriceReasonMarketDisabled        = "market_disabled"
+	PriceReasonNoPrice               = "no_price"
+	PriceReasonInsufficientProviders = "insufficient_providers"
+
+	// The price moved further than the limits of the pair allow, it is
+	// withheld until further updates confirm the move.
+	PriceReasonWithheld = "price_withheld"
+
+	// The block carried a price that breaks the market's rules or is
+	// malformed, these count as failures.
+	PriceReasonNegativePrice       = "negative_price"
+	PriceReasonInvalidCurrencyPair = "invalid_currency_pair"
+	PriceReasonInvalidPrice        = "invalid_price"
+	PriceReasonWriteFailed         = "write_failed"
+
+	// The sidecar didn't attest aggregating the price with the on-chain ticker
+	// of the pair: the pair isn't in its market map, the price is scaled to
+	// other decimals, or it required fewer providers. These count as failures.
+	PriceReasonDecimalsUnattested       = "decimals_unattested"
+	PriceReasonDecimalsMismatch         = "decimals_mismatch"
+	PriceReasonMinProviderCountMismatch = "min_provider_count_mismatch"
+)
+
+// The PreBlocker emits an EventOracleUpdateMissed event when a block writes no
+// prices at all, with one of these reasons.
+const (
+	UpdateReasonNoTxs                 = "no_txs"
+	UpdateReasonNoEnvelope            = "no_envelope"
+	UpdateReasonInvalidEnvelope       = "invalid_envelope"
+	UpdateReasonInvalidReport         = "invalid_report"
+	UpdateReasonStaleReport           = "stale_report"
+	UpdateReasonConfigNotApproved     = "config_not_approved"
+	UpdateReasonInvalidPrices         = "invalid_prices"
+	UpdateReasonInvalidProviderPrices = "invalid_provider_prices"
+	UpdateReasonInvalidMarketMap      = "invalid_market_map"
+	UpdateReasonTooManyFailures       = "too_many_failures"
+	UpdateReasonUnknown               = "unknown"
+
+	// No DCAP collateral is pinned in state to verify the report against.
+	UpdateReasonNoCollateral = "no_collateral"
+
+	// The report's platform has a TCB status the node doesn't accept.
+	UpdateReasonTCBStatusNotAllowed = "tcb_status_not_allowed"
+
+	// The envelope wasn't signed by the chain's sequencer, or was signed for
+	// a height already used or not reached yet.
+	UpdateReasonInvalidSequencerSignature = "invalid_sequencer_signature"
+	UpdateReasonInvalidSequencerHeight    = "invalid_sequencer_height"
+
+	// In vote extensions mode, vote extensions aren't enabled yet, the block
+	// carries no valid extended commit, or its votes can't be aggregated.
+	UpdateReasonVoteExtensionsDisabled = "vote_extensions_disabled"
+	UpdateReasonInvalidVoteExtensions  = "invalid_vote_extensions"
+	UpdateReasonAggregationFailed      = "aggregation_failed"
+)
+
+// emitPriceUpdated emits that the price of a currency pair was written.
+func (h *RollkitHandler) emitPriceUpdated(ctx sdk.Context, cp connecttypes.CurrencyPair, price *big.Int, attestationHash string) {
+	attestationtypes.EmitEvent(ctx, h.logger, &attestationtypes.EventPriceUpdated{
+		CurrencyPair:    cp.String(),
+		Price:           math.NewIntFromBigInt(price),
+		BlockHeight:     uint64(ctx.BlockHeight()), //nolint:gosec
+		BlockTime:       ctx.BlockTime(),
+		AttestationHash: attestationHash,
+	})
+}
+
+// skipPrice emits that the price of a currency pair was not written.
+func (h *RollkitHandler) skipPrice(ctx sdk.Context, pair, reason string) {
+	attestationtypes.EmitEvent(ctx, h.logger, &attestationtypes.EventPriceSkipped{
+		CurrencyPair: pair,
+		Reason:       reason,
+	})
+}
+
+// rejectPrice emits that the price of a currency pair was rejected and counts
+// it in the price failure metric.
+func (h *RollkitHandler) rejectPrice(ctx sdk.Context, pair, reason string) {
+	h.skipPrice(ctx, pair, reason)
+	telemetry.IncrCounterWithLabels(
+		[]string{"oracle", "price", "failures"}, 1,
+		[]metrics.Label{telemetry.NewLabel("reason", reason)},
+	)
+}
+
+// missUpdate emits that the block writes no prices. err is nil if the block
+// simply carried no oracle update.
+func (h *RollkitHandler) missUpdate(ctx sdk.Context, reason string, err error) {
+	event := &attestationtypes.EventOracleUpdateMissed{
+		BlockHeight: uint64(ctx.BlockHeight()), //nolint:gosec
+		Reason:      reason,
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+
+	attestationtypes.EmitEvent(ctx, h.logger, event)
+	telemetry.IncrCounterWithLabels(
+		[]string{"oracle", "update", "missed"}, 1,
+		[]metrics.Label{telemetry.NewLabel("reason", reason)},
+	)
+}
@@ -0,0 +1,106 @@
+package app
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashicorp/go-metrics"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+
+	attestationtypes "rollinky/x/attestation/types"
+)
+
+// oraclePriceHook is the OraclePriceHooks a module registered.
+type oraclePriceHook struct {
+	module string
+	hooks  attestationtypes.OraclePriceHooks
+}
+
+// newOraclePriceHooks orders the hooks registered through depinject by module
+// name, so that every node calls them in the same order.
+func newOraclePriceHooks(wrappers map[string]attestationtypes.OraclePriceHooksWrapper) []oraclePriceHook {
+	hooks := make([]oraclePriceHook, 0, len(wrappers))
+	for module, wrapper := range wrappers {
+		hooks = append(hooks, oraclePriceHook{module: module, hooks: wrapper.OraclePriceHooks})
+	}
+	sort.Slice(hooks, func(i, j int) bool { return hooks[i].module < hooks[j].module })
+
+	return hooks
+}
+
+// runPriceHooks calls the oracle price hooks once the prices of the block
+// are written: AfterPricesUpdated with the written prices, if any, then
+// AfterPriceMissed for every other currency pair of the oracle module.
+func (h *RollkitHandler) runPriceHooks(ctx sdk.Context, written map[connecttypes.CurrencyPair]*big.Int) {
+	if len(h.hooks) == 0 {
+		return
+	}
+
+	if len(written) > 0 {
+		prices := make(map[connecttypes.CurrencyPair]oracletypes.QuotePrice, len(written))
+		for cp, price := range written {
+			prices[cp] = oracletypes.QuotePrice{
+				Price:          math.NewIntFromBigInt(price),
+				BlockTimestamp: ctx.BlockHeader().Time,
+				BlockHeight:    uint64(ctx.BlockHeight()), //nolint:gosec
+			}
+		}
+
+		for _, hook := range h.hooks {
+			h.callHook(ctx, hook, "after_prices_updated", func(ctx sdk.Context) error {
+				return hook.hooks.AfterPricesUpdated(ctx, prices)
+			})
+		}
+	}
+
+	for _, cp := range h.ok.GetAllCurrencyPairs(ctx) {
+		if _, ok := written[cp]; ok {
+			continue
+		}
+
+		for _, hook := range h.hooks {
+			h.callHook(ctx, hook, "after_price_missed", func(ctx sdk.Context) error {
+				return hook.hooks.AfterPriceMissed(ctx, cp)
+			})
+		}
+	}
+}
+
+// callHook calls a hook on a cache, so that a hook that fails or panics only
+// reverts its own state changes. Its error is logged and counted in the hook
+// failure metric, it never fails the block.
+func (h *RollkitHandler) callHook(ctx sdk.Context, hook oraclePriceHook, name string, call func(ctx sdk.Context) error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+
+		return call(cacheCtx)
+	}()
+	if err != nil {
+		h.logger.Error(
+			"oracle price hook failed",
+			"height", ctx.BlockHeight(),
+			"module", hook.module,
+			"hook", name,
+			"err", err,
+		)
+		telemetry.IncrCounterWithLabels(
+			[]string{"oracle", "hook", "failures"}, 1,
+			[]metrics.Label{telemetry.NewLabel("module", hook.module), telemetry.NewLabel("hook", name)},
+		)
+
+		return
+	}
+
+	writeCache()
+}
@@ -0,0 +1,139 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	"github.com/stretchr/testify/require"
+
+	attestationtypes "rollinky/x/attestation/types"
+)
+
+// mockPriceHooks writes every call to the store under its name, then fails or
+// panics if told to.
+type mockPriceHooks struct {
+	name  string
+	key   storetypes.StoreKey
+	err   error
+	panic bool
+	calls *[]string
+}
+
+func (h mockPriceHooks) call(ctx context.Context, call string) error {
+	*h.calls = append(*h.calls, h.name+" "+call)
+	sdk.UnwrapSDKContext(ctx).KVStore(h.key).Set([]byte(h.name+" "+call), []byte{1})
+	if h.panic {
+		panic("hook panicked")
+	}
+
+	return h.err
+}
+
+func (h mockPriceHooks) AfterPricesUpdated(ctx context.Context, prices map[connecttypes.CurrencyPair]oracletypes.QuotePrice) error {
+	for _, cp := range attestationtypes.SortedCurrencyPairs(prices) {
+		if err := h.call(ctx, "updated "+cp.String()+"="+prices[cp].Price.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h mockPriceHooks) AfterPriceMissed(ctx context.Context, cp connecttypes.CurrencyPair) error {
+	return h.call(ctx, "missed "+cp.String())
+}
+
+func TestRunPriceHooks(t *testing.T) {
+	key := storetypes.NewKVStoreKey("hooks")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient")).
+		WithBlockHeader(cmtproto.Header{Height: 10, Time: time.Unix(1700000000, 0).UTC()})
+
+	btc, eth, sol := connecttypes.NewCurrencyPair("BTC", "USD"), connecttypes.NewCurrencyPair("ETH", "USD"), connecttypes.NewCurrencyPair("SOL", "USD")
+	var calls []string
+	h := &RollkitHandler{
+		logger: log.NewNopLogger(),
+		ok: &mockOracleKeeper{
+			pairs: []connecttypes.CurrencyPair{btc, eth, sol},
+		},
+		hooks: newOraclePriceHooks(map[string]attestationtypes.OraclePriceHooksWrapper{
+			"c": {OraclePriceHooks: mockPriceHooks{name: "c", key: key, calls: &calls}},
+			"a": {OraclePriceHooks: mockPriceHooks{name: "a", key: key, calls: &calls, err: errors.New("failed")}},
+			"b": {OraclePriceHooks: mockPriceHooks{name: "b", key: key, calls: &calls, panic: true}},
+		}),
+	}
+
+	h.runPriceHooks(ctx, map[connecttypes.CurrencyPair]*big.Int{
+		btc: big.NewInt(100),
+		eth: big.NewInt(200),
+	})
+
+	// hooks are called in module order, each failing hook only stops itself.
+	require.Equal(t, []string{
+		"a updated BTC/USD=100",
+		"b updated BTC/USD=100",
+		"c updated BTC/USD=100",
+		"c updated ETH/USD=200",
+		"a missed SOL/USD",
+		"b missed SOL/USD",
+		"c missed SOL/USD",
+	}, calls)
+
+	// only the writes of the hooks that succeeded are kept.
+	store := ctx.KVStore(key)
+	for _, call := range calls {
+		require.Equal(t, call[0] == 'c', store.Has([]byte(call)), call)
+	}
+
+	// a block without prices misses every pair.
+	calls = calls[:0]
+	h.hooks = h.hooks[2:]
+	h.runPriceHooks(ctx, nil)
+	require.Equal(t, []string{"c missed BTC/USD", "c missed ETH/USD", "c missed SOL/USD"}, calls)
+}
+
+func TestPriceHookPrices(t *testing.T) {
+	var got map[connecttypes.CurrencyPair]oracletypes.QuotePrice
+	blockTime := time.Unix(1700000000, 0).UTC()
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("hooks"), storetypes.NewTransientStoreKey("transient")).
+		WithBlockHeader(cmtproto.Header{Height: 10, Time: blockTime})
+
+	h := &RollkitHandler{
+		logger: log.NewNopLogger(),
+		ok:     &mockOracleKeeper{},
+		hooks:  []oraclePriceHook{{module: "m", hooks: recordingPriceHooks{&got}}},
+	}
+	h.runPriceHooks(ctx, map[connecttypes.CurrencyPair]*big.Int{connecttypes.NewCurrencyPair("BTC", "USD"): big.NewInt(100)})
+
+	require.Equal(t, map[connecttypes.CurrencyPair]oracletypes.QuotePrice{
+		connecttypes.NewCurrencyPair("BTC", "USD"): {
+			Price:          math.NewInt(100),
+			BlockTimestamp: blockTime,
+			BlockHeight:    10,
+		},
+	}, got)
+}
+
+// recordingPriceHooks keeps the prices it is called with.
+type recordingPriceHooks struct {
+	prices *map[connecttypes.CurrencyPair]oracletypes.QuotePrice
+}
+
+func (h recordingPriceHooks) AfterPricesUpdated(_ context.Context, prices map[connecttypes.CurrencyPair]oracletypes.QuotePrice) error {
+	*h.prices = prices
+	return nil
+}
+
+func (recordingPriceHooks) AfterPriceMissed(context.Context, connecttypes.CurrencyPair) error {
+	return nil
+}
@@ -1,9 +1,15 @@
 package app
 
 import (
+	"context"
+	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
+	"os"
+	"slices"
+	"sort"
 	"time"
 
 	"cosmossdk.io/log"
@@ -16,9 +22,12 @@ import (
 	connectabcitypes "github.com/skip-mev/connect/v2/abci/types"
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 	servicemetrics "github.com/skip-mev/connect/v2/service/metrics"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 
 	sequencerutils "github.com/facundomedica/rollinky/sequencer/utils"
+	"github.com/facundomedica/rollinky/sequencer/utils/dcap"
+	attestationtypes "rollinky/x/attestation/types"
 )
 
 type RollkitHandler struct {
@@ -27,14 +36,103 @@ type RollkitHandler struct {
 	metrics servicemetrics.Metrics
 	// ok is the oracle keeper that is used to write prices to state.
 	ok connectabcitypes.OracleKeeper
+	// ak checks the sidecar config attested alongside the prices and stores
+	// the dispersion of the provider prices and the attestation records.
+	ak AttestationKeeper
+	// mmk is the market map keeper, used to check prices against their market's
+	// ticker and to recompute prices from the provider prices.
+	mmk MarketMapKeeper
+	// pl withholds prices that move further than the limits of their pair.
+	pl PriceLimiter
+	// hooks are the oracle price hooks registered by modules, called once the
+	// prices of a block are written.
+	hooks []oraclePriceHook
+	// signerIDs are the signer IDs of the enclaves whose reports are accepted.
+	signerIDs [][]byte
+	// attestationCfg is the node's [rollinky.attestation] section, whose
+	// policies override the attestation params.
+	attestationCfg AttestationConfig
+	// collateral is the last DCAP collateral pinned in state that was decoded,
+	// collateralHash the hash of its encoding, so that it is only decoded again
+	// once governance pins another one.
+	collateral     *dcap.Collateral
+	collateralHash [sha256.Size]byte
 }
 
-func (h *RollkitHandler) PreBlocker(mm *module.Manager, signerID string) sdk.PreBlocker {
-	signerIDBz, err := hex.DecodeString(signerID)
-	if err != nil {
-		panic(err)
-	}
+// AttestationKeeper defines the attestation keeper methods the PreBlocker
+// depends on.
+type AttestationKeeper interface {
+	GetParams(ctx context.Context) attestationtypes.Params
+	ValidateConfigDigest(ctx context.Context, digest []byte) error
+	ValidateSequencerSignature(ctx context.Context, signBytes, signature []byte, height uint64) error
+	SetLastSequencerHeight(ctx context.Context, height uint64)
+	GetCollateral(ctx context.Context) []byte
+	SetPriceDispersion(ctx context.Context, priceDispersion attestationtypes.PriceDispersion)
+	SetAttestationRecord(ctx context.Context, attestationRecord attestationtypes.AttestationRecord)
+}
+
+// MarketMapKeeper defines the market map keeper methods the PreBlocker depends
+// on.
+type MarketMapKeeper interface {
+	GetMarket(ctx context.Context, tickerStr string) (mmtypes.Market, error)
+}
+
+// PriceLimiter defines the price limit keeper methods the PreBlocker depends
+// on.
+type PriceLimiter interface {
+	LimitPrice(ctx context.Context, cp connecttypes.CurrencyPair, price math.Int) bool
+}
+
+func (h *RollkitHandler) PreBlocker(mm *module.Manager) sdk.PreBlocker {
+	return h.preBlocker(mm, func(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) (applyUpdate, error) {
+		if len(req.Txs) == 0 {
+			h.logger.Error(
+				"no txs in block",
+				"height", ctx.BlockHeight(),
+			)
+			h.missUpdate(ctx, UpdateReasonNoTxs, nil)
+			return nil, nil
+		}
+
+		// only a payload the sequencer tagged as an oracle envelope carries
+		// prices, the first tx of a block without prices is a regular user tx.
+		envelope, err := sequencerutils.UnmarshalEnvelope(req.Txs[0])
+		if errors.Is(err, sequencerutils.ErrNotEnvelope) {
+			h.logger.Info(
+				"no oracle envelope in block",
+				"height", ctx.BlockHeight(),
+			)
+			h.missUpdate(ctx, UpdateReasonNoEnvelope, nil)
+			return nil, nil
+		} else if err != nil {
+			return nil, discardUpdate(UpdateReasonInvalidEnvelope, fmt.Errorf("failed to decode prices and enclave report: %w", err))
+		}
+
+		// the height is recorded on the block's state rather than with the
+		// update, so that an envelope whose update is discarded can't be
+		// replayed either.
+		if err := h.acceptSequencerEnvelope(ctx, envelope); err != nil {
+			return nil, err
+		}
+
+		return func(ctx sdk.Context) (map[connecttypes.CurrencyPair]*big.Int, error) {
+			return h.updatePrices(ctx, envelope)
+		}, nil
+	})
+}
+
+// applyUpdate verifies the oracle update a block carries and writes its prices,
+// returning the written prices.
+type applyUpdate func(ctx sdk.Context) (map[connecttypes.CurrencyPair]*big.Int, error)
+
+// updateSource reads the oracle update a block carries. It returns a nil
+// applyUpdate, after reporting the missed update, if the block carries none.
+type updateSource func(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) (applyUpdate, error)
 
+// preBlocker returns a PreBlocker that applies the oracle update read by
+// source, then runs the oracle price hooks. Both the Rollkit head tx and the
+// vote extensions modes go through it.
+func (h *RollkitHandler) preBlocker(mm *module.Manager, source updateSource) sdk.PreBlocker {
 	return func(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) (_ *sdk.ResponsePreBlock, err error) {
 		if req == nil {
 			ctx.Logger().Error(
@@ -53,7 +151,10 @@ func (h *RollkitHandler) PreBlocker(mm *module.Manager, signerID string) sdk.Pre
 		}
 
 		start := time.Now()
-		prices := map[connecttypes.CurrencyPair]*big.Int{}
+		var (
+			prices    map[connecttypes.CurrencyPair]*big.Int
+			updateErr error
+		)
 		defer func() {
 			// only measure latency in Finalize
 			if ctx.ExecMode() == sdk.ExecModeFinalize {
@@ -63,10 +164,10 @@ func (h *RollkitHandler) PreBlocker(mm *module.Manager, signerID string) sdk.Pre
 					"height", ctx.BlockHeight(),
 					"latency (seconds)", latency.Seconds(),
 				)
-				connectabcitypes.RecordLatencyAndStatus(h.metrics, latency, err, servicemetrics.PreBlock)
+				connectabcitypes.RecordLatencyAndStatus(h.metrics, latency, updateErr, servicemetrics.PreBlock)
 
 				// record prices + ticker metrics per validator (only do so if there was no error writing the prices)
-				if err == nil && prices != nil {
+				if updateErr == nil && prices != nil {
 					// record price metrics
 					h.recordPrices(prices)
 				}
@@ -78,108 +179,490 @@ func (h *RollkitHandler) PreBlocker(mm *module.Manager, signerID string) sdk.Pre
 			"height", req.Height,
 		)
 
-		if len(req.Txs) == 0 {
-			h.logger.Error(
-				"no txs in block",
-				"height", ctx.BlockHeight(),
-			)
+		apply, err := source(ctx, req)
+		if err == nil && apply == nil {
+			h.runPriceHooks(ctx, nil)
 			return response, nil
 		}
 
-		// return utils.Encode(pricesBz, enclaveReport), nil
-		pricesBz, enclaveReport, err := sequencerutils.Decode(req.Txs[0])
+		// the update is applied on a cache, so that a discarded update leaves no
+		// trace in state. A bad oracle update must never halt the chain, it is
+		// discarded and the block is finalized without it.
+		if err == nil {
+			cacheCtx, writeCache := ctx.CacheContext()
+			if prices, err = apply(cacheCtx); err == nil {
+				writeCache()
+			} else {
+				// keep the per-pair decisions that led to the discard.
+				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			}
+		}
+
 		if err != nil {
+			reason := UpdateReasonUnknown
+			var discarded *discardedUpdateError
+			if errors.As(err, &discarded) {
+				reason = discarded.reason
+			}
+
 			h.logger.Error(
-				"failed to decode prices and enclave report",
+				"discarding oracle update",
 				"height", ctx.BlockHeight(),
+				"reason", reason,
 				"error", err,
 			)
-			return response, err
+			h.missUpdate(ctx, reason, err)
+
+			prices, updateErr = nil, err
 		}
 
-		if err := sequencerutils.VerifyReport(enclaveReport, pricesBz, signerIDBz); err != nil {
-			h.logger.Error(
-				"failed to verify report",
-				"height", ctx.BlockHeight(),
-				"error", err,
+		// hooks run on the block's state, after the update is written or
+		// discarded, so that a failing hook can't affect the prices.
+		h.runPriceHooks(ctx, prices)
+
+		return response, nil
+	}
+}
+
+// updatePrices verifies the enclave report of an envelope accepted from the
+// sequencer and writes the prices it carries, returning the written prices.
+// Prices are validated one pair at a time, an invalid pair is reported and
+// skipped, unless more than the max price failure ratio of the prices fail,
+// which discards the whole update.
+func (h *RollkitHandler) updatePrices(ctx sdk.Context, envelope sequencerutils.Envelope) (map[connecttypes.CurrencyPair]*big.Int, error) {
+	collateral, err := h.pinnedCollateral(ctx)
+	if err != nil {
+		return nil, discardUpdate(UpdateReasonNoCollateral, err)
+	}
+	report, err := h.verifyReport(ctx, collateral, envelope.Report, envelope.ReportData())
+	if err != nil {
+		return nil, discardUpdate(UpdateReasonInvalidReport, fmt.Errorf("failed to verify report: %w", err))
+	}
+	params := h.attestationParams(ctx)
+	if !slices.Contains(params.AllowedTcbStatuses, report.TCBStatus) {
+		return nil, discardUpdate(UpdateReasonTCBStatusNotAllowed, fmt.Errorf("enclave report TCB status %q is not one of %v", report.TCBStatus, params.AllowedTcbStatuses))
+	}
+
+	// the report is bound to the sequencer's request nonce and the time it was
+	// created, an old report replayed in a new block is rejected.
+	if age := ctx.BlockTime().Sub(envelope.Time()); age > params.MaxReportAge || age < -params.MaxReportAge {
+		return nil, discardUpdate(UpdateReasonStaleReport, fmt.Errorf("enclave report created at %s is too far from block time %s (max %s)",
+			envelope.Time().UTC().Format(time.RFC3339Nano), ctx.BlockTime().UTC().Format(time.RFC3339Nano), params.MaxReportAge))
+	}
+
+	// the report also attests the sidecar's oracle config, so the chain can
+	// refuse prices fetched from providers it hasn't approved.
+	if err := h.ak.ValidateConfigDigest(ctx, envelope.ConfigDigest); err != nil {
+		return nil, discardUpdate(UpdateReasonConfigNotApproved, fmt.Errorf("failed to verify sidecar config %x: %w", envelope.ConfigDigest, err))
+	}
+
+	rawPrices := &types.QueryPricesResponse{}
+	if err := rawPrices.Unmarshal(envelope.Prices); err != nil {
+		return nil, discardUpdate(UpdateReasonInvalidPrices, fmt.Errorf("failed to unmarshal prices: %w", err))
+	}
+
+	// the sidecar attests the market map it aggregated the prices with, which
+	// sets the decimals the prices are scaled to.
+	if len(envelope.MarketMap) == 0 {
+		return nil, discardUpdate(UpdateReasonInvalidMarketMap, fmt.Errorf("no market map attested"))
+	}
+	marketMap, err := sequencerutils.UnmarshalMarketMap(envelope.MarketMap)
+	if err != nil {
+		return nil, discardUpdate(UpdateReasonInvalidMarketMap, err)
+	}
+
+	// if the sidecar attested the provider prices, recompute the prices from
+	// them instead of trusting its aggregation.
+	var update priceUpdate
+	if len(envelope.ProviderPrices) > 0 {
+		providerPrices, err := sequencerutils.UnmarshalProviderPrices(envelope.ProviderPrices)
+		if err != nil {
+			return nil, discardUpdate(UpdateReasonInvalidProviderPrices, err)
+		}
+		update = h.aggregateProviderPrices(ctx, providerPrices)
+	} else {
+		update = h.parsePrices(ctx, rawPrices.Prices)
+	}
+	update.markets = marketMap.Markets
+
+	valid, rejected := h.validatePrices(ctx, update)
+	if err := h.checkFailureRatio(ctx, update, valid, rejected); err != nil {
+		return nil, err
+	}
+
+	// keep the attestation behind the prices, so that it can later be proven
+	// which enclave produced the prices written at this height.
+	reportHash := sha256.Sum256(envelope.Report)
+	pricesHash := sha256.Sum256(envelope.Prices)
+	h.ak.SetAttestationRecord(ctx, attestationtypes.AttestationRecord{
+		BlockHeight:     uint64(ctx.BlockHeight()), //nolint:gosec
+		ReportHash:      hex.EncodeToString(reportHash[:]),
+		SignerId:        hex.EncodeToString(report.SignerID),
+		ProductId:       uint32(report.ProductID),
+		SecurityVersion: uint64(report.SecurityVersion),
+		TcbStatus:       report.TCBStatus,
+		PricesHash:      hex.EncodeToString(pricesHash[:]),
+		ConfigDigest:    hex.EncodeToString(envelope.ConfigDigest),
+		MarketMapDigest: hex.EncodeToString(envelope.MarketMapDigest()),
+	})
+	return h.writePrices(ctx, valid, hex.EncodeToString(reportHash[:])), nil
+}
+
+// acceptSequencerEnvelope checks that the envelope comes from the chain's
+// sequencer, a node syncing from DA can't otherwise tell it from a payload
+// someone else posted, and records its height as the last accepted one.
+func (h *RollkitHandler) acceptSequencerEnvelope(ctx sdk.Context, envelope sequencerutils.Envelope) error {
+	if err := h.ak.ValidateSequencerSignature(ctx, envelope.SignBytes(ctx.ChainID()), envelope.Signature, envelope.Height); err != nil {
+		reason := UpdateReasonInvalidSequencerSignature
+		if errors.Is(err, attestationtypes.ErrInvalidSequencerHeight) {
+			reason = UpdateReasonInvalidSequencerHeight
+		}
+		return discardUpdate(reason, fmt.Errorf("failed to verify sequencer signature: %w", err))
+	}
+
+	if h.ak.GetParams(ctx).SequencerPublicKey != "" {
+		h.ak.SetLastSequencerHeight(ctx, envelope.Height)
+	}
+
+	return nil
+}
+
+// checkFailureRatio discards the update if more than the max price failure
+// ratio of its prices failed. A block where too many prices fail is more
+// likely to come from a broken sidecar than to have a few bad markets, none
+// of its prices are trusted.
+func (h *RollkitHandler) checkFailureRatio(ctx sdk.Context, update priceUpdate, valid []validPrice, rejected int) error {
+	failures := len(update.failed) + rejected
+	if total := failures + len(valid); total > 0 {
+		maxRatio := h.ak.GetParams(ctx).MaxPriceFailureRatio
+		if ratio := math.LegacyNewDec(int64(failures)).QuoInt64(int64(total)); ratio.GT(maxRatio) {
+			return discardUpdate(UpdateReasonTooManyFailures,
+				fmt.Errorf("%d of %d prices failed validation, more than the max ratio %s", failures, total, maxRatio))
+		}
+	}
+
+	return nil
+}
+
+// priceUpdate holds the prices carried by a block, along with the reasons
+// prices could not be derived for some pairs.
+type priceUpdate struct {
+	// prices are the well-formed prices, keyed by currency pair.
+	prices map[connecttypes.CurrencyPair]*big.Int
+	// skipped holds the pairs that legitimately have no price (e.g. too few
+	// provider prices), with the reason.
+	skipped map[connecttypes.CurrencyPair]string
+	// failed holds the pairs whose currency pair or price is malformed, keyed
+	// by the currency pair string as the sidecar sent it, with the reason.
+	failed map[string]string
+	// markets are the markets of the market map the sidecar attested
+	// aggregating the prices with, keyed by currency pair. They are nil if the
+	// prices aren't attested, vote extension prices are decoded with the
+	// ticker's decimals and aggregated by the chain.
+	markets map[string]mmtypes.Market
+	// aggregated is set if the chain aggregated the prices itself from the
+	// provider prices, skipping pairs with fewer than the ticker's
+	// min_provider_count.
+	aggregated bool
+}
+
+// parsePrices parses the prices aggregated by the sidecar. Malformed currency
+// pairs or prices are reported and left out.
+func (h *RollkitHandler) parsePrices(ctx sdk.Context, rawPrices map[string]string) priceUpdate {
+	// iterate in a fixed order, so that every node emits the same events.
+	pairs := make([]string, 0, len(rawPrices))
+	for pair := range rawPrices {
+		pairs = append(pairs, pair)
+	}
+	sort.Strings(pairs)
+
+	update := priceUpdate{
+		prices: make(map[connecttypes.CurrencyPair]*big.Int, len(pairs)),
+		failed: make(map[string]string),
+	}
+	for _, pair := range pairs {
+		cp, err := connecttypes.CurrencyPairFromString(pair)
+		if err != nil {
+			h.failPrice(ctx, update, pair, PriceReasonInvalidCurrencyPair, err)
+			continue
+		}
+
+		price, err := parsePrice(rawPrices[pair])
+		if err != nil {
+			h.failPrice(ctx, update, pair, PriceReasonInvalidPrice, err)
+			continue
+		}
+
+		update.prices[cp] = price
+	}
+
+	return update
+}
+
+// maxPriceBitLen is the largest bit length of a price. math.Int holds at most
+// 256 bits, a longer price would panic when written to state.
+const maxPriceBitLen = 255
+
+// parsePrice parses a price string sent by the sidecar.
+func parsePrice(s string) (*big.Int, error) {
+	price, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("failed to convert price string to big.Int: %s", s)
+	}
+	if price.BitLen() > maxPriceBitLen {
+		return nil, fmt.Errorf("price %s is longer than %d bits", s, maxPriceBitLen)
+	}
+
+	return price, nil
+}
+
+// failPrice records a malformed currency pair or price in the update.
+func (h *RollkitHandler) failPrice(ctx sdk.Context, update priceUpdate, pair, reason string, err error) {
+	h.logger.Error(
+		"invalid price",
+		"currency_pair", pair,
+		"reason", reason,
+		"err", err,
+	)
+	update.failed[pair] = reason
+	h.rejectPrice(ctx, pair, reason)
+}
+
+// validPrice is a price that follows the rules of its market.
+type validPrice struct {
+	cp    connecttypes.CurrencyPair
+	price *big.Int
+}
+
+// validatePrices checks the price of every currency pair of the oracle module
+// against the rules of its market map ticker: the market must exist and be
+// enabled, and the sidecar must have aggregated the price with the ticker's
+// decimals and at least its min_provider_count. Prices that move further than
+// the limits of their pair are withheld. Every pair that won't be written is
+// emitted as an event, it returns the valid prices and how many prices were
+// rejected.
+func (h *RollkitHandler) validatePrices(ctx sdk.Context, update priceUpdate) (valid []validPrice, rejected int) {
+	failMissingPrices := h.attestationParams(ctx).FailMissingPrices
+	for _, cp := range h.ok.GetAllCurrencyPairs(ctx) {
+		// malformed prices were already reported.
+		if _, ok := update.failed[cp.String()]; ok {
+			continue
+		}
+
+		price := update.prices[cp]
+
+		market, err := h.mmk.GetMarket(ctx, cp.String())
+		if err != nil {
+			h.logger.Debug(
+				"no market for currency pair",
+				"currency_pair", cp.String(),
+				"err", err,
 			)
-			return response, err
+			h.skipPrice(ctx, cp.String(), PriceReasonMarketNotFound)
+
+			continue
 		}
 
-		rawPrices := &types.QueryPricesResponse{}
-		if err := rawPrices.Unmarshal(pricesBz); err != nil {
-			h.logger.Error(
-				"failed to unmarshal prices from txs",
-				"height", ctx.BlockHeight(),
-				"error", err,
+		if !market.Ticker.Enabled {
+			h.logger.Debug(
+				"market is disabled",
+				"currency_pair", cp.String(),
 			)
-			return response, err
+			h.skipPrice(ctx, cp.String(), PriceReasonMarketDisabled)
+
+			continue
 		}
 
-		// Iterate over the prices and transform them into the correct format.
-		for currencyPairID, priceString := range rawPrices.Prices {
-			cp, err := connecttypes.CurrencyPairFromString(currencyPairID)
-			if err != nil {
-				return response, err
+		if price == nil {
+			reason, ok := update.skipped[cp]
+			if !ok {
+				reason = PriceReasonNoPrice
 			}
-
-			rawPrice, converted := new(big.Int).SetString(priceString, 10)
-			if !converted {
-				return response, fmt.Errorf("failed to convert price string to big.Int: %s", priceString)
+			h.logger.Debug(
+				"no price for currency pair",
+				"currency_pair", cp.String(),
+				"reason", reason,
+			)
+			if failMissingPrices {
+				h.rejectPrice(ctx, cp.String(), reason)
+				rejected++
+			} else {
+				h.skipPrice(ctx, cp.String(), reason)
 			}
 
-			prices[cp] = rawPrice
+			continue
 		}
 
-		currencyPairs := h.ok.GetAllCurrencyPairs(ctx)
-		for _, cp := range currencyPairs {
-			price, ok := prices[cp]
-			if !ok || price == nil {
-				h.logger.Debug(
-					"no price for currency pair",
-					"currency_pair", cp.String(),
-				)
+		if update.markets != nil && !h.checkAttestedMarket(ctx, cp, market, update) {
+			rejected++
 
-				continue
-			}
+			continue
+		}
 
-			if price.Sign() == -1 {
-				h.logger.Error(
-					"price is negative",
-					"currency_pair", cp.String(),
-					"price", price.String(),
-				)
+		if price.Sign() == -1 {
+			h.logger.Error(
+				"price is negative",
+				"currency_pair", cp.String(),
+				"price", price.String(),
+			)
+			h.rejectPrice(ctx, cp.String(), PriceReasonNegativePrice)
+			rejected++
 
-				continue
-			}
+			continue
+		}
 
-			// Convert the price to a quote price and write it to state.
-			quotePrice := oracletypes.QuotePrice{
-				Price:          math.NewIntFromBigInt(price),
-				BlockTimestamp: ctx.BlockHeader().Time,
-				BlockHeight:    uint64(ctx.BlockHeight()), //nolint:gosec
-			}
+		// a withheld price isn't a failure, a real crash across markets
+		// mustn't discard the prices within their limits.
+		if !h.pl.LimitPrice(ctx, cp, math.NewIntFromBigInt(price)) {
+			h.logger.Info(
+				"price withheld by its limits",
+				"currency_pair", cp.String(),
+				"price", price.String(),
+			)
+			h.skipPrice(ctx, cp.String(), PriceReasonWithheld)
 
-			if err := h.ok.SetPriceForCurrencyPair(ctx, cp, quotePrice); err != nil {
-				h.logger.Error(
-					"failed to set price for currency pair",
-					"currency_pair", cp.String(),
-					"quote_price", cp.String(),
-					"err", err,
-				)
+			continue
+		}
 
-				return nil, err
-			}
+		valid = append(valid, validPrice{cp: cp, price: price})
+	}
 
-			h.logger.Debug(
-				"set price for currency pair",
-				"currency_pair", cp.String(),
+	return valid, rejected
+}
+
+// checkAttestedMarket checks that the sidecar attested aggregating the price
+// of cp with the ticker of its on-chain market: the price is an integer scaled
+// to the attested decimals, which must be the ones the chain reads it with,
+// and it must have required at least as many providers as the chain does. It
+// rejects the price otherwise.
+func (h *RollkitHandler) checkAttestedMarket(ctx sdk.Context, cp connecttypes.CurrencyPair, market mmtypes.Market, update priceUpdate) bool {
+	attested, ok := update.markets[cp.String()]
+	if !ok {
+		h.logger.Error(
+			"market of price is not attested",
+			"currency_pair", cp.String(),
+		)
+		h.rejectPrice(ctx, cp.String(), PriceReasonDecimalsUnattested)
+		return false
+	}
+
+	if attested.Ticker.Decimals != market.Ticker.Decimals {
+		h.logger.Error(
+			"price decimals do not match the ticker",
+			"currency_pair", cp.String(),
+			"decimals", attested.Ticker.Decimals,
+			"ticker_decimals", market.Ticker.Decimals,
+		)
+		h.rejectPrice(ctx, cp.String(), PriceReasonDecimalsMismatch)
+		return false
+	}
+
+	// the chain counted the providers itself when it aggregated the provider
+	// prices.
+	if !update.aggregated && attested.Ticker.MinProviderCount < market.Ticker.MinProviderCount {
+		h.logger.Error(
+			"price aggregated from too few providers",
+			"currency_pair", cp.String(),
+			"min_provider_count", attested.Ticker.MinProviderCount,
+			"ticker_min_provider_count", market.Ticker.MinProviderCount,
+		)
+		h.rejectPrice(ctx, cp.String(), PriceReasonMinProviderCountMismatch)
+		return false
+	}
+
+	return true
+}
+
+// writePrices writes the valid prices to state and returns the written ones.
+// A price that fails to be written is reported and skipped. attestationHash is
+// the hash of the enclave report the prices were attested by.
+func (h *RollkitHandler) writePrices(ctx sdk.Context, valid []validPrice, attestationHash string) map[connecttypes.CurrencyPair]*big.Int {
+	written := make(map[connecttypes.CurrencyPair]*big.Int, len(valid))
+	for _, v := range valid {
+		// Convert the price to a quote price and write it to state.
+		quotePrice := oracletypes.QuotePrice{
+			Price:          math.NewIntFromBigInt(v.price),
+			BlockTimestamp: ctx.BlockHeader().Time,
+			BlockHeight:    uint64(ctx.BlockHeight()), //nolint:gosec
+		}
+
+		if err := h.ok.SetPriceForCurrencyPair(ctx, v.cp, quotePrice); err != nil {
+			h.logger.Error(
+				"failed to set price for currency pair",
+				"currency_pair", v.cp.String(),
 				"quote_price", quotePrice.Price.String(),
+				"err", err,
 			)
+			h.rejectPrice(ctx, v.cp.String(), PriceReasonWriteFailed)
+
+			continue
 		}
 
-		return response, nil
+		h.logger.Debug(
+			"set price for currency pair",
+			"currency_pair", v.cp.String(),
+			"quote_price", quotePrice.Price.String(),
+		)
+		h.emitPriceUpdated(ctx, v.cp, v.price, attestationHash)
+		written[v.cp] = v.price
 	}
+
+	return written
+}
+
+// verifyReport verifies the enclave report against the collateral pinned in
+// state, at the block time, so that every node reaches the same verdict for a
+// block. The host's quote provider is never used, its view of the PCCS is
+// local to the node. It returns the identity of the enclave that produced the
+// report.
+func (h *RollkitHandler) verifyReport(ctx sdk.Context, collateral *dcap.Collateral, report, data []byte) (sequencerutils.VerifiedReport, error) {
+	return sequencerutils.VerifyReportWithCollateral(report, data, h.signerIDs, collateral, ctx.BlockTime())
+}
+
+// pinnedCollateral returns the DCAP collateral pinned in state, decoding it
+// only if it changed since the last block.
+func (h *RollkitHandler) pinnedCollateral(ctx sdk.Context) (*dcap.Collateral, error) {
+	bz := h.ak.GetCollateral(ctx)
+	if len(bz) == 0 {
+		return nil, errors.New("no DCAP collateral is pinned")
+	}
+
+	if hash := sha256.Sum256(bz); h.collateral == nil || hash != h.collateralHash {
+		collateral, err := dcap.ParseCollateral(bz)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse pinned collateral: %w", err)
+		}
+		h.collateral, h.collateralHash = collateral, hash
+		h.cacheCollateral(bz)
+	}
+
+	return h.collateral, nil
+}
+
+// cacheCollateral copies the pinned collateral to the collateral cache path,
+// if one is set. The copy is only for operators, failing to write it doesn't
+// affect the block.
+func (h *RollkitHandler) cacheCollateral(bz []byte) {
+	path := h.attestationCfg.CollateralCachePath
+	if path == "" {
+		return
+	}
+
+	// write the copy whole or not at all.
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, bz, 0o600); err != nil {
+		h.logger.Error("failed to cache collateral", "path", path, "err", err)
+		return
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		h.logger.Error("failed to cache collateral", "path", path, "err", err)
+	}
+}
+
+// attestationParams returns the attestation params, with the policies the
+// node's [rollinky.attestation] section sets in place of theirs.
+func (h *RollkitHandler) attestationParams(ctx sdk.Context) attestationtypes.Params {
+	return h.attestationCfg.ApplyTo(h.ak.GetParams(ctx))
 }
 
 func (h *RollkitHandler) recordPrices(prices map[connecttypes.CurrencyPair]*big.Int) {
@@ -188,3 +671,22 @@ func (h *RollkitHandler) recordPrices(prices map[connecttypes.CurrencyPair]*big.
 		h.metrics.ObservePriceForTicker(ticker, floatPrice)
 	}
 }
+
+// discardedUpdateError is the error of an oracle update that was discarded,
+// with the reason emitted in the discard event.
+type discardedUpdateError struct {
+	reason string
+	err    error
+}
+
+func discardUpdate(reason string, err error) error {
+	return &discardedUpdateError{reason: reason, err: err}
+}
+
+func (e *discardedUpdateError) Error() string {
+	return e.err.Error()
+}
+
+func (e *discardedUpdateError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,277 @@
+//go:build no_tee
+// +build no_tee
+
+package app
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/service/servers/oracle/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	"github.com/stretchr/testify/require"
+
+	sequencerutils "github.com/facundomedica/rollinky/sequencer/utils"
+	keepertest "rollinky/testutil/keeper"
+	attestationtypes "rollinky/x/attestation/types"
+)
+
+// TestPreBlockerDiscardsUpdate runs envelopes through the PreBlocker, with
+// the no-op report verification of the no_tee build.
+func TestPreBlockerDiscardsUpdate(t *testing.T) {
+	cp := func(base string) connecttypes.CurrencyPair {
+		return connecttypes.NewCurrencyPair(base, "USD")
+	}
+	market := func(base string) mmtypes.Market {
+		return mmtypes.Market{
+			Ticker: mmtypes.Ticker{
+				CurrencyPair:     cp(base),
+				Decimals:         8,
+				MinProviderCount: 1,
+				Enabled:          true,
+			},
+			ProviderConfigs: []mmtypes.ProviderConfig{{Name: "coinbase_api"}},
+		}
+	}
+
+	ak, ctx := keepertest.AttestationKeeper(t)
+	ak.SetCollateral(ctx, []byte(`{"crls": []}`))
+
+	ok := &mockOracleKeeper{
+		pairs:  []connecttypes.CurrencyPair{cp("BTC"), cp("ETH"), cp("SOL")},
+		prices: map[connecttypes.CurrencyPair]oracletypes.QuotePrice{},
+	}
+	mmk := mockMarketMapKeeper{
+		"BTC/USD": market("BTC"),
+		"ETH/USD": market("ETH"),
+		"SOL/USD": market("SOL"),
+	}
+	h := &RollkitHandler{
+		logger: log.NewNopLogger(),
+		ok:     ok,
+		ak:     ak,
+		mmk:    mmk,
+		pl:     mockPriceLimiter{},
+	}
+	preBlocker := h.PreBlocker(module.NewManager())
+
+	// the sidecar aggregated with the on-chain market map.
+	marketMap, err := json.Marshal(mmtypes.MarketMap{Markets: map[string]mmtypes.Market(mmk)})
+	require.NoError(t, err)
+
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	finalize := func(marketMap []byte, providerPrices map[string]string) []cometabci.Event {
+		prices, err := (&types.QueryPricesResponse{}).Marshal()
+		require.NoError(t, err)
+
+		attested := make(map[string][]sequencerutils.ProviderPrice, len(providerPrices))
+		for pair, price := range providerPrices {
+			attested[pair] = []sequencerutils.ProviderPrice{{Provider: "coinbase_api", Price: price, Timestamp: blockTime.UnixNano()}}
+		}
+		bz, err := json.Marshal(attested)
+		require.NoError(t, err)
+
+		envelope := sequencerutils.Envelope{
+			Prices:         prices,
+			Report:         []byte("report"),
+			Nonce:          make([]byte, sequencerutils.NonceSize),
+			Timestamp:      blockTime.UnixNano(),
+			ConfigDigest:   make([]byte, sequencerutils.ConfigDigestSize),
+			MarketMap:      marketMap,
+			ProviderPrices: bz,
+		}
+
+		ctx := ctx.
+			WithBlockHeader(cmtproto.Header{Height: 10, Time: blockTime}).
+			WithEventManager(sdk.NewEventManager())
+		_, err = preBlocker(ctx, &cometabci.RequestFinalizeBlock{Height: 10, Txs: [][]byte{envelope.Marshal()}})
+		require.NoError(t, err)
+
+		return ctx.EventManager().ABCIEvents()
+	}
+
+	// prices too large to be written fail their pair instead of halting the
+	// chain. Two of three pairs failing discard the update, and with it the
+	// dispersion of the valid pair.
+	tooLarge := new(big.Int).Lsh(big.NewInt(1), 256).String()
+	events := finalize(marketMap, map[string]string{
+		"BTC/USD": tooLarge,
+		"ETH/USD": "100",
+		"SOL/USD": tooLarge,
+	})
+
+	skipped := map[string]string{}
+	var missed *attestationtypes.EventOracleUpdateMissed
+	for _, event := range events {
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+
+		switch e := msg.(type) {
+		case *attestationtypes.EventPriceSkipped:
+			skipped[e.CurrencyPair] = e.Reason
+		case *attestationtypes.EventOracleUpdateMissed:
+			missed = e
+		default:
+			t.Fatalf("unexpected event %T", msg)
+		}
+	}
+	require.Equal(t, map[string]string{
+		"BTC/USD": PriceReasonInvalidPrice,
+		"SOL/USD": PriceReasonInvalidPrice,
+	}, skipped)
+	require.NotNil(t, missed)
+	require.Equal(t, UpdateReasonTooManyFailures, missed.Reason)
+
+	require.Empty(t, ok.prices)
+	_, found := ak.GetPriceDispersion(ctx, "ETH/USD")
+	require.False(t, found)
+	_, found = ak.GetAttestationRecord(ctx, 10)
+	require.False(t, found)
+
+	// without the market map the sidecar aggregated with, the decimals of the
+	// prices are unknown.
+	missed = nil
+	for _, event := range finalize(nil, map[string]string{"ETH/USD": "100"}) {
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		if e, ok := msg.(*attestationtypes.EventOracleUpdateMissed); ok {
+			missed = e
+		}
+	}
+	require.NotNil(t, missed)
+	require.Equal(t, UpdateReasonInvalidMarketMap, missed.Reason)
+	require.Empty(t, ok.prices)
+
+	// the same update without the failing pairs is written.
+	finalize(marketMap, map[string]string{"ETH/USD": "100"})
+
+	require.Equal(t, int64(100), ok.prices[cp("ETH")].Price.Int64())
+	_, found = ak.GetPriceDispersion(ctx, "ETH/USD")
+	require.True(t, found)
+	_, found = ak.GetAttestationRecord(ctx, 10)
+	require.True(t, found)
+}
+
+func TestPreBlockerRecordsSequencerHeight(t *testing.T) {
+	ak, ctx := keepertest.AttestationKeeper(t)
+	pubKey, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	params := attestationtypes.DefaultParams()
+	params.SequencerPublicKey = hex.EncodeToString(pubKey)
+	require.NoError(t, ak.SetParams(ctx, params))
+
+	h := &RollkitHandler{
+		logger: log.NewNopLogger(),
+		ok:     &mockOracleKeeper{},
+		ak:     ak,
+	}
+	preBlocker := h.PreBlocker(module.NewManager())
+
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	envelope := sequencerutils.Envelope{
+		Nonce:     make([]byte, sequencerutils.NonceSize),
+		Timestamp: blockTime.UnixNano(),
+	}
+	envelope.Sign(key, "other-chain", 10)
+
+	// missedReason finalizes a block carrying the envelope and returns why
+	// its update was missed.
+	missedReason := func() string {
+		ctx := ctx.
+			WithChainID("rollinky").
+			WithBlockHeader(cmtproto.Header{ChainID: "rollinky", Height: 10, Time: blockTime}).
+			WithEventManager(sdk.NewEventManager())
+		_, err := preBlocker(ctx, &cometabci.RequestFinalizeBlock{Height: 10, Txs: [][]byte{envelope.Marshal()}})
+		require.NoError(t, err)
+
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			msg, err := sdk.ParseTypedEvent(event)
+			require.NoError(t, err)
+			if e, ok := msg.(*attestationtypes.EventOracleUpdateMissed); ok {
+				return e.Reason
+			}
+		}
+		return ""
+	}
+
+	// an envelope signed for another chain isn't accepted.
+	require.Equal(t, UpdateReasonInvalidSequencerSignature, missedReason())
+	require.Zero(t, ak.GetLastSequencerHeight(ctx))
+
+	envelope.Sign(key, "rollinky", 10)
+
+	// no collateral is pinned, so the update is discarded once the envelope
+	// is accepted. Its height is recorded all the same.
+	require.Equal(t, UpdateReasonNoCollateral, missedReason())
+	require.Equal(t, uint64(10), ak.GetLastSequencerHeight(ctx))
+
+	// so the envelope can't be replayed.
+	require.Equal(t, UpdateReasonInvalidSequencerHeight, missedReason())
+}
+
+func TestPreBlockerChecksTCBStatus(t *testing.T) {
+	ak, ctx := keepertest.AttestationKeeper(t)
+	ak.SetCollateral(ctx, []byte(`{"crls": []}`))
+
+	h := &RollkitHandler{
+		logger: log.NewNopLogger(),
+		ok:     &mockOracleKeeper{},
+		ak:     ak,
+		mmk:    mockMarketMapKeeper{},
+		pl:     mockPriceLimiter{},
+	}
+	preBlocker := h.PreBlocker(module.NewManager())
+
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	prices, err := (&types.QueryPricesResponse{}).Marshal()
+	require.NoError(t, err)
+	marketMap, err := json.Marshal(mmtypes.MarketMap{})
+	require.NoError(t, err)
+	envelope := sequencerutils.Envelope{
+		Prices:       prices,
+		Report:       []byte("report"),
+		Nonce:        make([]byte, sequencerutils.NonceSize),
+		Timestamp:    blockTime.UnixNano(),
+		ConfigDigest: make([]byte, sequencerutils.ConfigDigestSize),
+		MarketMap:    marketMap,
+	}
+
+	// missedReason finalizes a block carrying the envelope and returns why
+	// its update was missed, if it was.
+	missedReason := func() string {
+		ctx := ctx.
+			WithBlockHeader(cmtproto.Header{Height: 10, Time: blockTime}).
+			WithEventManager(sdk.NewEventManager())
+		_, err := preBlocker(ctx, &cometabci.RequestFinalizeBlock{Height: 10, Txs: [][]byte{envelope.Marshal()}})
+		require.NoError(t, err)
+
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			msg, err := sdk.ParseTypedEvent(event)
+			require.NoError(t, err)
+			if e, ok := msg.(*attestationtypes.EventOracleUpdateMissed); ok {
+				return e.Reason
+			}
+		}
+		return ""
+	}
+
+	// the no_tee build verifies every report as coming from an up to date
+	// platform, which the default params accept.
+	require.Empty(t, missedReason())
+
+	params := attestationtypes.DefaultParams()
+	params.AllowedTcbStatuses = []string{"SWHardeningNeeded"}
+	require.NoError(t, ak.SetParams(ctx, params))
+	require.Equal(t, UpdateReasonTCBStatusNotAllowed, missedReason())
+}
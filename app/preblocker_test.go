@@ -0,0 +1,248 @@
+package app
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	"github.com/stretchr/testify/require"
+
+	attestationtypes "rollinky/x/attestation/types"
+)
+
+type mockOracleKeeper struct {
+	pairs  []connecttypes.CurrencyPair
+	prices map[connecttypes.CurrencyPair]oracletypes.QuotePrice
+}
+
+func (k *mockOracleKeeper) GetAllCurrencyPairs(context.Context) []connecttypes.CurrencyPair {
+	return k.pairs
+}
+
+func (k *mockOracleKeeper) SetPriceForCurrencyPair(_ context.Context, cp connecttypes.CurrencyPair, qp oracletypes.QuotePrice) error {
+	k.prices[cp] = qp
+	return nil
+}
+
+// mockPriceLimiter withholds the prices of the pairs it holds.
+type mockPriceLimiter map[string]bool
+
+func (l mockPriceLimiter) LimitPrice(_ context.Context, cp connecttypes.CurrencyPair, _ math.Int) bool {
+	return !l[cp.String()]
+}
+
+func TestValidateAndWritePrices(t *testing.T) {
+	cp := func(base string) connecttypes.CurrencyPair {
+		return connecttypes.NewCurrencyPair(base, "USD")
+	}
+	market := func(base string, decimals uint64, enabled bool) mmtypes.Market {
+		return mmtypes.Market{
+			Ticker: mmtypes.Ticker{
+				CurrencyPair:     cp(base),
+				Decimals:         decimals,
+				MinProviderCount: 1,
+				Enabled:          enabled,
+			},
+		}
+	}
+	withMinProviderCount := func(market mmtypes.Market, minProviderCount uint64) mmtypes.Market {
+		market.Ticker.MinProviderCount = minProviderCount
+		return market
+	}
+
+	ok := &mockOracleKeeper{
+		pairs: []connecttypes.CurrencyPair{
+			cp("BTC"), cp("ETH"), cp("SOL"), cp("ATOM"), cp("USDT"), cp("DOGE"), cp("TIA"), cp("OSMO"), cp("LINK"), cp("ARB"), cp("NEAR"),
+		},
+		prices: map[connecttypes.CurrencyPair]oracletypes.QuotePrice{},
+	}
+	ak := &mockAttestationKeeper{}
+	h := &RollkitHandler{
+		logger: log.NewNopLogger(),
+		ok:     ok,
+		ak:     ak,
+		mmk: mockMarketMapKeeper{
+			"BTC/USD":  market("BTC", 8, true),
+			"ETH/USD":  market("ETH", 11, true),
+			"SOL/USD":  market("SOL", 8, false),
+			"USDT/USD": market("USDT", 6, true),
+			"DOGE/USD": market("DOGE", 8, true),
+			"TIA/USD":  market("TIA", 8, true),
+			"OSMO/USD": market("OSMO", 8, true),
+			"LINK/USD": market("LINK", 8, true),
+			"ARB/USD":  market("ARB", 8, true),
+			"NEAR/USD": withMinProviderCount(market("NEAR", 8, true), 3),
+		},
+		pl: mockPriceLimiter{"ARB/USD": true},
+	}
+	blockTime := time.Unix(1700000000, 0).UTC()
+	ctx := sdk.Context{}.
+		WithBlockHeader(cmtproto.Header{Height: 10, Time: blockTime}).
+		WithEventManager(sdk.NewEventManager())
+
+	update := h.parsePrices(ctx, map[string]string{
+		"BTC/USD":  "100",
+		"ETH/USD":  "200",
+		"SOL/USD":  "300",
+		"ATOM/USD": "400",
+		"USDT/USD": "-1",
+		"DOGE/USD": "500",
+		"OSMO/USD": "1.5",
+		"ARB/USD":  "600",
+		"NEAR/USD": "700",
+		"BTCUSD":   "100",
+		"PEPE/USD": new(big.Int).Lsh(big.NewInt(1), maxPriceBitLen).String(),
+	})
+	update.skipped = map[connecttypes.CurrencyPair]string{
+		cp("TIA"): PriceReasonInsufficientProviders,
+	}
+	require.Equal(t, map[string]string{
+		"OSMO/USD": PriceReasonInvalidPrice,
+		"PEPE/USD": PriceReasonInvalidPrice,
+		"BTCUSD":   PriceReasonInvalidCurrencyPair,
+	}, update.failed)
+
+	// the sidecar scaled ETH to other decimals, didn't price DOGE and required
+	// fewer providers for NEAR than the chain.
+	update.markets = map[string]mmtypes.Market{
+		"BTC/USD":  market("BTC", 8, true),
+		"ETH/USD":  market("ETH", 8, true),
+		"SOL/USD":  market("SOL", 8, true),
+		"USDT/USD": market("USDT", 6, true),
+		"TIA/USD":  market("TIA", 8, true),
+		"ARB/USD":  market("ARB", 8, true),
+		"NEAR/USD": market("NEAR", 8, true),
+	}
+	valid, rejected := h.validatePrices(ctx, update)
+	require.Equal(t, []validPrice{{cp: cp("BTC"), price: big.NewInt(100)}}, valid)
+	require.Equal(t, 4, rejected)
+
+	written := h.writePrices(ctx, valid, "abcd")
+	require.Equal(t, map[connecttypes.CurrencyPair]*big.Int{cp("BTC"): big.NewInt(100)}, written)
+	require.Len(t, ok.prices, 1)
+	require.Equal(t, int64(100), ok.prices[cp("BTC")].Price.Int64())
+	require.Equal(t, uint64(10), ok.prices[cp("BTC")].BlockHeight)
+
+	// every pair gets exactly one event.
+	updated := map[string]*attestationtypes.EventPriceUpdated{}
+	skipped := map[string]string{}
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+
+		switch e := msg.(type) {
+		case *attestationtypes.EventPriceUpdated:
+			require.NotContains(t, updated, e.CurrencyPair)
+			updated[e.CurrencyPair] = e
+		case *attestationtypes.EventPriceSkipped:
+			require.NotContains(t, skipped, e.CurrencyPair)
+			skipped[e.CurrencyPair] = e.Reason
+		default:
+			t.Fatalf("unexpected event %T", msg)
+		}
+	}
+
+	require.Equal(t, map[string]*attestationtypes.EventPriceUpdated{
+		"BTC/USD": {
+			CurrencyPair:    "BTC/USD",
+			Price:           math.NewInt(100),
+			BlockHeight:     10,
+			BlockTime:       blockTime,
+			AttestationHash: "abcd",
+		},
+	}, updated)
+	require.Equal(t, map[string]string{
+		"ETH/USD":  PriceReasonDecimalsMismatch,
+		"SOL/USD":  PriceReasonMarketDisabled,
+		"ATOM/USD": PriceReasonMarketNotFound,
+		"USDT/USD": PriceReasonNegativePrice,
+		"DOGE/USD": PriceReasonDecimalsUnattested,
+		"TIA/USD":  PriceReasonInsufficientProviders,
+		"OSMO/USD": PriceReasonInvalidPrice,
+		"NEAR/USD": PriceReasonMinProviderCountMismatch,
+		"PEPE/USD": PriceReasonInvalidPrice,
+		"BTCUSD":   PriceReasonInvalidCurrencyPair,
+		"LINK/USD": PriceReasonNoPrice,
+		"ARB/USD":  PriceReasonWithheld,
+	}, skipped)
+
+	// with the fail missing prices param, enabled markets without a price
+	// count as failures.
+	params := attestationtypes.DefaultParams()
+	params.FailMissingPrices = true
+	ak.params = &params
+	_, rejected = h.validatePrices(ctx.WithEventManager(sdk.NewEventManager()), update)
+	require.Equal(t, 6, rejected)
+	ak.params = nil
+
+	// so they do with the node's missing prices policy.
+	h.attestationCfg.MissingPrices = MissingPricesFail
+	_, rejected = h.validatePrices(ctx.WithEventManager(sdk.NewEventManager()), update)
+	require.Equal(t, 6, rejected)
+	h.attestationCfg.MissingPrices = MissingPricesParams
+
+	// prices the chain aggregated from the provider prices already have
+	// enough providers.
+	update.aggregated = true
+	valid, rejected = h.validatePrices(ctx.WithEventManager(sdk.NewEventManager()), update)
+	require.Equal(t, []validPrice{{cp: cp("BTC"), price: big.NewInt(100)}, {cp: cp("NEAR"), price: big.NewInt(700)}}, valid)
+	require.Equal(t, 3, rejected)
+
+	// vote extension prices aren't attested and are scaled by the chain.
+	update.markets = nil
+	valid, rejected = h.validatePrices(ctx.WithEventManager(sdk.NewEventManager()), update)
+	require.Len(t, valid, 4)
+	require.Equal(t, 1, rejected)
+}
+
+func TestPinnedCollateral(t *testing.T) {
+	ak := &mockAttestationKeeper{}
+	cachePath := filepath.Join(t.TempDir(), "collateral.json")
+	h := &RollkitHandler{
+		logger:         log.NewNopLogger(),
+		ak:             ak,
+		attestationCfg: AttestationConfig{CollateralCachePath: cachePath},
+	}
+	ctx := sdk.Context{}
+
+	// without collateral in state, no report can be verified.
+	_, err := h.pinnedCollateral(ctx)
+	require.ErrorContains(t, err, "no DCAP collateral is pinned")
+
+	ak.collateral = []byte(`{"crls": []}`)
+	collateral, err := h.pinnedCollateral(ctx)
+	require.NoError(t, err)
+
+	// the node keeps a copy of the pinned collateral.
+	cached, err := os.ReadFile(cachePath)
+	require.NoError(t, err)
+	require.Equal(t, ak.collateral, cached)
+
+	// the same collateral is only decoded once.
+	again, err := h.pinnedCollateral(ctx)
+	require.NoError(t, err)
+	require.Same(t, collateral, again)
+
+	// a newly pinned collateral is decoded again.
+	ak.collateral = []byte(`{"crls": [], "tcb_infos": []}`)
+	updated, err := h.pinnedCollateral(ctx)
+	require.NoError(t, err)
+	require.NotSame(t, collateral, updated)
+	cached, err = os.ReadFile(cachePath)
+	require.NoError(t, err)
+	require.Equal(t, ak.collateral, cached)
+
+	ak.collateral = []byte(`not json`)
+	_, err = h.pinnedCollateral(ctx)
+	require.ErrorContains(t, err, "failed to parse pinned collateral")
+}
@@ -0,0 +1,167 @@
+package app
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+
+	sequencerutils "github.com/facundomedica/rollinky/sequencer/utils"
+	attestationtypes "rollinky/x/attestation/types"
+)
+
+// basisPoints is the denominator of the price spread.
+var basisPoints = big.NewInt(10_000)
+
+// aggregateProviderPrices recomputes the price of every currency pair from the
+// attested provider prices, the same way the sidecar does: the median of the
+// provider prices, as long as there are at least min_provider_count of them.
+// Only prices from providers the on-chain market map configures for the market,
+// received at most max_provider_price_age before the block time, are counted.
+// The dispersion of the prices is stored for every pair. Pairs without enough
+// prices are skipped, pairs with a malformed currency pair or provider price
+// fail.
+func (h *RollkitHandler) aggregateProviderPrices(ctx sdk.Context, providerPrices map[string][]sequencerutils.ProviderPrice) priceUpdate {
+	// iterate in a fixed order, so that every node logs and writes the same.
+	pairs := make([]string, 0, len(providerPrices))
+	for pair := range providerPrices {
+		pairs = append(pairs, pair)
+	}
+	sort.Strings(pairs)
+
+	maxAge := h.ak.GetParams(ctx).MaxProviderPriceAge
+
+	update := priceUpdate{
+		prices:     make(map[connecttypes.CurrencyPair]*big.Int, len(pairs)),
+		skipped:    make(map[connecttypes.CurrencyPair]string),
+		failed:     make(map[string]string),
+		aggregated: true,
+	}
+pairs:
+	for _, pair := range pairs {
+		cp, err := connecttypes.CurrencyPairFromString(pair)
+		if err != nil {
+			h.failPrice(ctx, update, pair, PriceReasonInvalidCurrencyPair, err)
+			continue
+		}
+
+		market, err := h.mmk.GetMarket(ctx, cp.String())
+		if err != nil {
+			h.logger.Debug(
+				"no market for provider prices",
+				"currency_pair", cp.String(),
+				"err", err,
+			)
+
+			continue
+		}
+
+		// a provider can be configured more than once for a market (e.g. with
+		// different normalization pairs), it contributes one price per config.
+		configured := make(map[string]int, len(market.ProviderConfigs))
+		for _, cfg := range market.ProviderConfigs {
+			configured[cfg.Name]++
+		}
+
+		values := make([]*big.Int, 0, len(providerPrices[pair]))
+		for _, providerPrice := range providerPrices[pair] {
+			if configured[providerPrice.Provider] == 0 {
+				h.logger.Debug(
+					"ignoring price from unconfigured provider",
+					"currency_pair", cp.String(),
+					"provider", providerPrice.Provider,
+				)
+
+				continue
+			}
+
+			// the sidecar keeps a provider's last price until the provider sends
+			// another, a provider that stopped responding mustn't keep moving
+			// the median.
+			if receivedAt := time.Unix(0, providerPrice.Timestamp); maxAge > 0 && ctx.BlockTime().Sub(receivedAt) > maxAge {
+				h.logger.Debug(
+					"ignoring stale provider price",
+					"currency_pair", cp.String(),
+					"provider", providerPrice.Provider,
+					"received_at", receivedAt.UTC().Format(time.RFC3339Nano),
+				)
+
+				continue
+			}
+			configured[providerPrice.Provider]--
+
+			value, err := parsePrice(providerPrice.Price)
+			if err != nil {
+				h.failPrice(ctx, update, pair, PriceReasonInvalidPrice, fmt.Errorf("invalid %s price: %w", providerPrice.Provider, err))
+				continue pairs
+			}
+			if value.Sign() == -1 {
+				h.logger.Error(
+					"provider price is negative",
+					"currency_pair", cp.String(),
+					"provider", providerPrice.Provider,
+					"price", value.String(),
+				)
+
+				continue
+			}
+
+			values = append(values, value)
+		}
+
+		if len(values) == 0 || uint64(len(values)) < market.Ticker.MinProviderCount {
+			h.logger.Info(
+				"not enough provider prices",
+				"currency_pair", cp.String(),
+				"provider_count", len(values),
+				"min_provider_count", market.Ticker.MinProviderCount,
+			)
+			update.skipped[cp] = PriceReasonInsufficientProviders
+
+			continue
+		}
+
+		sort.Slice(values, func(i, j int) bool { return values[i].Cmp(values[j]) < 0 })
+		median := medianOfSorted(values)
+		update.prices[cp] = median
+
+		minPrice, maxPrice := values[0], values[len(values)-1]
+		spread := new(big.Int)
+		if median.Sign() > 0 {
+			spread.Sub(maxPrice, minPrice)
+			spread.Mul(spread, basisPoints)
+			spread.Quo(spread, median)
+		}
+		if !spread.IsUint64() {
+			spread.SetUint64(math.MaxUint64)
+		}
+
+		h.ak.SetPriceDispersion(ctx, attestationtypes.PriceDispersion{
+			CurrencyPair:  cp.String(),
+			BlockHeight:   uint64(ctx.BlockHeight()), //nolint:gosec
+			ProviderCount: uint32(len(values)),       //nolint:gosec
+			MinPrice:      minPrice.String(),
+			MaxPrice:      maxPrice.String(),
+			MedianPrice:   median.String(),
+			SpreadBps:     spread.Uint64(),
+		})
+	}
+
+	return update
+}
+
+// medianOfSorted returns the median of sorted values, rounding the mean of the
+// two middle values down if there is an even number of them.
+func medianOfSorted(values []*big.Int) *big.Int {
+	middle := len(values) / 2
+	if len(values)%2 == 1 {
+		return new(big.Int).Set(values[middle])
+	}
+
+	median := new(big.Int).Add(values[middle-1], values[middle])
+	return median.Rsh(median, 1)
+}
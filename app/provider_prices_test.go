@@ -0,0 +1,181 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/stretchr/testify/require"
+
+	sequencerutils "github.com/facundomedica/rollinky/sequencer/utils"
+	attestationtypes "rollinky/x/attestation/types"
+)
+
+type mockAttestationKeeper struct {
+	dispersions map[string]attestationtypes.PriceDispersion
+	collateral  []byte
+	// params, if set, replace the default params.
+	params *attestationtypes.Params
+}
+
+func (k *mockAttestationKeeper) GetParams(context.Context) attestationtypes.Params {
+	if k.params != nil {
+		return *k.params
+	}
+	return attestationtypes.DefaultParams()
+}
+
+func (k *mockAttestationKeeper) ValidateConfigDigest(context.Context, []byte) error { return nil }
+
+func (k *mockAttestationKeeper) ValidateSequencerSignature(context.Context, []byte, []byte, uint64) error {
+	return nil
+}
+
+func (k *mockAttestationKeeper) SetLastSequencerHeight(context.Context, uint64) {}
+
+func (k *mockAttestationKeeper) GetCollateral(context.Context) []byte { return k.collateral }
+
+func (k *mockAttestationKeeper) SetAttestationRecord(context.Context, attestationtypes.AttestationRecord) {
+}
+
+func (k *mockAttestationKeeper) SetPriceDispersion(_ context.Context, d attestationtypes.PriceDispersion) {
+	k.dispersions[d.CurrencyPair] = d
+}
+
+type mockMarketMapKeeper map[string]mmtypes.Market
+
+func (k mockMarketMapKeeper) GetMarket(_ context.Context, tickerStr string) (mmtypes.Market, error) {
+	market, ok := k[tickerStr]
+	if !ok {
+		return mmtypes.Market{}, fmt.Errorf("market %s not found", tickerStr)
+	}
+	return market, nil
+}
+
+func TestAggregateProviderPrices(t *testing.T) {
+	market := func(base string, minProviderCount uint64, providers ...string) mmtypes.Market {
+		m := mmtypes.Market{
+			Ticker: mmtypes.Ticker{
+				CurrencyPair:     connecttypes.NewCurrencyPair(base, "USD"),
+				Decimals:         8,
+				MinProviderCount: minProviderCount,
+				Enabled:          true,
+			},
+		}
+		for _, provider := range providers {
+			m.ProviderConfigs = append(m.ProviderConfigs, mmtypes.ProviderConfig{Name: provider})
+		}
+		return m
+	}
+
+	ak := &mockAttestationKeeper{dispersions: map[string]attestationtypes.PriceDispersion{}}
+	h := &RollkitHandler{
+		logger: log.NewNopLogger(),
+		ak:     ak,
+		mmk: mockMarketMapKeeper{
+			"BTC/USD": market("BTC", 3, "coinbase_api", "coinbase_api", "binance_api"),
+			"ETH/USD": market("ETH", 2, "coinbase_api", "binance_api"),
+		},
+	}
+	blockTime := time.Unix(1_700_000_000, 0)
+	ctx := sdk.Context{}.
+		WithBlockHeader(cmtproto.Header{Height: 10, Time: blockTime}).
+		WithEventManager(sdk.NewEventManager())
+
+	fresh := blockTime.Add(-time.Second).UnixNano()
+	stale := blockTime.Add(-attestationtypes.DefaultMaxProviderPriceAge - time.Second).UnixNano()
+	providerPrices, err := sequencerutils.UnmarshalProviderPrices([]byte(fmt.Sprintf(`{
+		"BTC/USD": [
+			{"provider": "coinbase_api", "price": "1", "timestamp": %[2]d},
+			{"provider": "coinbase_api", "price": "100", "timestamp": %[1]d},
+			{"provider": "coinbase_api", "price": "104", "timestamp": %[1]d},
+			{"provider": "binance_api", "price": "101", "timestamp": %[1]d},
+			{"provider": "binance_api", "price": "1000", "timestamp": %[1]d},
+			{"provider": "kraken_api", "price": "1", "timestamp": %[1]d}
+		],
+		"ETH/USD": [
+			{"provider": "coinbase_api", "price": "10", "timestamp": %[1]d},
+			{"provider": "binance_api", "price": "11", "timestamp": %[2]d}
+		],
+		"SOL/USD": [
+			{"provider": "coinbase_api", "price": "5", "timestamp": %[1]d}
+		]
+	}`, fresh, stale)))
+	require.NoError(t, err)
+
+	update := h.aggregateProviderPrices(ctx, providerPrices)
+
+	// the stale coinbase price, the duplicate binance price and the unconfigured
+	// kraken price are ignored, ETH/USD has too few fresh prices and SOL/USD has
+	// no market.
+	require.Equal(t, map[connecttypes.CurrencyPair]*big.Int{
+		connecttypes.NewCurrencyPair("BTC", "USD"): big.NewInt(101),
+	}, update.prices)
+	require.Equal(t, map[connecttypes.CurrencyPair]string{
+		connecttypes.NewCurrencyPair("ETH", "USD"): PriceReasonInsufficientProviders,
+	}, update.skipped)
+	require.Empty(t, update.failed)
+
+	require.Equal(t, map[string]attestationtypes.PriceDispersion{
+		"BTC/USD": {
+			CurrencyPair:  "BTC/USD",
+			BlockHeight:   10,
+			ProviderCount: 3,
+			MinPrice:      "100",
+			MaxPrice:      "104",
+			MedianPrice:   "101",
+			SpreadBps:     396,
+		},
+	}, ak.dispersions)
+
+	// a malformed pair or provider price only fails its own pair.
+	update = h.aggregateProviderPrices(ctx, map[string][]sequencerutils.ProviderPrice{
+		"BTC/USD": {
+			{Provider: "coinbase_api", Price: "100", Timestamp: fresh},
+			{Provider: "binance_api", Price: "1.5", Timestamp: fresh},
+		},
+		"BTCUSD": {{Provider: "coinbase_api", Price: "100", Timestamp: fresh}},
+		"ETH/USD": {
+			{Provider: "coinbase_api", Price: "10", Timestamp: fresh},
+			{Provider: "binance_api", Price: "11", Timestamp: fresh},
+		},
+	})
+	require.Equal(t, map[connecttypes.CurrencyPair]*big.Int{
+		connecttypes.NewCurrencyPair("ETH", "USD"): big.NewInt(10),
+	}, update.prices)
+	require.Equal(t, map[string]string{
+		"BTC/USD": PriceReasonInvalidPrice,
+		"BTCUSD":  PriceReasonInvalidCurrencyPair,
+	}, update.failed)
+
+	// so does a provider price too large to be written.
+	update = h.aggregateProviderPrices(ctx, map[string][]sequencerutils.ProviderPrice{
+		"ETH/USD": {
+			{Provider: "coinbase_api", Price: "10", Timestamp: fresh},
+			{Provider: "binance_api", Price: new(big.Int).Lsh(big.NewInt(1), maxPriceBitLen).String(), Timestamp: fresh},
+		},
+	})
+	require.Empty(t, update.prices)
+	require.Equal(t, map[string]string{"ETH/USD": PriceReasonInvalidPrice}, update.failed)
+}
+
+func TestMedianOfSorted(t *testing.T) {
+	ints := func(values ...int64) []*big.Int {
+		out := make([]*big.Int, len(values))
+		for i, v := range values {
+			out[i] = big.NewInt(v)
+		}
+		return out
+	}
+
+	require.Equal(t, big.NewInt(2), medianOfSorted(ints(1, 2, 3)))
+	require.Equal(t, big.NewInt(2), medianOfSorted(ints(1, 2, 3, 4)))
+	require.Equal(t, big.NewInt(7), medianOfSorted(ints(7)))
+}
@@ -0,0 +1,164 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/skip-mev/connect/v2/abci/proposals"
+	"github.com/skip-mev/connect/v2/abci/strategies/aggregator"
+	"github.com/skip-mev/connect/v2/abci/strategies/codec"
+	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
+	"github.com/skip-mev/connect/v2/abci/ve"
+	oracleconfig "github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/pkg/math/voteweighted"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracleclient "github.com/skip-mev/connect/v2/service/clients/oracle"
+)
+
+// The oracle mode selects where the PreBlocker reads a block's prices from.
+const (
+	// OracleModeRollkit reads the attested envelope the Rollkit sequencer puts
+	// at the head of every block. It is the default.
+	OracleModeRollkit = "rollkit"
+	// OracleModeVoteExtensions runs the Connect vote extensions pipeline, for
+	// CometBFT deployments with several validators: validators extend their
+	// votes with their sidecar's prices and the proposer injects the votes in
+	// the next block.
+	OracleModeVoteExtensions = "vote-extensions"
+)
+
+// voteExtensionTimeout is how long a validator waits for its sidecar's prices
+// when extending its vote.
+const voteExtensionTimeout = time.Second
+
+// VoteExtensionsPreBlocker returns a PreBlocker that aggregates the oracle
+// vote extensions injected in the block into the stake weighted median price
+// of every pair, then validates and writes them like the Rollkit mode does.
+func (h *RollkitHandler) VoteExtensionsPreBlocker(
+	mm *module.Manager,
+	va aggregator.VoteAggregator,
+	veCodec codec.VoteExtensionCodec,
+	ecCodec codec.ExtendedCommitCodec,
+) sdk.PreBlocker {
+	return h.preBlocker(mm, func(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) (applyUpdate, error) {
+		// the votes of the block where vote extensions are enabled are only
+		// injected in the next one.
+		if !ve.VoteExtensionsEnabled(ctx) {
+			h.logger.Info(
+				"vote extensions are not enabled",
+				"height", ctx.BlockHeight(),
+			)
+			h.missUpdate(ctx, UpdateReasonVoteExtensionsDisabled, nil)
+			return nil, nil
+		}
+
+		votes, err := aggregator.GetOracleVotes(req.Txs, veCodec, ecCodec)
+		if err != nil {
+			return nil, discardUpdate(UpdateReasonInvalidVoteExtensions, fmt.Errorf("failed to get oracle votes: %w", err))
+		}
+
+		return func(ctx sdk.Context) (map[connecttypes.CurrencyPair]*big.Int, error) {
+			return h.updatePricesFromVotes(ctx, va, votes)
+		}, nil
+	})
+}
+
+// updatePricesFromVotes aggregates the oracle votes and writes the resulting
+// prices, returning the written prices.
+func (h *RollkitHandler) updatePricesFromVotes(ctx sdk.Context, va aggregator.VoteAggregator, votes []aggregator.Vote) (map[connecttypes.CurrencyPair]*big.Int, error) {
+	prices, err := va.AggregateOracleVotes(ctx, votes)
+	if err != nil {
+		return nil, discardUpdate(UpdateReasonAggregationFailed, fmt.Errorf("failed to aggregate oracle votes: %w", err))
+	}
+
+	update := priceUpdate{
+		prices: prices,
+		failed: make(map[string]string),
+	}
+	valid, rejected := h.validatePrices(ctx, update)
+	if err := h.checkFailureRatio(ctx, update, valid, rejected); err != nil {
+		return nil, err
+	}
+
+	// vote extension prices aren't attested by an enclave report.
+	return h.writePrices(ctx, valid, ""), nil
+}
+
+// setVoteExtensionHandlers sets the Connect vote extension and proposal
+// handlers, and a PreBlocker writing the prices aggregated from the votes
+// through rh. The validators' sidecar is configured by the [oracle] section
+// of app.toml.
+func (app *App) setVoteExtensionHandlers(rh *RollkitHandler, appOpts servertypes.AppOptions) error {
+	cfg, err := oracleconfig.ReadConfigFromAppOpts(appOpts)
+	if err != nil {
+		return fmt.Errorf("failed to read oracle config: %w", err)
+	}
+
+	client, err := oracleclient.NewClientFromConfig(cfg, app.Logger().With("client", "oracle"), rh.metrics)
+	if err != nil {
+		return fmt.Errorf("failed to create oracle client: %w", err)
+	}
+	go func() {
+		if err := client.Start(context.Background()); err != nil {
+			app.Logger().Error("failed to start oracle client", "err", err)
+		}
+	}()
+
+	veCodec := codec.NewCompressionVoteExtensionCodec(
+		codec.NewDefaultVoteExtensionCodec(),
+		codec.NewZLibCompressor(),
+	)
+	ecCodec := codec.NewCompressionExtendedCommitCodec(
+		codec.NewDefaultExtendedCommitCodec(),
+		codec.NewZStdCompressor(),
+	)
+
+	// prices are encoded whole rather than as deltas from the on-chain price,
+	// since the PreBlocker may withhold prices the votes agreed on.
+	strategy := currencypair.NewDefaultCurrencyPairStrategy(app.OracleKeeper)
+	newVoteAggregator := func() aggregator.VoteAggregator {
+		return aggregator.NewDefaultVoteAggregator(
+			app.Logger(),
+			voteweighted.MedianFromContext(app.Logger(), app.StakingKeeper, voteweighted.DefaultPowerThreshold),
+			strategy,
+		)
+	}
+
+	proposalHandler := proposals.NewProposalHandler(
+		app.Logger(),
+		baseapp.NoOpPrepareProposal(),
+		baseapp.NoOpProcessProposal(),
+		ve.NewDefaultValidateVoteExtensionsFn(app.StakingKeeper),
+		veCodec,
+		ecCodec,
+		strategy,
+		rh.metrics,
+	)
+	app.App.SetPrepareProposal(proposalHandler.PrepareProposalHandler())
+	app.App.SetProcessProposal(proposalHandler.ProcessProposalHandler())
+
+	// the vote extension handler applies the prices of the proposal before
+	// extending the vote, with an aggregator of its own.
+	voteExtensionHandler := ve.NewVoteExtensionHandler(
+		app.Logger(),
+		client,
+		voteExtensionTimeout,
+		strategy,
+		veCodec,
+		aggregator.NewOraclePriceApplier(newVoteAggregator(), app.OracleKeeper, veCodec, ecCodec, app.Logger()),
+		rh.metrics,
+	)
+	app.App.SetExtendVoteHandler(voteExtensionHandler.ExtendVoteHandler())
+	app.App.SetVerifyVoteExtensionHandler(voteExtensionHandler.VerifyVoteExtensionHandler())
+
+	app.App.SetPreBlocker(rh.VoteExtensionsPreBlocker(app.ModuleManager, newVoteAggregator(), veCodec, ecCodec))
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package app
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/skip-mev/connect/v2/abci/strategies/aggregator"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	"github.com/stretchr/testify/require"
+
+	attestationtypes "rollinky/x/attestation/types"
+)
+
+// mockVoteAggregator returns fixed prices, whatever the votes.
+type mockVoteAggregator struct {
+	prices map[connecttypes.CurrencyPair]*big.Int
+	err    error
+}
+
+func (a *mockVoteAggregator) AggregateOracleVotes(sdk.Context, []aggregator.Vote) (map[connecttypes.CurrencyPair]*big.Int, error) {
+	return a.prices, a.err
+}
+
+func (a *mockVoteAggregator) GetPriceForValidator(sdk.ConsAddress) map[connecttypes.CurrencyPair]*big.Int {
+	return nil
+}
+
+func TestUpdatePricesFromVotes(t *testing.T) {
+	cp := func(base string) connecttypes.CurrencyPair {
+		return connecttypes.NewCurrencyPair(base, "USD")
+	}
+	market := func(base string, enabled bool) mmtypes.Market {
+		return mmtypes.Market{
+			Ticker: mmtypes.Ticker{
+				CurrencyPair:     cp(base),
+				Decimals:         8,
+				MinProviderCount: 1,
+				Enabled:          enabled,
+			},
+		}
+	}
+
+	ok := &mockOracleKeeper{
+		pairs:  []connecttypes.CurrencyPair{cp("BTC"), cp("ETH"), cp("SOL"), cp("ARB")},
+		prices: map[connecttypes.CurrencyPair]oracletypes.QuotePrice{},
+	}
+	h := &RollkitHandler{
+		logger: log.NewNopLogger(),
+		ok:     ok,
+		ak:     &mockAttestationKeeper{},
+		mmk: mockMarketMapKeeper{
+			"BTC/USD": market("BTC", true),
+			"ETH/USD": market("ETH", true),
+			"SOL/USD": market("SOL", false),
+			"ARB/USD": market("ARB", true),
+		},
+		pl: mockPriceLimiter{"ARB/USD": true},
+	}
+	ctx := sdk.Context{}.
+		WithBlockHeader(cmtproto.Header{Height: 10}).
+		WithEventManager(sdk.NewEventManager())
+
+	// vote extension prices carry no attested decimals, they are written as
+	// long as their market allows it.
+	written, err := h.updatePricesFromVotes(ctx, &mockVoteAggregator{prices: map[connecttypes.CurrencyPair]*big.Int{
+		cp("BTC"): big.NewInt(100),
+		cp("SOL"): big.NewInt(300),
+		cp("ARB"): big.NewInt(600),
+	}}, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[connecttypes.CurrencyPair]*big.Int{cp("BTC"): big.NewInt(100)}, written)
+	require.Equal(t, int64(100), ok.prices[cp("BTC")].Price.Int64())
+
+	skipped := map[string]string{}
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		if e, ok := msg.(*attestationtypes.EventPriceSkipped); ok {
+			skipped[e.CurrencyPair] = e.Reason
+		}
+	}
+	require.Equal(t, map[string]string{
+		"ETH/USD": PriceReasonNoPrice,
+		"SOL/USD": PriceReasonMarketDisabled,
+		"ARB/USD": PriceReasonWithheld,
+	}, skipped)
+
+	// votes that can't be aggregated discard the update.
+	_, err = h.updatePricesFromVotes(ctx, &mockVoteAggregator{err: errors.New("no votes")}, nil)
+	var discarded *discardedUpdateError
+	require.ErrorAs(t, err, &discarded)
+	require.Equal(t, UpdateReasonAggregationFailed, discarded.reason)
+
+	// so do too many failing prices.
+	_, err = h.updatePricesFromVotes(ctx, &mockVoteAggregator{prices: map[connecttypes.CurrencyPair]*big.Int{
+		cp("BTC"): big.NewInt(-1),
+	}}, nil)
+	require.ErrorAs(t, err, &discarded)
+	require.Equal(t, UpdateReasonTooManyFailures, discarded.reason)
+}
+
+func TestVoteExtensionsPreBlockerDisabled(t *testing.T) {
+	h := &RollkitHandler{
+		logger: log.NewNopLogger(),
+		ok:     &mockOracleKeeper{},
+	}
+	ctx := sdk.Context{}.
+		WithBlockHeader(cmtproto.Header{Height: 10}).
+		WithConsensusParams(cmtproto.ConsensusParams{}).
+		WithEventManager(sdk.NewEventManager())
+
+	// until vote extensions are enabled, blocks carry no votes.
+	preBlocker := h.VoteExtensionsPreBlocker(module.NewManager(), &mockVoteAggregator{}, nil, nil)
+	_, err := preBlocker(ctx, &cometabci.RequestFinalizeBlock{Height: 10})
+	require.NoError(t, err)
+
+	events := ctx.EventManager().ABCIEvents()
+	require.Len(t, events, 1)
+	msg, err := sdk.ParseTypedEvent(events[0])
+	require.NoError(t, err)
+	require.Equal(t, UpdateReasonVoteExtensionsDisabled, msg.(*attestationtypes.EventOracleUpdateMissed).Reason)
+}
@@ -48,7 +48,9 @@ func initRootCmd(
 		newApp, appExport,
 		server.StartCmdOptions{
 			AddFlags: func(cmd *cobra.Command) {
-				cmd.Flags().String("signer-id", "", "Intel SGX signer ID")
+				cmd.Flags().String("oracle-mode", app.OracleModeRollkit, "Where prices come from: rollkit (attested envelope from the sequencer) or vote-extensions (Connect vote extensions, for multi-validator CometBFT chains)")
+				cmd.Flags().String("signer-id", "", "Intel SGX signer ID (rollkit oracle mode), overrides "+app.FlagAttestationSignerIDs)
+				addAttestationFlags(cmd)
 				rollconf.AddFlags(cmd)
 				addModuleInitFlags(cmd)
 			},
@@ -65,6 +67,19 @@ func initRootCmd(
 	)
 }
 
+// addAttestationFlags adds a flag for every key of the [rollinky.attestation]
+// section of app.toml.
+func addAttestationFlags(startCmd *cobra.Command) {
+	defaults := app.DefaultAttestationConfig()
+
+	startCmd.Flags().StringSlice(app.FlagAttestationSignerIDs, defaults.SignerIDs, "Hex encoded signer IDs of the accepted sidecar enclaves")
+	startCmd.Flags().StringSlice(app.FlagAttestationAllowedTCBStatuses, defaults.AllowedTCBStatuses, "TCB statuses a report's platform may have, overrides the allowed_tcb_statuses param")
+	startCmd.Flags().Duration(app.FlagAttestationMaxReportAge, defaults.MaxReportAge, "How far from the block time a report may have been created, overrides the max_report_age param")
+	startCmd.Flags().String(app.FlagAttestationMissingPrices, defaults.MissingPrices, `What a missing price does, "skip" or "fail", overrides the fail_missing_prices param`)
+	startCmd.Flags().String(app.FlagAttestationCollateralCachePath, defaults.CollateralCachePath, "File the pinned DCAP collateral is copied to")
+	startCmd.Flags().Bool(app.FlagAttestationMetrics, defaults.Metrics, "Export the oracle metrics to Prometheus")
+}
+
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 }
@@ -44,6 +44,7 @@ func initAppConfig() (string, interface{}) {
 	type CustomAppConfig struct {
 		serverconfig.Config `mapstructure:",squash"`
 		Oracle              oracleconfig.AppConfig `mapstructure:"oracle" json:"oracle"`
+		Rollinky            app.RollinkyConfig     `mapstructure:"rollinky" json:"rollinky"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -70,9 +71,12 @@ func initAppConfig() (string, interface{}) {
 	customAppConfig := CustomAppConfig{
 		Config: *srvCfg,
 		Oracle: oraclecfg,
+		Rollinky: app.RollinkyConfig{
+			Attestation: app.DefaultAttestationConfig(),
+		},
 	}
 
-	customAppTemplate := serverconfig.DefaultConfigTemplate + oracleconfig.DefaultConfigTemplate
+	customAppTemplate := serverconfig.DefaultConfigTemplate + oracleconfig.DefaultConfigTemplate + app.AttestationConfigTemplate
 	// Edit the default template file
 	//
 	// customAppTemplate := serverconfig.DefaultConfigTemplate + `
@@ -25,7 +25,10 @@ var _ OracleClient = (*GRPCClient)(nil)
 // stopped upon app shutdown/cleanup.
 type GRPCClient struct {
 	logger log.Logger
-	mutex  sync.Mutex
+	// mutex guards client and conn. It is only held while the connection is
+	// swapped or read, never for the duration of a call, so concurrent callers
+	// are not serialized behind each other.
+	mutex sync.RWMutex
 
 	// address of remote oracle server
 	addr string
@@ -86,7 +89,7 @@ func NewPriceDaemonClientFromConfig(
 		return nil, err
 	}
 
-	return NewPriceDaemon(logger, cfg, client)
+	return NewPriceDaemon(logger, cfg, client, opts...)
 }
 
 // NewClient creates a new grpc client of the oracle service with the given
@@ -177,21 +180,35 @@ func (c *GRPCClient) Start(ctx context.Context) error {
 }
 
 // Stop stops the GRPC client. This method closes the connection to the remote.
+// In-flight calls are cancelled by the connection close rather than waited on,
+// so Stop never blocks behind a hanging request.
 func (c *GRPCClient) Stop() error {
 	c.mutex.Lock()
-	defer c.mutex.Unlock()
+	conn := c.conn
+	c.client = nil
+	c.conn = nil
+	c.mutex.Unlock()
 
 	c.logger.Info("stopping oracle client")
-	if c.conn == nil {
+	if conn == nil {
 		return nil
 	}
 
-	err := c.conn.Close()
+	err := conn.Close()
 	c.logger.Info("oracle client stopped", "err", err)
 
 	return err
 }
 
+// oracleClient returns the underlying oracle client, or nil if the client has
+// not been started (or has been stopped).
+func (c *GRPCClient) oracleClient() types.OracleClient {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.client
+}
+
 // Prices returns the prices from the remote oracle service. This method blocks for the timeout duration configured on the client,
 // otherwise it returns the response from the remote oracle.
 func (c *GRPCClient) Prices(
@@ -199,9 +216,6 @@ func (c *GRPCClient) Prices(
 	req *types.QueryPricesRequest,
 	opts ...grpc.CallOption,
 ) (resp *types.QueryPricesResponse, err error) {
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-
 	start := time.Now()
 	defer func() {
 		// Observe the duration of the call as well as the error.
@@ -213,19 +227,17 @@ func (c *GRPCClient) Prices(
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	if c.client == nil {
+	client := c.oracleClient()
+	if client == nil {
 		return nil, fmt.Errorf("oracle client not started")
 	}
 
 	opts = append(opts, grpc.WaitForReady(true))
 
-	return c.client.Prices(ctx, req, opts...)
+	return client.Prices(ctx, req, opts...)
 }
 
 func (c *GRPCClient) MarketMap(ctx context.Context, req *types.QueryMarketMapRequest, _ ...grpc.CallOption) (res *types.QueryMarketMapResponse, err error) {
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-
 	start := time.Now()
 	defer func() {
 		// Observe the duration of the call as well as the error.
@@ -237,18 +249,16 @@ func (c *GRPCClient) MarketMap(ctx context.Context, req *types.QueryMarketMapReq
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	if c.client == nil {
+	client := c.oracleClient()
+	if client == nil {
 		return nil, fmt.Errorf("oracle client not started")
 	}
 
-	return c.client.MarketMap(ctx, req, grpc.WaitForReady(true))
+	return client.MarketMap(ctx, req, grpc.WaitForReady(true))
 }
 
 // Version returns the version of the oracle service.
 func (c *GRPCClient) Version(ctx context.Context, req *types.QueryVersionRequest, _ ...grpc.CallOption) (res *types.QueryVersionResponse, err error) {
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-
 	start := time.Now()
 	defer func() {
 		// Observe the duration of the call as well as the error.
@@ -260,9 +270,10 @@ func (c *GRPCClient) Version(ctx context.Context, req *types.QueryVersionRequest
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	if c.client == nil {
+	client := c.oracleClient()
+	if client == nil {
 		return nil, fmt.Errorf("oracle client not started")
 	}
 
-	return c.client.Version(ctx, req, grpc.WaitForReady(true))
+	return client.Version(ctx, req, grpc.WaitForReady(true))
 }
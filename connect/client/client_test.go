@@ -0,0 +1,215 @@
+package client_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/skip-mev/connect/v2/service/metrics"
+	"github.com/skip-mev/connect/v2/service/servers/oracle/types"
+
+	"github.com/facundomedica/rollinky/connect/client"
+)
+
+// slowOracleServer is an oracle server whose Prices call blocks until release
+// is closed and then sleeps for delay. MarketMap and Version always answer
+// immediately.
+type slowOracleServer struct {
+	types.UnimplementedOracleServer
+
+	entered chan struct{}
+	release chan struct{}
+	delay   time.Duration
+}
+
+func (s *slowOracleServer) Prices(ctx context.Context, _ *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
+	select {
+	case s.entered <- struct{}{}:
+	default:
+	}
+
+	select {
+	case <-s.release:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	time.Sleep(s.delay)
+
+	return &types.QueryPricesResponse{Prices: map[string]string{"BTC/USD": "100"}}, nil
+}
+
+func (s *slowOracleServer) MarketMap(context.Context, *types.QueryMarketMapRequest) (*types.QueryMarketMapResponse, error) {
+	return &types.QueryMarketMapResponse{}, nil
+}
+
+func (s *slowOracleServer) Version(context.Context, *types.QueryVersionRequest) (*types.QueryVersionResponse, error) {
+	return &types.QueryVersionResponse{Version: "test"}, nil
+}
+
+// startServer starts srv on a random local port and returns its address.
+func startServer(t testing.TB, srv types.OracleServer) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	s := grpc.NewServer()
+	types.RegisterOracleServer(s, srv)
+	go s.Serve(lis) //nolint:errcheck
+	t.Cleanup(s.Stop)
+
+	return lis.Addr().String()
+}
+
+func startClient(t testing.TB, addr string, timeout time.Duration) client.OracleClient {
+	t.Helper()
+
+	c, err := client.NewClient(log.NewNopLogger(), addr, timeout, metrics.NewNopMetrics())
+	require.NoError(t, err)
+	require.NoError(t, c.Start(context.Background()))
+
+	return c
+}
+
+func TestGRPCClient_NotStarted(t *testing.T) {
+	c, err := client.NewClient(log.NewNopLogger(), "localhost:0", time.Second, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	_, err = c.Prices(context.Background(), &types.QueryPricesRequest{})
+	require.Error(t, err)
+	require.NoError(t, c.Stop())
+}
+
+func TestGRPCClient_CallsAreNotSerialized(t *testing.T) {
+	srv := &slowOracleServer{
+		entered: make(chan struct{}, 1),
+		release: make(chan struct{}),
+	}
+	c := startClient(t, startServer(t, srv), 5*time.Second)
+	defer c.Stop()
+
+	pricesDone := make(chan error, 1)
+	go func() {
+		_, err := c.Prices(context.Background(), &types.QueryPricesRequest{})
+		pricesDone <- err
+	}()
+
+	select {
+	case <-srv.entered:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Prices call never reached the server")
+	}
+
+	// While Prices is hanging on the server, other calls must still go through.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	version, err := c.Version(ctx, &types.QueryVersionRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "test", version.Version)
+
+	_, err = c.MarketMap(ctx, &types.QueryMarketMapRequest{})
+	require.NoError(t, err)
+
+	close(srv.release)
+	require.NoError(t, <-pricesDone)
+}
+
+func TestGRPCClient_StopDoesNotWaitForInFlightCalls(t *testing.T) {
+	srv := &slowOracleServer{
+		entered: make(chan struct{}, 1),
+		release: make(chan struct{}),
+	}
+	defer close(srv.release)
+	c := startClient(t, startServer(t, srv), time.Minute)
+
+	pricesDone := make(chan error, 1)
+	go func() {
+		_, err := c.Prices(context.Background(), &types.QueryPricesRequest{})
+		pricesDone <- err
+	}()
+	<-srv.entered
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		c.Stop()
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stop blocked behind an in-flight call")
+	}
+
+	// the in-flight call is cancelled by the connection close.
+	require.Error(t, <-pricesDone)
+
+	_, err := c.Version(context.Background(), &types.QueryVersionRequest{})
+	require.Error(t, err)
+}
+
+func TestGRPCClient_ConcurrentCallsAndStop(t *testing.T) {
+	srv := &slowOracleServer{
+		entered: make(chan struct{}, 1),
+		release: make(chan struct{}),
+	}
+	close(srv.release)
+	c := startClient(t, startServer(t, srv), time.Second)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				switch (i + j) % 3 {
+				case 0:
+					c.Prices(context.Background(), &types.QueryPricesRequest{}) //nolint:errcheck
+				case 1:
+					c.MarketMap(context.Background(), &types.QueryMarketMapRequest{}) //nolint:errcheck
+				default:
+					c.Version(context.Background(), &types.QueryVersionRequest{}) //nolint:errcheck
+				}
+			}
+		}(i)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, c.Stop())
+	}()
+
+	wg.Wait()
+}
+
+// BenchmarkGRPCClient_ParallelPrices issues Prices calls against a server that
+// takes a millisecond per request. If calls were serialized on the client, the
+// parallel benchmark could not do better than ~1ms/op.
+func BenchmarkGRPCClient_ParallelPrices(b *testing.B) {
+	srv := &slowOracleServer{
+		entered: make(chan struct{}, 1),
+		release: make(chan struct{}),
+		delay:   time.Millisecond,
+	}
+	close(srv.release)
+	c := startClient(b, startServer(b, srv), time.Second)
+	defer c.Stop()
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := c.Prices(context.Background(), &types.QueryPricesRequest{}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// ConfigDigestSize is the size of the digests returned by ConfigDigest.
+const ConfigDigestSize = sha256.Size
+
+// ConfigDigest returns the SHA-256 digest of the sidecar's static oracle
+// config, which the sidecar binds into every enclave report so that a chain can
+// tell which providers and intervals the prices were computed with. The listen
+// address and the metrics config don't affect prices and are left out, so that
+// the same config deployed on different hosts has the same digest. The
+// endpoints' credentials are left out too, so that operators with their own API
+// keys share a digest and rotating a key doesn't change it.
+//
+// The market map is updated at runtime and isn't covered: the sidecar attests
+// the market map of every aggregation separately (see MarketMapDigest).
+//
+// The digest is taken over the JSON encoding of the config, which is
+// deterministic: maps are encoded with sorted keys.
+func ConfigDigest(cfg config.OracleConfig) ([]byte, error) {
+	cfg.Host = ""
+	cfg.Port = ""
+	cfg.Metrics = config.MetricsConfig{}
+
+	providers := make(map[string]config.ProviderConfig, len(cfg.Providers))
+	for name, provider := range cfg.Providers {
+		provider.API.Endpoints = withoutCredentials(provider.API.Endpoints)
+		provider.WebSocket.Endpoints = withoutCredentials(provider.WebSocket.Endpoints)
+		providers[name] = provider
+	}
+	cfg.Providers = providers
+
+	bz, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode config: %w", err)
+	}
+
+	digest := sha256.Sum256(bz)
+	return digest[:], nil
+}
+
+// withoutCredentials returns a copy of endpoints without their authentication.
+func withoutCredentials(endpoints []config.Endpoint) []config.Endpoint {
+	if endpoints == nil {
+		return nil
+	}
+
+	stripped := make([]config.Endpoint, len(endpoints))
+	for i, endpoint := range endpoints {
+		endpoint.Authentication = config.Authentication{}
+		stripped[i] = endpoint
+	}
+
+	return stripped
+}
+
+// EncodeMarketMap encodes the market map the sidecar aggregated prices with,
+// which it returns next to the enclave report so that a chain can check the
+// markets and providers every price was computed from. The JSON encoding is
+// deterministic: maps are encoded with sorted keys.
+func EncodeMarketMap(marketMap mmtypes.MarketMap) ([]byte, error) {
+	bz, err := json.Marshal(marketMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode market map: %w", err)
+	}
+
+	return bz, nil
+}
+
+// MarketMapDigest returns the SHA-256 digest of a market map encoded with
+// EncodeMarketMap, which the sidecar binds into the enclave report. It is nil
+// if there is no market map.
+func MarketMapDigest(encoded []byte) []byte {
+	if len(encoded) == 0 {
+		return nil
+	}
+
+	digest := sha256.Sum256(encoded)
+	return digest[:]
+}
@@ -0,0 +1,123 @@
+package client_test
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+
+	"github.com/facundomedica/rollinky/connect/client"
+)
+
+func TestConfigDigest(t *testing.T) {
+	cfg := config.OracleConfig{
+		UpdateInterval: time.Second,
+		MaxPriceAge:    time.Minute,
+		Providers: map[string]config.ProviderConfig{
+			"coingecko_api": {
+				Name: "coingecko_api",
+				API: config.APIConfig{
+					Enabled: true,
+					Endpoints: []config.Endpoint{{
+						URL:            "https://api.coingecko.com/api/v3",
+						Authentication: config.Authentication{APIKey: "secret", APIKeyHeader: "x-cg-pro-api-key"},
+					}},
+				},
+			},
+		},
+		Host: "0.0.0.0",
+		Port: "8080",
+	}
+
+	digest, err := client.ConfigDigest(cfg)
+	require.NoError(t, err)
+	require.Len(t, digest, client.ConfigDigestSize)
+
+	t.Run("listen address and metrics are not covered", func(t *testing.T) {
+		other := cfg
+		other.Host = "localhost"
+		other.Port = "9090"
+		other.Metrics = config.MetricsConfig{Enabled: true, PrometheusServerAddress: "0.0.0.0:8002"}
+
+		otherDigest, err := client.ConfigDigest(other)
+		require.NoError(t, err)
+		require.Equal(t, digest, otherDigest)
+	})
+
+	t.Run("credentials are not covered", func(t *testing.T) {
+		provider := cfg.Providers["coingecko_api"]
+		provider.API.Endpoints = []config.Endpoint{{
+			URL:            "https://api.coingecko.com/api/v3",
+			Authentication: config.Authentication{APIKey: "other secret", APIKeyHeader: "x-cg-pro-api-key"},
+		}}
+		other := cfg
+		other.Providers = map[string]config.ProviderConfig{"coingecko_api": provider}
+
+		otherDigest, err := client.ConfigDigest(other)
+		require.NoError(t, err)
+		require.Equal(t, digest, otherDigest)
+
+		// the caller's config is left untouched.
+		require.Equal(t, "other secret", other.Providers["coingecko_api"].API.Endpoints[0].Authentication.APIKey)
+	})
+
+	t.Run("oracle config is covered", func(t *testing.T) {
+		other := cfg
+		other.MaxPriceAge = time.Hour
+
+		otherDigest, err := client.ConfigDigest(other)
+		require.NoError(t, err)
+		require.NotEqual(t, digest, otherDigest)
+	})
+
+	t.Run("endpoints are covered", func(t *testing.T) {
+		provider := cfg.Providers["coingecko_api"]
+		provider.API.Endpoints = []config.Endpoint{{URL: "https://example.com"}}
+		other := cfg
+		other.Providers = map[string]config.ProviderConfig{"coingecko_api": provider}
+
+		otherDigest, err := client.ConfigDigest(other)
+		require.NoError(t, err)
+		require.NotEqual(t, digest, otherDigest)
+	})
+}
+
+func TestMarketMapDigest(t *testing.T) {
+	market := mmtypes.Market{
+		Ticker: mmtypes.Ticker{
+			CurrencyPair:     connecttypes.NewCurrencyPair("BTC", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []mmtypes.ProviderConfig{{Name: "coinbase_api", OffChainTicker: "BTC-USD"}},
+	}
+	digest := func(markets map[string]mmtypes.Market) []byte {
+		bz, err := client.EncodeMarketMap(mmtypes.MarketMap{Markets: markets})
+		require.NoError(t, err)
+		return client.MarketMapDigest(bz)
+	}
+
+	want := digest(map[string]mmtypes.Market{"BTC/USD": market})
+	require.Len(t, want, sha256.Size)
+	require.Equal(t, want, digest(map[string]mmtypes.Market{"BTC/USD": market}))
+	require.Nil(t, client.MarketMapDigest(nil))
+
+	// the decimals, the min provider count and the providers are covered.
+	other := market
+	other.Ticker.Decimals = 6
+	require.NotEqual(t, want, digest(map[string]mmtypes.Market{"BTC/USD": other}))
+
+	other = market
+	other.Ticker.MinProviderCount = 3
+	require.NotEqual(t, want, digest(map[string]mmtypes.Market{"BTC/USD": other}))
+
+	other = market
+	other.ProviderConfigs = []mmtypes.ProviderConfig{{Name: "binance_api", OffChainTicker: "BTCUSDT"}}
+	require.NotEqual(t, want, digest(map[string]mmtypes.Market{"BTC/USD": other}))
+}
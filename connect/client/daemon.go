@@ -24,6 +24,15 @@ type WithTrailer interface {
 
 var _ WithTrailer = (*PriceDaemon)(nil)
 
+// WithVerification is implemented by clients that only serve prices whose enclave
+// report has been verified.
+type WithVerification interface {
+	WithTrailer
+	VerifiedPrices(context.Context, *types.QueryPricesRequest, ...grpc.CallOption) (*types.QueryPricesResponse, *Attestation, error)
+}
+
+var _ WithVerification = (*PriceDaemon)(nil)
+
 type PriceDaemon struct {
 	logger log.Logger
 
@@ -35,7 +44,21 @@ type PriceDaemon struct {
 	OracleClient
 	// latestResponse is the latest price response fetched by the daemon.
 	resp ThreadSafeResponse
-	// doneCh is a channel that is closed when the daemon is stopped.
+
+	// signerID is the expected enclave signer ID, used when verify is set.
+	signerID []byte
+	// verify, if set, is used to verify every fetched response. Responses that
+	// fail verification are never stored.
+	verify VerifyFunc
+	// rejected counts the fetched responses that failed verification.
+	rejected atomic.Uint64
+
+	// mtx guards stopCh and doneCh, which are replaced on every Start so that
+	// the daemon can be restarted after it has been stopped.
+	mtx sync.Mutex
+	// stopCh is closed by Stop to signal the current run to exit.
+	stopCh chan struct{}
+	// doneCh is closed when the current run has exited.
 	doneCh chan struct{}
 }
 
@@ -44,6 +67,7 @@ func NewPriceDaemon(
 	logger log.Logger,
 	cfg config.AppConfig,
 	client OracleClient,
+	opts ...Option,
 ) (*PriceDaemon, error) {
 	if logger == nil {
 		return nil, fmt.Errorf("logger cannot be nil")
@@ -57,16 +81,43 @@ func NewPriceDaemon(
 		return nil, fmt.Errorf("oracle client cannot be nil")
 	}
 
-	return &PriceDaemon{
+	doneCh := make(chan struct{})
+	close(doneCh)
+
+	daemon := &PriceDaemon{
 		logger:       logger.With("process", "price_daemon"),
 		config:       cfg,
 		OracleClient: client,
-		doneCh:       make(chan struct{}),
-	}, nil
+		doneCh:       doneCh,
+	}
+
+	// apply options
+	for _, opt := range opts {
+		opt(daemon)
+	}
+
+	return daemon, nil
 }
 
-// Start starts the price daemon. This method will block until the daemon is stopped.
+// Start starts the price daemon. This method will block until the daemon is stopped,
+// either through Stop or by cancelling ctx. Once it has returned, the daemon can be
+// started again. Starting a daemon that is already running returns an error.
 func (d *PriceDaemon) Start(ctx context.Context) error {
+	d.mtx.Lock()
+	if d.isRunning.Load() {
+		d.mtx.Unlock()
+		return fmt.Errorf("price daemon is already running")
+	}
+	stopCh, doneCh := make(chan struct{}), make(chan struct{})
+	d.stopCh, d.doneCh = stopCh, doneCh
+	d.isRunning.Store(true)
+	d.mtx.Unlock()
+
+	defer func() {
+		d.isRunning.Store(false)
+		close(doneCh)
+	}()
+
 	if err := d.OracleClient.Start(ctx); err != nil {
 		return err
 	}
@@ -76,15 +127,13 @@ func (d *PriceDaemon) Start(ctx context.Context) error {
 	defer ticker.Stop()
 
 	d.logger.Info("starting price daemon")
-	d.isRunning.Store(true)
-	defer d.isRunning.Store(false)
 
 	for {
 		select {
 		case <-ctx.Done():
 			d.logger.Info("stopping price daemon from context")
 			return ctx.Err()
-		case <-d.doneCh:
+		case <-stopCh:
 			d.logger.Info("price daemon stopped")
 			return nil
 		case <-ticker.C:
@@ -106,6 +155,15 @@ func (d *PriceDaemon) fetchPrices(ctx context.Context) {
 	fetchCtx, cancel := context.WithTimeout(ctx, d.config.ClientTimeout)
 	defer cancel()
 
+	// every request carries a fresh nonce, so that a report replayed from an
+	// earlier request fails verification.
+	nonce, err := NewNonce()
+	if err != nil {
+		d.logger.Error("failed to create nonce", "err", err)
+		return
+	}
+	fetchCtx = ContextWithNonce(fetchCtx, nonce)
+
 	var trailer metadata.MD // variable to store trailer
 	resp, err := d.OracleClient.Prices(fetchCtx, &types.QueryPricesRequest{}, grpc.Trailer(&trailer))
 	if err != nil {
@@ -118,9 +176,41 @@ func (d *PriceDaemon) fetchPrices(ctx context.Context) {
 		return
 	}
 
+	if d.verify != nil {
+		if err := d.verifyResponse(resp, trailer, nonce); err != nil {
+			d.logger.Error(
+				"rejected prices from sidecar",
+				"reason", err,
+				"rejected_total", d.rejected.Add(1),
+			)
+
+			return
+		}
+	}
+
 	ts := time.Now()
 	d.logger.Debug("fetched prices", "timestamp", ts, "prices", resp.Prices)
-	d.resp.Update(resp, trailer)
+	d.resp.Update(resp, trailer, nonce)
+}
+
+// verifyResponse verifies resp against the enclave report carried in trailer,
+// which must be bound to the request's nonce.
+func (d *PriceDaemon) verifyResponse(resp *types.QueryPricesResponse, trailer metadata.MD, nonce []byte) error {
+	attestation, err := AttestationFromTrailer(trailer, nonce)
+	if err != nil {
+		return err
+	}
+
+	bz, err := resp.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal prices: %w", err)
+	}
+
+	if err := attestation.Verify(d.verify, bz, d.signerID); err != nil {
+		return fmt.Errorf("failed to verify enclave report: %w", err)
+	}
+
+	return nil
 }
 
 // Prices returns the latest price response fetched by the daemon. If the latest response
@@ -130,39 +220,63 @@ func (d *PriceDaemon) Prices(
 	_ *types.QueryPricesRequest,
 	_ ...grpc.CallOption,
 ) (*types.QueryPricesResponse, error) {
-	latest, ts, _ := d.resp.Get()
-	if latest == nil {
-		d.logger.Error("no prices fetched by price daemon yet")
-		return nil, fmt.Errorf("no prices fetched by price daemon yet")
-	}
-
-	if time.Since(ts) > d.config.PriceTTL {
-		d.logger.Error(
-			"latest prices from the price daemon are too stale",
-			"last_fetched_at", ts.String(),
-			"diff", time.Since(ts).String(),
-			"ttl", d.config.PriceTTL.String(),
-		)
-
-		return nil, fmt.Errorf(
-			"latest prices from the price daemon are too stale; last fetched at %s; diff %s ago",
-			ts.Format(time.RFC3339),
-			time.Since(ts).String(),
-		)
+	latest, _, _, err := d.latest()
+	if err != nil {
+		return nil, err
 	}
 
 	return latest, nil
 }
 
+// PricesWithTrailer returns the latest price response fetched by the daemon along
+// with the trailer the sidecar sent with it. If the daemon has a verifier
+// configured, the response is guaranteed to have passed verification.
 func (d *PriceDaemon) PricesWithTrailer(
 	_ context.Context,
 	_ *types.QueryPricesRequest,
 	_ ...grpc.CallOption,
 ) (*types.QueryPricesResponse, metadata.MD, error) {
-	latest, ts, trailer := d.resp.Get()
+	latest, trailer, _, err := d.latest()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return latest, trailer, nil
+}
+
+// VerifiedPrices returns the latest verified price response together with the
+// attestation (enclave report, nonce and timestamp) it was verified against. It
+// errors if the daemon has no verifier configured, or if the latest verified
+// response is too stale.
+func (d *PriceDaemon) VerifiedPrices(
+	_ context.Context,
+	_ *types.QueryPricesRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryPricesResponse, *Attestation, error) {
+	if d.verify == nil {
+		return nil, nil, fmt.Errorf("price daemon has no verifier configured")
+	}
+
+	latest, trailer, nonce, err := d.latest()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attestation, err := AttestationFromTrailer(trailer, nonce)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return latest, attestation, nil
+}
+
+// latest returns the latest response with its trailer and request nonce, or an
+// error if there is none or it is too stale.
+func (d *PriceDaemon) latest() (*types.QueryPricesResponse, metadata.MD, []byte, error) {
+	latest, ts, trailer, nonce := d.resp.Get()
 	if latest == nil {
 		d.logger.Error("no prices fetched by price daemon yet")
-		return nil, nil, fmt.Errorf("no prices fetched by price daemon yet")
+		return nil, nil, nil, fmt.Errorf("no prices fetched by price daemon yet")
 	}
 
 	if time.Since(ts) > d.config.PriceTTL {
@@ -173,26 +287,55 @@ func (d *PriceDaemon) PricesWithTrailer(
 			"ttl", d.config.PriceTTL.String(),
 		)
 
-		return nil, nil, fmt.Errorf(
+		return nil, nil, nil, fmt.Errorf(
 			"latest prices from the price daemon are too stale; last fetched at %s; diff %s ago",
 			ts.Format(time.RFC3339),
 			time.Since(ts).String(),
 		)
 	}
 
-	return latest, trailer, nil
+	return latest, trailer, nonce, nil
 }
 
-// Stop stops the price daemon.
+// Rejected returns the number of fetched responses that failed verification.
+func (d *PriceDaemon) Rejected() uint64 {
+	return d.rejected.Load()
+}
+
+// Stop signals the price daemon to stop. It does not wait for the daemon to exit
+// (use Wait or Done for that), and it is safe to call any number of times,
+// including before Start or after the daemon has already exited.
 func (d *PriceDaemon) Stop() error {
-	if d.isRunning.Load() {
-		d.doneCh <- struct{}{}
-		close(d.doneCh)
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	if d.stopCh == nil {
+		return nil
+	}
+
+	select {
+	case <-d.stopCh:
+	default:
+		close(d.stopCh)
 	}
 
 	return nil
 }
 
+// Done returns a channel that is closed once the current run of the daemon has
+// exited. If the daemon is not running, the returned channel is already closed.
+func (d *PriceDaemon) Done() <-chan struct{} {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	return d.doneCh
+}
+
+// Wait blocks until the current run of the daemon has exited.
+func (d *PriceDaemon) Wait() {
+	<-d.Done()
+}
+
 // ThreadSafeResponse is a thread-safe wrapper around a QueryPricesResponse.
 type ThreadSafeResponse struct {
 	sync.Mutex
@@ -200,6 +343,7 @@ type ThreadSafeResponse struct {
 	resp      *types.QueryPricesResponse
 	timestamp time.Time
 	trailer   metadata.MD
+	nonce     []byte
 }
 
 // NewThreadSafeResponse creates a new thread-safe response.
@@ -210,20 +354,22 @@ func NewThreadSafeResponse() *ThreadSafeResponse {
 	}
 }
 
-// Update updates the response and timestamp of the thread-safe response.
-func (r *ThreadSafeResponse) Update(resp *types.QueryPricesResponse, trailer metadata.MD) {
+// Update updates the response, its trailer and the nonce it was requested with,
+// and sets the timestamp to now.
+func (r *ThreadSafeResponse) Update(resp *types.QueryPricesResponse, trailer metadata.MD, nonce []byte) {
 	r.Lock()
 	defer r.Unlock()
 
 	r.resp = resp
 	r.timestamp = time.Now()
 	r.trailer = trailer
+	r.nonce = nonce
 }
 
-// Get returns the response and timestamp of the thread-safe response.
-func (r *ThreadSafeResponse) Get() (*types.QueryPricesResponse, time.Time, metadata.MD) {
+// Get returns the response, timestamp, trailer and nonce of the thread-safe response.
+func (r *ThreadSafeResponse) Get() (*types.QueryPricesResponse, time.Time, metadata.MD, []byte) {
 	r.Lock()
 	defer r.Unlock()
 
-	return r.resp, r.timestamp, r.trailer
+	return r.resp, r.timestamp, r.trailer, r.nonce
 }
@@ -1,19 +1,29 @@
 package client_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
 	"cosmossdk.io/log"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/service/clients/oracle"
 	"github.com/skip-mev/connect/v2/service/clients/oracle/mocks"
 	"github.com/skip-mev/connect/v2/service/servers/oracle/types"
+
+	"github.com/facundomedica/rollinky/connect/client"
+	clientmocks "github.com/facundomedica/rollinky/connect/client/mocks"
 )
 
 func TestNewPriceDaemon(t *testing.T) {
@@ -238,3 +248,298 @@ func TestPriceDaemon_Start(t *testing.T) {
 		require.Nil(t, resp)
 	})
 }
+
+func TestPriceDaemon_Lifecycle(t *testing.T) {
+	logger := log.NewTestLogger(t)
+	cfg := config.AppConfig{
+		Enabled:       true,
+		OracleAddress: "localhost:8080",
+		ClientTimeout: time.Second,
+		Interval:      time.Millisecond * 10,
+		PriceTTL:      time.Second,
+	}
+
+	newClient := func(t *testing.T, runs int) *clientmocks.OracleClient {
+		c := clientmocks.NewOracleClient(t)
+		c.On("Start", mock.Anything).Return(nil).Times(runs)
+		c.On("Prices", mock.Anything, mock.Anything, mock.Anything).Return(&types.QueryPricesResponse{}, nil).Maybe()
+		c.On("Stop").Return(nil).Times(runs)
+		return c
+	}
+
+	// start runs the daemon in the background and returns the channel its
+	// Start error will be sent on.
+	start := func(ctx context.Context, d *client.PriceDaemon) <-chan error {
+		errCh := make(chan error, 1)
+		go func() { errCh <- d.Start(ctx) }()
+		require.Eventually(t, func() bool {
+			select {
+			case <-d.Done():
+				return false
+			default:
+				return true
+			}
+		}, time.Second, time.Millisecond)
+		return errCh
+	}
+
+	t.Run("stop before start", func(t *testing.T) {
+		d, err := client.NewPriceDaemon(logger, cfg, newClient(t, 1))
+		require.NoError(t, err)
+
+		require.NoError(t, d.Stop())
+		d.Wait()
+
+		// stopping a daemon that never ran does not prevent it from starting.
+		errCh := start(context.Background(), d)
+		require.NoError(t, d.Stop())
+		require.NoError(t, <-errCh)
+	})
+
+	t.Run("double stop", func(t *testing.T) {
+		d, err := client.NewPriceDaemon(logger, cfg, newClient(t, 1))
+		require.NoError(t, err)
+
+		errCh := start(context.Background(), d)
+		require.NoError(t, d.Stop())
+		require.NoError(t, d.Stop())
+		d.Wait()
+		require.NoError(t, <-errCh)
+		require.NoError(t, d.Stop())
+	})
+
+	t.Run("stop after context cancel", func(t *testing.T) {
+		d, err := client.NewPriceDaemon(logger, cfg, newClient(t, 1))
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := start(ctx, d)
+		cancel()
+		require.ErrorIs(t, <-errCh, context.Canceled)
+
+		stopped := make(chan struct{})
+		go func() {
+			defer close(stopped)
+			require.NoError(t, d.Stop())
+		}()
+
+		select {
+		case <-stopped:
+		case <-time.After(time.Second):
+			t.Fatal("Stop blocked after the daemon exited")
+		}
+	})
+
+	t.Run("start while running", func(t *testing.T) {
+		d, err := client.NewPriceDaemon(logger, cfg, newClient(t, 1))
+		require.NoError(t, err)
+
+		errCh := start(context.Background(), d)
+		require.Error(t, d.Start(context.Background()))
+		require.NoError(t, d.Stop())
+		require.NoError(t, <-errCh)
+	})
+
+	t.Run("restart", func(t *testing.T) {
+		d, err := client.NewPriceDaemon(logger, cfg, newClient(t, 3))
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			errCh := start(context.Background(), d)
+			done := d.Done()
+			require.NoError(t, d.Stop())
+			<-done
+			require.NoError(t, <-errCh)
+		}
+	})
+}
+
+func TestPriceDaemon_Verifier(t *testing.T) {
+	logger := log.NewTestLogger(t)
+	cfg := config.AppConfig{
+		Enabled:       true,
+		OracleAddress: "localhost:8080",
+		ClientTimeout: time.Second,
+		Interval:      time.Millisecond * 10,
+		PriceTTL:      time.Second,
+	}
+	signerID := []byte("signer")
+	prices := map[string]string{"BTC/USD": "10000"}
+
+	const timestamp = int64(1700000000000000000)
+	configDigest := sha256.New().Sum(nil)
+	providerPrices := []byte(`{"BTC/USD":[{"provider":"coinbase_api","price":"10000","timestamp":1700000000000000000}]}`)
+	marketMap := []byte(`{"markets":{}}`)
+
+	// nonces records the nonce of every request the sidecar received.
+	var (
+		noncesMtx sync.Mutex
+		nonces    [][]byte
+	)
+
+	// setTrailer returns a mock Run function that records the request nonce and
+	// populates the grpc.Trailer call option with the given report.
+	setTrailer := func(report string) func(args mock.Arguments) {
+		return func(args mock.Arguments) {
+			md, _ := metadata.FromOutgoingContext(args.Get(0).(context.Context))
+			nonce, err := base64.RawStdEncoding.DecodeString(md.Get(client.EnclaveNonceMetadataKey)[0])
+			require.NoError(t, err)
+
+			noncesMtx.Lock()
+			nonces = append(nonces, nonce)
+			noncesMtx.Unlock()
+
+			for _, opt := range args[2:] {
+				if trailer, ok := opt.(grpc.TrailerCallOption); ok {
+					*trailer.TrailerAddr = metadata.Pairs(
+						client.EnclaveReportTrailerKey,
+						base64.RawStdEncoding.EncodeToString([]byte(report)),
+						client.EnclaveTimestampTrailerKey,
+						fmt.Sprint(timestamp),
+						client.EnclaveConfigDigestTrailerKey,
+						hex.EncodeToString(configDigest),
+						client.EnclaveMarketMapTrailerKey,
+						base64.RawStdEncoding.EncodeToString(marketMap),
+						client.EnclaveProviderPricesTrailerKey,
+						base64.RawStdEncoding.EncodeToString(providerPrices),
+					)
+				}
+			}
+		}
+	}
+
+	// verifyBoundTo accepts only the "good" report, bound to the nonce accepted
+	// by the nonce function.
+	verifyBoundTo := func(acceptNonce func(nonce []byte) bool) client.VerifyFunc {
+		return func(report, data, signer []byte) error {
+			if string(report) != "good" {
+				return fmt.Errorf("bad report")
+			}
+			if !bytes.Equal(signer, signerID) {
+				return fmt.Errorf("bad signer")
+			}
+
+			expected, err := (&types.QueryPricesResponse{Prices: prices}).Marshal()
+			require.NoError(t, err)
+
+			noncesMtx.Lock()
+			defer noncesMtx.Unlock()
+			for _, nonce := range nonces {
+				if acceptNonce(nonce) && bytes.Equal(data, client.BindReportData(expected, nonce, timestamp, configDigest, client.MarketMapDigest(marketMap), providerPrices)) {
+					return nil
+				}
+			}
+
+			return fmt.Errorf("bad data")
+		}
+	}
+
+	// verify accepts a good report bound to any nonce the client sent.
+	verify := verifyBoundTo(func([]byte) bool { return true })
+
+	run := func(t *testing.T, d *client.PriceDaemon) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+		defer cancel()
+		require.ErrorIs(t, d.Start(ctx), context.DeadlineExceeded)
+	}
+
+	t.Run("only verified responses are served", func(t *testing.T) {
+		c := clientmocks.NewOracleClient(t)
+		c.On("Start", mock.Anything).Return(nil).Once()
+		c.On("Prices", mock.Anything, mock.Anything, mock.Anything).
+			Run(setTrailer("good")).
+			Return(&types.QueryPricesResponse{Prices: prices}, nil)
+		c.On("Stop").Return(nil).Once()
+
+		d, err := client.NewPriceDaemon(logger, cfg, c, client.WithVerifier(signerID, verify))
+		require.NoError(t, err)
+		run(t, d)
+
+		resp, attestation, err := d.VerifiedPrices(context.Background(), &types.QueryPricesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, prices, resp.Prices)
+		require.Equal(t, []byte("good"), attestation.Report)
+		require.Len(t, attestation.Nonce, client.NonceSize)
+		require.Equal(t, timestamp, attestation.Timestamp)
+		require.Equal(t, configDigest, attestation.ConfigDigest)
+		require.Equal(t, marketMap, attestation.MarketMap)
+		require.Equal(t, providerPrices, attestation.ProviderPrices)
+		require.Zero(t, d.Rejected())
+	})
+
+	t.Run("reports bound to another request are rejected", func(t *testing.T) {
+		noncesMtx.Lock()
+		nonces = nil
+		noncesMtx.Unlock()
+
+		// only the first request's report is accepted, replaying it for any
+		// later request must fail.
+		var first []byte
+		verifyFirst := verifyBoundTo(func(nonce []byte) bool {
+			if first == nil {
+				first = nonce
+			}
+			return bytes.Equal(nonce, first)
+		})
+
+		c := clientmocks.NewOracleClient(t)
+		c.On("Start", mock.Anything).Return(nil).Once()
+		c.On("Prices", mock.Anything, mock.Anything, mock.Anything).
+			Run(setTrailer("good")).
+			Return(&types.QueryPricesResponse{Prices: prices}, nil)
+		c.On("Stop").Return(nil).Once()
+
+		d, err := client.NewPriceDaemon(logger, cfg, c, client.WithVerifier(signerID, verifyFirst))
+		require.NoError(t, err)
+		run(t, d)
+
+		noncesMtx.Lock()
+		fetched := len(nonces)
+		noncesMtx.Unlock()
+		require.Greater(t, fetched, 1)
+		require.Equal(t, uint64(fetched-1), d.Rejected())
+	})
+
+	t.Run("rejected responses are never served", func(t *testing.T) {
+		c := clientmocks.NewOracleClient(t)
+		c.On("Start", mock.Anything).Return(nil).Once()
+		c.On("Prices", mock.Anything, mock.Anything, mock.Anything).
+			Run(setTrailer("bad")).
+			Return(&types.QueryPricesResponse{Prices: prices}, nil)
+		c.On("Stop").Return(nil).Once()
+
+		d, err := client.NewPriceDaemon(logger, cfg, c, client.WithVerifier(signerID, verify))
+		require.NoError(t, err)
+		run(t, d)
+
+		_, _, err = d.VerifiedPrices(context.Background(), &types.QueryPricesRequest{})
+		require.Error(t, err)
+		_, _, err = d.PricesWithTrailer(context.Background(), &types.QueryPricesRequest{})
+		require.Error(t, err)
+		require.NotZero(t, d.Rejected())
+	})
+
+	t.Run("missing trailer is rejected", func(t *testing.T) {
+		c := clientmocks.NewOracleClient(t)
+		c.On("Start", mock.Anything).Return(nil).Once()
+		c.On("Prices", mock.Anything, mock.Anything, mock.Anything).
+			Return(&types.QueryPricesResponse{Prices: prices}, nil)
+		c.On("Stop").Return(nil).Once()
+
+		d, err := client.NewPriceDaemon(logger, cfg, c, client.WithVerifier(signerID, verify))
+		require.NoError(t, err)
+		run(t, d)
+
+		_, err = d.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.Error(t, err)
+		require.NotZero(t, d.Rejected())
+	})
+
+	t.Run("no verifier configured", func(t *testing.T) {
+		d, err := client.NewPriceDaemon(logger, cfg, clientmocks.NewOracleClient(t))
+		require.NoError(t, err)
+
+		_, _, err = d.VerifiedPrices(context.Background(), &types.QueryPricesRequest{})
+		require.Error(t, err)
+	})
+}
@@ -16,3 +16,19 @@ func WithBlockingDial() Option {
 		client.blockingDial = true
 	}
 }
+
+// WithVerifier configures a PriceDaemon to verify every response it fetches
+// against the enclave report in the response trailer, using verify and the
+// expected signerID. Responses that fail verification are discarded, so only
+// attested prices are ever served by the daemon.
+func WithVerifier(signerID []byte, verify VerifyFunc) Option {
+	return func(c OracleClient) {
+		daemon, ok := c.(*PriceDaemon)
+		if !ok {
+			return
+		}
+
+		daemon.signerID = signerID
+		daemon.verify = verify
+	}
+}
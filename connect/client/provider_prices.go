@@ -0,0 +1,46 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ProviderPrice is the price a single provider reported for a currency pair,
+// converted to the pair (inverted and normalized as the market map says) and
+// scaled to the ticker's decimals, as the sidecar feeds it into its median.
+type ProviderPrice struct {
+	// Provider is the name of the provider.
+	Provider string `json:"provider"`
+	// Price is the scaled integer price.
+	Price string `json:"price"`
+	// Timestamp is the time (unix nanoseconds) the sidecar received the price
+	// from the provider.
+	Timestamp int64 `json:"timestamp"`
+}
+
+// ProviderPrices are the per-provider prices of every currency pair, keyed by
+// the currency pair string (e.g. "BTC/USD"). The sidecar attests them next to
+// the aggregated prices when it runs with provider prices enabled, so that the
+// chain can recompute and audit the aggregation.
+type ProviderPrices map[string][]ProviderPrice
+
+// Marshal encodes the provider prices as JSON. Map keys are sorted, so the
+// encoding is deterministic.
+func (p ProviderPrices) Marshal() ([]byte, error) {
+	bz, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode provider prices: %w", err)
+	}
+
+	return bz, nil
+}
+
+// UnmarshalProviderPrices decodes provider prices encoded with Marshal.
+func UnmarshalProviderPrices(bz []byte) (ProviderPrices, error) {
+	var p ProviderPrices
+	if err := json.Unmarshal(bz, &p); err != nil {
+		return nil, fmt.Errorf("failed to decode provider prices: %w", err)
+	}
+
+	return p, nil
+}
@@ -0,0 +1,200 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// EnclaveReportTrailerKey is the gRPC trailer key under which the sidecar
+	// returns the base64 (raw, unpadded) encoded enclave report.
+	EnclaveReportTrailerKey = "x-enclave-report"
+	// EnclaveTimestampTrailerKey is the gRPC trailer key under which the sidecar
+	// returns the time (unix nanoseconds) it bound into the enclave report.
+	EnclaveTimestampTrailerKey = "x-enclave-timestamp"
+	// EnclaveConfigDigestTrailerKey is the gRPC trailer key under which the
+	// sidecar returns the hex encoded config digest (see ConfigDigest) it bound
+	// into the enclave report.
+	EnclaveConfigDigestTrailerKey = "x-enclave-config-digest"
+	// EnclaveMarketMapTrailerKey is the gRPC trailer key under which the
+	// sidecar returns the base64 (raw, unpadded) encoded market map (see
+	// EncodeMarketMap) whose digest it bound into the enclave report.
+	EnclaveMarketMapTrailerKey = "x-enclave-market-map"
+	// EnclaveProviderPricesTrailerKey is the gRPC trailer key under which the
+	// sidecar returns the base64 (raw, unpadded) encoded provider prices (see
+	// ProviderPrices) it bound into the enclave report. It is only set when the
+	// sidecar runs with provider prices enabled.
+	EnclaveProviderPricesTrailerKey = "x-enclave-provider-prices"
+	// EnclaveNonceMetadataKey is the gRPC request metadata key under which the
+	// client sends the base64 (raw, unpadded) encoded nonce the enclave report
+	// must be bound to.
+	EnclaveNonceMetadataKey = "x-enclave-nonce"
+
+	// NonceSize is the size of the nonces created by NewNonce, the only size
+	// the sidecar accepts.
+	NonceSize = 32
+)
+
+// VerifyFunc verifies that report is a valid enclave report over data, produced
+// by an enclave signed by signerID. Any additional policy (accepted TCB statuses,
+// product ID, security version, ...) is up to the implementation.
+type VerifyFunc func(report, data, signerID []byte) error
+
+// EnclaveReportFromTrailer extracts and decodes the enclave report from a gRPC
+// trailer returned by the sidecar.
+func EnclaveReportFromTrailer(trailer metadata.MD) ([]byte, error) {
+	values := trailer.Get(EnclaveReportTrailerKey)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no enclave report in trailer")
+	}
+
+	report, err := base64.RawStdEncoding.DecodeString(values[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode enclave report: %w", err)
+	}
+
+	return report, nil
+}
+
+// NewNonce returns a random nonce to bind an enclave report to a request.
+func NewNonce() ([]byte, error) {
+	nonce := make([]byte, NonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to create nonce: %w", err)
+	}
+
+	return nonce, nil
+}
+
+// ContextWithNonce returns a context that sends nonce to the sidecar in the
+// request metadata, so that the enclave report of the response is bound to it.
+func ContextWithNonce(ctx context.Context, nonce []byte) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, EnclaveNonceMetadataKey, base64.RawStdEncoding.EncodeToString(nonce))
+}
+
+// NonceFromIncomingContext returns the nonce sent by the client in the request
+// metadata, or nil if it sent none. A nonce must be NonceSize bytes long.
+func NonceFromIncomingContext(ctx context.Context) ([]byte, error) {
+	values := metadata.ValueFromIncomingContext(ctx, EnclaveNonceMetadataKey)
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	nonce, err := base64.RawStdEncoding.DecodeString(values[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode nonce: %w", err)
+	}
+
+	if len(nonce) != NonceSize {
+		return nil, fmt.Errorf("invalid nonce length: %d bytes, expected %d", len(nonce), NonceSize)
+	}
+
+	return nonce, nil
+}
+
+// BindReportData returns the data whose SHA-256 hash the sidecar puts in the
+// enclave report: the response, the request nonce, the big endian timestamp,
+// the config digest, the market map digest and the encoded provider prices
+// (empty unless enabled), each prefixed with its big endian uint32 length.
+// The prefixes keep the bytes of one field from being read as part of
+// another, e.g. the tail of the response as a nonce.
+func BindReportData(response, nonce []byte, timestamp int64, configDigest, marketMapDigest, providerPrices []byte) []byte {
+	fields := [][]byte{response, nonce, binary.BigEndian.AppendUint64(nil, uint64(timestamp)), configDigest, marketMapDigest, providerPrices}
+
+	var data []byte
+	for _, field := range fields {
+		data = binary.BigEndian.AppendUint32(data, uint32(len(field))) //nolint:gosec
+		data = append(data, field...)
+	}
+
+	return data
+}
+
+// Attestation is an enclave report together with the request nonce, the
+// timestamp, the sidecar config digest, the market map and the provider prices
+// it is bound to.
+type Attestation struct {
+	Report       []byte
+	Nonce        []byte
+	Timestamp    int64
+	ConfigDigest []byte
+	// MarketMap is the encoded market map the prices were aggregated with.
+	MarketMap []byte
+	// ProviderPrices are the encoded provider prices, nil unless the sidecar
+	// runs with provider prices enabled.
+	ProviderPrices []byte
+}
+
+// AttestationFromTrailer reads the enclave report and its timestamp from a gRPC
+// trailer returned by the sidecar for a request sent with nonce.
+func AttestationFromTrailer(trailer metadata.MD, nonce []byte) (*Attestation, error) {
+	report, err := EnclaveReportFromTrailer(trailer)
+	if err != nil {
+		return nil, err
+	}
+
+	values := trailer.Get(EnclaveTimestampTrailerKey)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no enclave report timestamp in trailer")
+	}
+
+	timestamp, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse enclave report timestamp: %w", err)
+	}
+
+	values = trailer.Get(EnclaveConfigDigestTrailerKey)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no config digest in trailer")
+	}
+
+	configDigest, err := hex.DecodeString(values[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode config digest: %w", err)
+	}
+	if len(configDigest) != ConfigDigestSize {
+		return nil, fmt.Errorf("invalid config digest length: %d bytes, expected %d", len(configDigest), ConfigDigestSize)
+	}
+
+	values = trailer.Get(EnclaveMarketMapTrailerKey)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no market map in trailer")
+	}
+
+	marketMap, err := base64.RawStdEncoding.DecodeString(values[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode market map: %w", err)
+	}
+
+	var providerPrices []byte
+	if values = trailer.Get(EnclaveProviderPricesTrailerKey); len(values) > 0 {
+		providerPrices, err = base64.RawStdEncoding.DecodeString(values[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode provider prices: %w", err)
+		}
+	}
+
+	return &Attestation{
+		Report:         report,
+		Nonce:          nonce,
+		Timestamp:      timestamp,
+		ConfigDigest:   configDigest,
+		MarketMap:      marketMap,
+		ProviderPrices: providerPrices,
+	}, nil
+}
+
+// Verify verifies that the attestation's report is bound to response, the
+// nonce, the timestamp, the config digest, the market map and the provider
+// prices.
+func (a *Attestation) Verify(verify VerifyFunc, response, signerID []byte) error {
+	data := BindReportData(response, a.Nonce, a.Timestamp, a.ConfigDigest, MarketMapDigest(a.MarketMap), a.ProviderPrices)
+	return verify(a.Report, data, signerID)
+}
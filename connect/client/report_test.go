@@ -0,0 +1,53 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/facundomedica/rollinky/connect/client"
+)
+
+func TestNonceFromIncomingContext(t *testing.T) {
+	incoming := func(nonce []byte) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			client.EnclaveNonceMetadataKey, base64.RawStdEncoding.EncodeToString(nonce),
+		))
+	}
+
+	nonce, err := client.NonceFromIncomingContext(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, nonce)
+
+	want := bytes.Repeat([]byte{0x01}, client.NonceSize)
+	nonce, err = client.NonceFromIncomingContext(incoming(want))
+	require.NoError(t, err)
+	require.Equal(t, want, nonce)
+
+	_, err = client.NonceFromIncomingContext(incoming(want[1:]))
+	require.ErrorContains(t, err, "invalid nonce length")
+
+	_, err = client.NonceFromIncomingContext(incoming(append(want, 0x01)))
+	require.ErrorContains(t, err, "invalid nonce length")
+}
+
+func TestBindReportData(t *testing.T) {
+	nonce := bytes.Repeat([]byte{0x01}, client.NonceSize)
+	data := client.BindReportData([]byte("prices"), nonce, 1, []byte("digest"), []byte("markets"), []byte("providers"))
+
+	want := []byte("\x00\x00\x00\x06prices" +
+		"\x00\x00\x00\x20" + string(nonce) +
+		"\x00\x00\x00\x08\x00\x00\x00\x00\x00\x00\x00\x01" +
+		"\x00\x00\x00\x06digest" +
+		"\x00\x00\x00\x07markets" +
+		"\x00\x00\x00\x09providers")
+	require.Equal(t, want, data)
+
+	// moving a byte from the response into the nonce changes the data.
+	shifted := client.BindReportData([]byte("price"), append([]byte("s"), nonce[1:]...), 1, []byte("digest"), []byte("markets"), []byte("providers"))
+	require.NotEqual(t, data, shifted)
+}
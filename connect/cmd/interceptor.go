@@ -7,19 +7,33 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/edgelesssys/ego/enclave"
 	protov1 "github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/facundomedica/rollinky/connect/client"
 )
 
-func UnaryInterceptor(logger *zap.Logger) func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+// UnaryInterceptor attaches an enclave report to every response, bound to the
+// request nonce, the current time, the sidecar's config digest and the market
+// map and, if the sidecar attests them, the provider prices of the aggregation
+// the response was served from.
+func UnaryInterceptor(logger *zap.Logger, configDigest []byte, attested attestedFunc) func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	encodedDigest := hex.EncodeToString(configDigest)
+
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		resp, err := handler(ctx, req)
+		resp, aggregated, err := attested(func() (interface{}, error) {
+			return handler(ctx, req)
+		})
 		if err != nil {
 			return resp, err
 		}
@@ -30,8 +44,21 @@ func UnaryInterceptor(logger *zap.Logger) func(ctx context.Context, req interfac
 			return nil, err
 		}
 
+		// bind the report to the request's nonce and the current time, so that
+		// it can't be replayed as the answer to another request. A report
+		// without a nonce could be replayed, requests without one (e.g. from
+		// the Connect oracle client) get no report.
+		nonce, err := client.NonceFromIncomingContext(ctx)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if nonce == nil {
+			return resp, nil
+		}
+
 		since := time.Now()
-		hash := sha256.Sum256(bz)
+		timestamp := since.UnixNano()
+		hash := sha256.Sum256(client.BindReportData(bz, nonce, timestamp, configDigest, client.MarketMapDigest(aggregated.marketMap), aggregated.providerPrices))
 		// TODO: if this endpoint is queried a lot (if it's being used for something
 		// else other than creating blocks), we should cache the report.
 		report, err := enclave.GetRemoteReport(hash[:])
@@ -39,8 +66,14 @@ func UnaryInterceptor(logger *zap.Logger) func(ctx context.Context, req interfac
 			fmt.Println(err)
 		}
 		trailer := metadata.Pairs(
-			"X-Enclave-Report", base64.RawStdEncoding.EncodeToString(report),
+			client.EnclaveReportTrailerKey, base64.RawStdEncoding.EncodeToString(report),
+			client.EnclaveTimestampTrailerKey, strconv.FormatInt(timestamp, 10),
+			client.EnclaveConfigDigestTrailerKey, encodedDigest,
+			client.EnclaveMarketMapTrailerKey, base64.RawStdEncoding.EncodeToString(aggregated.marketMap),
 		)
+		if aggregated.providerPrices != nil {
+			trailer.Append(client.EnclaveProviderPricesTrailerKey, base64.RawStdEncoding.EncodeToString(aggregated.providerPrices))
+		}
 		grpc.SetTrailer(ctx, trailer)
 		logger.Debug("created report", zap.Duration("time", time.Since(since)))
 		return resp, err
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
@@ -34,6 +35,8 @@ import (
 	promserver "github.com/skip-mev/connect/v2/service/servers/prometheus"
 	"github.com/skip-mev/connect/v2/service/validation"
 	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+
+	"github.com/facundomedica/rollinky/connect/client"
 )
 
 var (
@@ -84,6 +87,8 @@ var (
 	disableRotatingLogs bool
 	mode                string
 	validationPeriod    time.Duration
+
+	attestProviderPrices bool
 )
 
 const (
@@ -126,6 +131,13 @@ func init() {
 		"",
 		"Path where the current market config will be written. Overwrites any pre-existing file. Requires an http-node-url/marketmap provider in your oracle.json config.",
 	)
+	rootCmd.Flags().BoolVarP(
+		&attestProviderPrices,
+		"attest-provider-prices",
+		"",
+		false,
+		"Attest the price every provider contributed to each market next to the aggregated prices.",
+	)
 	rootCmd.Flags().BoolVarP(
 		&runPprof,
 		"run-pprof",
@@ -361,6 +373,11 @@ func runOracle() error {
 		return fmt.Errorf("failed to create data aggregator: %w", err)
 	}
 
+	// record the market map of every aggregation and, in provider prices mode,
+	// every provider's price, so that they can be attested next to the
+	// aggregated prices.
+	recorder := newAggregationRecorder(aggregator, attestProviderPrices)
+
 	// Define the oracle options. These determine how the oracle is created & executed.
 	oracleOpts := []oracle.Option{
 		oracle.WithLogger(logger),
@@ -377,7 +394,7 @@ func runOracle() error {
 	// Create the oracle and start the oracle.
 	orc, err := oracle.New(
 		cfg,
-		aggregator,
+		recorder,
 		oracleOpts...,
 	)
 	if err != nil {
@@ -451,7 +468,13 @@ func runOracle() error {
 	}
 
 	// start server (blocks).
-	if err := srv.StartServer(ctx, cfg.Host, cfg.Port, grpc.UnaryInterceptor(UnaryInterceptor(logger))); err != nil {
+	configDigest, err := client.ConfigDigest(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to compute config digest: %w", err)
+	}
+	logger.Info("attesting config", zap.String("config_digest", hex.EncodeToString(configDigest)))
+
+	if err := srv.StartServer(ctx, cfg.Host, cfg.Port, grpc.UnaryInterceptor(UnaryInterceptor(logger, configDigest, recorder.ServeAttested))); err != nil {
 		logger.Error("stopping server", zap.Error(err))
 	}
 	return nil
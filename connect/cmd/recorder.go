@@ -0,0 +1,138 @@
+package main
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/skip-mev/connect/v2/oracle"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	connectmath "github.com/skip-mev/connect/v2/pkg/math"
+	oraclemath "github.com/skip-mev/connect/v2/pkg/math/oracle"
+
+	"github.com/facundomedica/rollinky/connect/client"
+)
+
+var _ oracle.PriceAggregator = (*aggregationRecorder)(nil)
+
+// aggregation is what the sidecar attests about the aggregation the served
+// prices come from.
+type aggregation struct {
+	// marketMap is the encoded market map the prices were aggregated with.
+	marketMap []byte
+	// providerPrices are the encoded provider prices, nil unless the sidecar
+	// attests them.
+	providerPrices []byte
+}
+
+// attestedFunc calls serve, which serves the aggregated prices, and returns
+// its response together with the aggregation they come from.
+type attestedFunc func(serve func() (interface{}, error)) (interface{}, aggregation, error)
+
+// aggregationRecorder wraps the index price aggregator and, every time prices
+// are aggregated, records the market map they were aggregated with and, if
+// enabled, the converted price each provider contributed to every enabled
+// market, so that they can be attested next to the index prices.
+type aggregationRecorder struct {
+	*oraclemath.IndexPriceAggregator
+
+	// providerPrices enables recording the provider prices.
+	providerPrices bool
+
+	// receivedAt is when the prices of each provider were set in the current
+	// round. It is only touched by the oracle's fetch loop.
+	receivedAt map[string]time.Time
+
+	// mtx is held for writing while prices are aggregated and for reading
+	// while they are served, so that the served prices and latest always
+	// come from the same aggregation.
+	mtx    sync.RWMutex
+	latest aggregation
+	// err is set if the latest aggregation couldn't be recorded.
+	err error
+}
+
+func newAggregationRecorder(aggregator *oraclemath.IndexPriceAggregator, providerPrices bool) *aggregationRecorder {
+	return &aggregationRecorder{
+		IndexPriceAggregator: aggregator,
+		providerPrices:       providerPrices,
+		receivedAt:           make(map[string]time.Time),
+	}
+}
+
+// SetProviderPrices records when the provider's prices were received and
+// passes them on to the aggregator.
+func (r *aggregationRecorder) SetProviderPrices(provider string, prices types.Prices) {
+	r.receivedAt[provider] = time.Now()
+	r.IndexPriceAggregator.SetProviderPrices(provider, prices)
+}
+
+// Reset resets the aggregator and the recorded receive times.
+func (r *aggregationRecorder) Reset() {
+	r.receivedAt = make(map[string]time.Time)
+	r.IndexPriceAggregator.Reset()
+}
+
+// AggregatePrices aggregates the prices and records the market map and the
+// provider prices that went into every enabled market. Like the aggregator,
+// it must only be called from the oracle's fetch loop, which is also the only
+// writer of provider prices.
+func (r *aggregationRecorder) AggregatePrices() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.IndexPriceAggregator.AggregatePrices()
+
+	r.latest = aggregation{}
+	r.latest.marketMap, r.err = client.EncodeMarketMap(*r.GetMarketMap())
+	if r.err != nil || !r.providerPrices {
+		return
+	}
+
+	providerPrices := make(client.ProviderPrices)
+	for _, market := range r.GetMarketMap().Markets {
+		if !market.Ticker.Enabled {
+			continue
+		}
+
+		var prices []client.ProviderPrice
+		for _, cfg := range market.ProviderConfigs {
+			price, err := r.CalculateAdjustedPrice(cfg)
+			if err != nil {
+				continue
+			}
+
+			// truncated to an integer, like the aggregated prices.
+			scaled, _ := connectmath.ScaleBigFloat(new(big.Float).Copy(price), market.Ticker.Decimals).Int(nil)
+			prices = append(prices, client.ProviderPrice{
+				Provider:  cfg.Name,
+				Price:     scaled.String(),
+				Timestamp: r.receivedAt[cfg.Name].UnixNano(),
+			})
+		}
+
+		if len(prices) > 0 {
+			providerPrices[market.Ticker.String()] = prices
+		}
+	}
+
+	r.latest.providerPrices, r.err = providerPrices.Marshal()
+}
+
+// ServeAttested is an attestedFunc: no aggregation runs while serve does, so
+// the market map and the provider prices are the ones its prices were
+// aggregated from.
+func (r *aggregationRecorder) ServeAttested(serve func() (interface{}, error)) (interface{}, aggregation, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	resp, err := serve()
+	if err != nil {
+		return resp, aggregation{}, err
+	}
+	if r.err != nil {
+		return nil, aggregation{}, r.err
+	}
+
+	return resp, r.latest, nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/skip-mev/connect/v2/oracle/metrics"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	oraclemath "github.com/skip-mev/connect/v2/pkg/math/oracle"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/facundomedica/rollinky/connect/client"
+)
+
+func TestAggregationRecorder(t *testing.T) {
+	ticker := mmtypes.Ticker{
+		CurrencyPair:     connecttypes.NewCurrencyPair("BTC", "USD"),
+		Decimals:         2,
+		MinProviderCount: 1,
+		Enabled:          true,
+	}
+	marketMap := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			ticker.String(): {
+				Ticker:          ticker,
+				ProviderConfigs: []mmtypes.ProviderConfig{{Name: "coinbase_api", OffChainTicker: "BTC-USD"}},
+			},
+		},
+	}
+	encodedMarketMap, err := client.EncodeMarketMap(marketMap)
+	require.NoError(t, err)
+
+	aggregator, err := oraclemath.NewIndexPriceAggregator(zap.NewNop(), marketMap, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	recorder := newAggregationRecorder(aggregator, true)
+	aggregate := func(price float64) {
+		recorder.Reset()
+		recorder.SetProviderPrices("coinbase_api", types.Prices{"BTC-USD": big.NewFloat(price)})
+		recorder.AggregatePrices()
+	}
+
+	// serve returns the served price, the provider price must be the one it
+	// was aggregated from.
+	check := func(serve func() (interface{}, error), want string) {
+		resp, aggregated, err := recorder.ServeAttested(serve)
+		require.NoError(t, err)
+		require.Equal(t, encodedMarketMap, aggregated.marketMap)
+
+		providerPrices, err := client.UnmarshalProviderPrices(aggregated.providerPrices)
+		require.NoError(t, err)
+		require.Len(t, providerPrices[ticker.String()], 1)
+		require.Equal(t, "coinbase_api", providerPrices[ticker.String()][0].Provider)
+		require.Equal(t, want, resp)
+		require.Equal(t, want, providerPrices[ticker.String()][0].Price)
+	}
+	served := func() (interface{}, error) {
+		return recorder.GetPrices()[ticker.String()].String(), nil
+	}
+
+	aggregate(1)
+	check(served, "100")
+
+	// an aggregation started while prices are served waits for them.
+	done := make(chan struct{})
+	check(func() (interface{}, error) {
+		go func() {
+			aggregate(2)
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			t.Error("prices were aggregated while served")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		return served()
+	}, "100")
+
+	<-done
+	check(served, "200")
+
+	// without provider prices, only the market map is recorded.
+	recorder = newAggregationRecorder(aggregator, false)
+	aggregate(3)
+	resp, aggregated, err := recorder.ServeAttested(served)
+	require.NoError(t, err)
+	require.Equal(t, "300", resp)
+	require.Equal(t, encodedMarketMap, aggregated.marketMap)
+	require.Nil(t, aggregated.providerPrices)
+}
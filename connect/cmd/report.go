@@ -0,0 +1,56 @@
+//go:build !no_tee
+// +build !no_tee
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/edgelesssys/ego/attestation"
+	"github.com/edgelesssys/ego/attestation/tcbstatus"
+	"github.com/edgelesssys/ego/enclave"
+)
+
+// createAndVerifyReport creates a remote report over data and verifies it
+// from within the enclave. The report is returned whenever it could be parsed,
+// even if verification failed, so the caller can show what went wrong.
+func createAndVerifyReport(data []byte) (*selfTestReport, error) {
+	reportBytes, err := enclave.GetRemoteReport(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create report: %w", err)
+	}
+
+	report, err := enclave.VerifyRemoteReport(reportBytes)
+	if err != nil && !errors.Is(err, attestation.ErrTCBLevelInvalid) {
+		return nil, fmt.Errorf("failed to verify report: %w", err)
+	}
+
+	summary := &selfTestReport{
+		SignerID:        hex.EncodeToString(report.SignerID),
+		UniqueID:        hex.EncodeToString(report.UniqueID),
+		SecurityVersion: report.SecurityVersion,
+		Debug:           report.Debug,
+		TCBStatus:       report.TCBStatus.String(),
+		TCBAdvisories:   report.TCBAdvisories,
+	}
+	if len(report.ProductID) >= 2 {
+		summary.ProductID = binary.LittleEndian.Uint16(report.ProductID)
+	}
+	if report.TCBStatus != tcbstatus.UpToDate {
+		summary.TCBExplanation = tcbstatus.Explain(report.TCBStatus)
+	}
+
+	if len(data) > len(report.Data) || !bytes.Equal(report.Data[:len(data)], data) {
+		return summary, errors.New("report data does not match the nonce")
+	}
+
+	if err != nil {
+		return summary, fmt.Errorf("TCB level is not up to date: %s", report.TCBStatus)
+	}
+
+	return summary, nil
+}
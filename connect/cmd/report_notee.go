@@ -0,0 +1,11 @@
+//go:build no_tee
+// +build no_tee
+
+package main
+
+import "errors"
+
+// createAndVerifyReport always fails, as reports can only be created in a TEE.
+func createAndVerifyReport(_ []byte) (*selfTestReport, error) {
+	return nil, errors.New("not running in a TEE, build without the no_tee tag to create reports")
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	// defaultQCNLConfigPath is where the Intel DCAP quote provider library reads
+	// its configuration from.
+	defaultQCNLConfigPath = "/etc/sgx_default_qcnl.conf"
+
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// quoteProviderLibPaths are the usual install locations of the DCAP quote
+// provider library (libsgx-dcap-default-qpl).
+var quoteProviderLibPaths = []string{
+	"/usr/lib/x86_64-linux-gnu/libdcap_quoteprov.so.1",
+	"/usr/lib/x86_64-linux-gnu/libdcap_quoteprov.so",
+	"/usr/lib/libdcap_quoteprov.so.1",
+	"/usr/lib/libdcap_quoteprov.so",
+	"/usr/lib64/libdcap_quoteprov.so.1",
+}
+
+var (
+	selfTestOutput         string
+	selfTestQCNLConfigPath string
+
+	selfTestCmd = &cobra.Command{
+		Use:   "attest-selftest",
+		Short: "Create an enclave report over a test nonce and verify it locally.",
+		Long: `Create an enclave report over a random test nonce, verify it locally and print
+the enclave identity and TCB status. It also checks the quote provider
+configuration, so that SGX setup issues (e.g. OE_QUOTE_PROVIDER_LOAD_ERROR) show
+up here instead of when the sequencer fails to verify prices.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if selfTestOutput != outputText && selfTestOutput != outputJSON {
+				return fmt.Errorf("invalid output format %q, expected %q or %q", selfTestOutput, outputText, outputJSON)
+			}
+
+			// the command ran, usage won't help past this point.
+			cmd.SilenceUsage = true
+
+			result := runSelfTest(selfTestQCNLConfigPath)
+			if err := result.write(cmd.OutOrStdout(), selfTestOutput); err != nil {
+				return err
+			}
+
+			if !result.OK {
+				return fmt.Errorf("attestation self-test failed")
+			}
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	selfTestCmd.Flags().StringVarP(
+		&selfTestOutput,
+		"output",
+		"o",
+		outputText,
+		"Output format (text, json).",
+	)
+	selfTestCmd.Flags().StringVar(
+		&selfTestQCNLConfigPath,
+		"qcnl-config",
+		defaultQCNLConfigPath,
+		"Path to the quote provider (QCNL) configuration file.",
+	)
+
+	rootCmd.AddCommand(selfTestCmd)
+}
+
+// selfTestResult is the outcome of an attestation self-test.
+type selfTestResult struct {
+	OK bool `json:"ok"`
+
+	Nonce  string               `json:"nonce"`
+	Report *selfTestReport      `json:"report,omitempty"`
+	Checks []selfTestCheck      `json:"checks"`
+	Quote  quoteProviderSummary `json:"quote_provider"`
+}
+
+// selfTestReport holds the identity of the enclave as seen in the verified report.
+type selfTestReport struct {
+	SignerID        string   `json:"signer_id"`
+	UniqueID        string   `json:"unique_id"`
+	ProductID       uint16   `json:"product_id"`
+	SecurityVersion uint     `json:"security_version"`
+	Debug           bool     `json:"debug"`
+	TCBStatus       string   `json:"tcb_status"`
+	TCBExplanation  string   `json:"tcb_explanation,omitempty"`
+	TCBAdvisories   []string `json:"tcb_advisories,omitempty"`
+}
+
+// selfTestCheck is a single pass/fail step of the self-test.
+type selfTestCheck struct {
+	Name   string `json:"name"`
+	OK     bool   `json:"ok"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// quoteProviderSummary describes the quote provider setup found on the host.
+type quoteProviderSummary struct {
+	ConfigPath string `json:"config_path"`
+	PCCSURL    string `json:"pccs_url,omitempty"`
+	Library    string `json:"library,omitempty"`
+}
+
+// check records the outcome of a step, failing the self-test if err is not nil.
+func (r *selfTestResult) check(name string, err error) {
+	c := selfTestCheck{Name: name, OK: err == nil}
+	if err != nil {
+		c.Detail = err.Error()
+		r.OK = false
+	}
+	r.Checks = append(r.Checks, c)
+}
+
+// runSelfTest checks the quote provider setup, then creates a report over a
+// random nonce and verifies it.
+func runSelfTest(qcnlConfigPath string) *selfTestResult {
+	result := &selfTestResult{
+		OK:    true,
+		Quote: quoteProviderSummary{ConfigPath: qcnlConfigPath},
+	}
+
+	lib, err := findQuoteProviderLib()
+	result.Quote.Library = lib
+	result.check("quote provider library", err)
+
+	pccsURL, err := readPCCSURL(qcnlConfigPath)
+	result.Quote.PCCSURL = pccsURL
+	result.check("quote provider configuration", err)
+
+	nonce := make([]byte, 32)
+	if _, err := rand.Read(nonce); err != nil {
+		result.check("generate nonce", err)
+		return result
+	}
+	result.Nonce = hex.EncodeToString(nonce)
+
+	hash := sha256.Sum256(nonce)
+	report, err := createAndVerifyReport(hash[:])
+	result.Report = report
+	result.check("create and verify report", err)
+
+	return result
+}
+
+func findQuoteProviderLib() (string, error) {
+	for _, path := range quoteProviderLibPaths {
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+
+	return "", fmt.Errorf("libdcap_quoteprov.so not found, install libsgx-dcap-default-qpl")
+}
+
+// readPCCSURL reads the pccs_url from the QCNL configuration file.
+func readPCCSURL(path string) (string, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	// the default configuration file is JSON with comments, strip them before parsing.
+	var lines []string
+	for _, line := range strings.Split(string(bz), "\n") {
+		if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	var cfg struct {
+		PCCSURL string `json:"pccs_url"`
+	}
+	if err := json.Unmarshal([]byte(strings.Join(lines, "\n")), &cfg); err != nil {
+		return "", fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	if cfg.PCCSURL == "" {
+		return "", fmt.Errorf("no pccs_url set in %s", path)
+	}
+
+	return cfg.PCCSURL, nil
+}
+
+func (r *selfTestResult) write(w io.Writer, format string) error {
+	if format == outputJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r)
+	}
+
+	fmt.Fprintf(w, "nonce:            %s\n", r.Nonce)
+	if r.Report != nil {
+		fmt.Fprintf(w, "signer id:        %s\n", r.Report.SignerID)
+		fmt.Fprintf(w, "unique id:        %s\n", r.Report.UniqueID)
+		fmt.Fprintf(w, "product id:       %d\n", r.Report.ProductID)
+		fmt.Fprintf(w, "security version: %d\n", r.Report.SecurityVersion)
+		fmt.Fprintf(w, "debug:            %t\n", r.Report.Debug)
+		fmt.Fprintf(w, "tcb status:       %s\n", r.Report.TCBStatus)
+		if r.Report.TCBExplanation != "" {
+			fmt.Fprintf(w, "                  %s\n", r.Report.TCBExplanation)
+		}
+		if len(r.Report.TCBAdvisories) > 0 {
+			fmt.Fprintf(w, "tcb advisories:   %s\n", strings.Join(r.Report.TCBAdvisories, ", "))
+		}
+	}
+	fmt.Fprintf(w, "qcnl config:      %s\n", r.Quote.ConfigPath)
+	fmt.Fprintf(w, "pccs url:         %s\n", r.Quote.PCCSURL)
+	fmt.Fprintf(w, "quote provider:   %s\n", r.Quote.Library)
+
+	fmt.Fprintln(w)
+	for _, c := range r.Checks {
+		status := "OK"
+		if !c.OK {
+			status = "FAIL"
+		}
+		if c.Detail != "" {
+			fmt.Fprintf(w, "[%s] %s: %s\n", status, c.Name, c.Detail)
+		} else {
+			fmt.Fprintf(w, "[%s] %s\n", status, c.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadPCCSURL(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config string
+		url    string
+		err    string
+	}{
+		{
+			name: "default config with comments",
+			config: `{
+  // PCCS server address
+  "pccs_url": "https://localhost:8081/sgx/certification/v4/",
+  # To accept insecure HTTPS certificate, set this option to false
+  "use_secure_cert": false
+}`,
+			url: "https://localhost:8081/sgx/certification/v4/",
+		},
+		{
+			name:   "no pccs_url",
+			config: `{"use_secure_cert": false}`,
+			err:    "no pccs_url set",
+		},
+		{
+			name:   "not JSON",
+			config: `pccs_url = "https://localhost:8081"`,
+			err:    "failed to parse",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "sgx_default_qcnl.conf")
+			require.NoError(t, os.WriteFile(path, []byte(tc.config), 0o600))
+
+			url, err := readPCCSURL(path)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.url, url)
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := readPCCSURL(filepath.Join(t.TempDir(), "missing.conf"))
+		require.ErrorContains(t, err, "failed to read")
+	})
+}
+
+func TestSelfTestResultWrite(t *testing.T) {
+	result := &selfTestResult{
+		OK:    true,
+		Nonce: "00ff",
+		Report: &selfTestReport{
+			SignerID:        "ab",
+			UniqueID:        "cd",
+			ProductID:       1,
+			SecurityVersion: 2,
+			TCBStatus:       "SWHardeningNeeded",
+			TCBExplanation:  "software hardening is needed",
+			TCBAdvisories:   []string{"INTEL-SA-00334", "INTEL-SA-00615"},
+		},
+		Quote: quoteProviderSummary{
+			ConfigPath: defaultQCNLConfigPath,
+			PCCSURL:    "https://localhost:8081/sgx/certification/v4/",
+			Library:    quoteProviderLibPaths[0],
+		},
+	}
+	result.check("quote provider library", nil)
+	result.check("create and verify report", errors.New("TCB level is not up to date: SWHardeningNeeded"))
+
+	t.Run("text", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, result.write(&buf, outputText))
+		require.Equal(t, `nonce:            00ff
+signer id:        ab
+unique id:        cd
+product id:       1
+security version: 2
+debug:            false
+tcb status:       SWHardeningNeeded
+                  software hardening is needed
+tcb advisories:   INTEL-SA-00334, INTEL-SA-00615
+qcnl config:      /etc/sgx_default_qcnl.conf
+pccs url:         https://localhost:8081/sgx/certification/v4/
+quote provider:   /usr/lib/x86_64-linux-gnu/libdcap_quoteprov.so.1
+
+[OK] quote provider library
+[FAIL] create and verify report: TCB level is not up to date: SWHardeningNeeded
+`, buf.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, result.write(&buf, outputJSON))
+
+		var decoded selfTestResult
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		require.Equal(t, *result, decoded)
+		require.False(t, decoded.OK)
+		require.Contains(t, buf.String(), `"signer_id": "ab"`)
+		require.Contains(t, buf.String(), `"pccs_url": "https://localhost:8081/sgx/certification/v4/"`)
+	})
+
+	t.Run("json without report", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, (&selfTestResult{Nonce: "00ff"}).write(&buf, outputJSON))
+		require.NotContains(t, buf.String(), `"report"`)
+	})
+}
@@ -5,12 +5,14 @@ go 1.23.3
 require (
 	cosmossdk.io/log v1.5.0
 	github.com/BurntSushi/toml v1.4.1-0.20240526193622-a339e1f7089c
+	github.com/cosmos/cosmos-sdk v0.50.11
 	github.com/edgelesssys/ego v1.7.0
-	github.com/facundomedica/connect-client v0.0.0-20250203150411-ee3d53742b42
+	github.com/facundomedica/rollinky/connect v0.0.0-00010101000000-000000000000
 	github.com/prometheus/client_golang v1.20.5
 	github.com/rollkit/centralized-sequencer v0.4.0
 	github.com/rollkit/go-sequencing v0.4.1
 	github.com/skip-mev/connect/v2 v2.3.0
+	google.golang.org/grpc v1.70.0
 )
 
 require (
@@ -47,7 +49,6 @@ require (
 	github.com/cosmos/btcutil v1.0.5 // indirect
 	github.com/cosmos/cosmos-db v1.1.0 // indirect
 	github.com/cosmos/cosmos-proto v1.0.0-beta.5 // indirect
-	github.com/cosmos/cosmos-sdk v0.50.11 // indirect
 	github.com/cosmos/go-bip39 v1.0.0 // indirect
 	github.com/cosmos/gogogateway v1.2.0 // indirect
 	github.com/cosmos/gogoproto v1.7.0 // indirect
@@ -180,7 +181,6 @@ require (
 	google.golang.org/genproto v0.0.0-20240722135656-d784300faade // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20241202173237-19429a94021a // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20241202173237-19429a94021a // indirect
-	google.golang.org/grpc v1.70.0 // indirect
 	google.golang.org/protobuf v1.35.2 // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
@@ -192,3 +192,5 @@ require (
 )
 
 replace github.com/rollkit/centralized-sequencer => github.com/facundomedica/centralized-sequencer v0.0.0-20250205114939-e32958054e56
+
+replace github.com/facundomedica/rollinky/connect => ../connect
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/facundomedica/rollinky/sequencer/utils"
+)
+
+// HeightSource returns the chain ID and the latest height of the rollup.
+type HeightSource interface {
+	LatestBlock(ctx context.Context) (chainID string, height uint64, err error)
+}
+
+// chainHeightSource queries the rollup node's CometBFT service over gRPC.
+type chainHeightSource struct {
+	client cmtservice.ServiceClient
+}
+
+// NewChainHeightSource returns a HeightSource backed by the rollup node
+// listening on addr.
+func NewChainHeightSource(addr string) (HeightSource, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial rollup gRPC at %s: %w", addr, err)
+	}
+
+	return &chainHeightSource{client: cmtservice.NewServiceClient(conn)}, nil
+}
+
+func (s *chainHeightSource) LatestBlock(ctx context.Context) (string, uint64, error) {
+	resp, err := s.client.GetLatestBlock(ctx, &cmtservice.GetLatestBlockRequest{})
+	if err != nil {
+		return "", 0, err
+	}
+
+	if resp.SdkBlock == nil || resp.SdkBlock.Header.Height < 0 || resp.SdkBlock.Header.ChainID == "" {
+		return "", 0, fmt.Errorf("rollup returned an invalid latest block")
+	}
+
+	return resp.SdkBlock.Header.ChainID, uint64(resp.SdkBlock.Header.Height), nil
+}
+
+// LoadSequencerKey reads the sequencer's ed25519 key from a file holding its
+// hex encoded 32 byte seed.
+func LoadSequencerKey(path string) (ed25519.PrivateKey, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sequencer key: %w", err)
+	}
+
+	seed, err := hex.DecodeString(strings.TrimSpace(string(bz)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode sequencer key: %w", err)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid sequencer key: expected a %d byte seed, got %d bytes", ed25519.SeedSize, len(seed))
+	}
+
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// ErrHeightSigned is returned by EnvelopeSigner.Sign if an envelope was
+// already signed for the height of the next block.
+var ErrHeightSigned = errors.New("an envelope was already signed for the next rollup height")
+
+// EnvelopeSigner signs oracle envelopes with the sequencer's key, for the
+// chain ID of the rollup and the height of the block they will be included
+// in, so the rollup can tell them apart from envelopes it didn't build.
+type EnvelopeSigner struct {
+	key     ed25519.PrivateKey
+	heights HeightSource
+
+	mu sync.Mutex
+	// lastHeight is the height of the last signed envelope.
+	lastHeight uint64
+}
+
+// NewEnvelopeSigner returns an EnvelopeSigner signing with key, which reads
+// the rollup height from heights.
+func NewEnvelopeSigner(key ed25519.PrivateKey, heights HeightSource) *EnvelopeSigner {
+	return &EnvelopeSigner{key: key, heights: heights}
+}
+
+// PublicKey returns the hex encoded public key the rollup must record as its
+// sequencer key.
+func (s *EnvelopeSigner) PublicKey() string {
+	return hex.EncodeToString(s.key.Public().(ed25519.PublicKey))
+}
+
+// Sign signs the envelope for the height of the next block. It returns
+// ErrHeightSigned, leaving the envelope unsigned, if an envelope was already
+// signed for that height.
+func (s *EnvelopeSigner) Sign(ctx context.Context, envelope *utils.Envelope) error {
+	chainID, latest, err := s.heights.LatestBlock(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get latest rollup height: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// the rollup only accepts heights above the last one it accepted, and not
+	// above the block's own height, so the batch can't claim more than the
+	// next one. Until the node executes the block of the last signed batch,
+	// the next batch goes without an envelope rather than claiming a height
+	// its block may not reach.
+	height := latest + 1
+	if height <= s.lastHeight {
+		return fmt.Errorf("%w: height %d", ErrHeightSigned, height)
+	}
+
+	envelope.Sign(s.key, chainID, height)
+	s.lastHeight = height
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/facundomedica/rollinky/sequencer/utils"
+)
+
+type fakeHeightSource struct {
+	chainID string
+	height  uint64
+	err     error
+}
+
+func (f *fakeHeightSource) LatestBlock(context.Context) (string, uint64, error) {
+	return f.chainID, f.height, f.err
+}
+
+func TestEnvelopeSigner(t *testing.T) {
+	pubKey, key, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	heights := &fakeHeightSource{chainID: "rollinky", height: 10}
+	signer := NewEnvelopeSigner(key, heights)
+
+	if signer.PublicKey() != hex.EncodeToString(pubKey) {
+		t.Errorf("public key mismatch: got %s, want %x", signer.PublicKey(), pubKey)
+	}
+
+	sign := func() utils.Envelope {
+		envelope := utils.Envelope{Prices: []byte("prices"), Report: []byte("report")}
+		if err := signer.Sign(context.Background(), &envelope); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !envelope.VerifySignature(pubKey, "rollinky") {
+			t.Fatal("expected signature to verify")
+		}
+		return envelope
+	}
+
+	if got := sign().Height; got != 11 {
+		t.Errorf("expected height 11, got %d", got)
+	}
+
+	// the node hasn't executed the previous batch yet, the next one isn't
+	// signed rather than claiming a height past the next block.
+	envelope := utils.Envelope{Prices: []byte("prices"), Report: []byte("report")}
+	if err := signer.Sign(context.Background(), &envelope); !errors.Is(err, ErrHeightSigned) {
+		t.Errorf("expected ErrHeightSigned, got %v", err)
+	}
+	if envelope.Signature != nil {
+		t.Error("expected envelope to be left unsigned")
+	}
+
+	heights.height = 11
+	if got := sign().Height; got != 12 {
+		t.Errorf("expected height 12, got %d", got)
+	}
+
+	// the rollup moved on without the sequencer's batches.
+	heights.height = 20
+	if got := sign().Height; got != 21 {
+		t.Errorf("expected height 21, got %d", got)
+	}
+
+	// without the rollup height the envelope isn't signed.
+	heights.err = errors.New("unavailable")
+	envelope = utils.Envelope{Prices: []byte("prices"), Report: []byte("report")}
+	if err := signer.Sign(context.Background(), &envelope); err == nil {
+		t.Error("expected error, got nil")
+	}
+	if envelope.Signature != nil {
+		t.Error("expected envelope to be left unsigned")
+	}
+}
+
+func TestLoadSequencerKey(t *testing.T) {
+	dir := t.TempDir()
+	seed := make([]byte, ed25519.SeedSize)
+	seed[0] = 1
+
+	path := filepath.Join(dir, "key")
+	if err := os.WriteFile(path, []byte(hex.EncodeToString(seed)+"\n"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	key, err := LoadSequencerKey(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !key.Equal(ed25519.NewKeyFromSeed(seed)) {
+		t.Error("key mismatch")
+	}
+
+	short := filepath.Join(dir, "short")
+	if err := os.WriteFile(short, []byte("0102"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := LoadSequencerKey(short); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rollkit/go-sequencing"
+
+	"github.com/facundomedica/rollinky/sequencer/utils"
+)
+
+// ErrEnvelopeTx is returned when a submitted transaction looks like an oracle
+// envelope. Only the sequencer itself may put one in a batch.
+var ErrEnvelopeTx = errors.New("transaction looks like an oracle envelope")
+
+var envelopeTxsRejectedCounter = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "rollinky",
+	Subsystem: "sequencer",
+	Name:      "envelope_txs_rejected_total",
+	Help:      "Number of submitted transactions rejected because they looked like an oracle envelope.",
+})
+
+// TxFilter wraps the sequencer's transaction intake and rejects transactions
+// shaped like an oracle envelope, so users can't get an old attested payload
+// applied as a block's prices.
+type TxFilter struct {
+	sequencing.SequencerInput
+}
+
+// NewTxFilter returns a TxFilter that forwards accepted transactions to input.
+func NewTxFilter(input sequencing.SequencerInput) *TxFilter {
+	return &TxFilter{SequencerInput: input}
+}
+
+// SubmitRollupTransaction implements sequencing.SequencerInput.
+func (f *TxFilter) SubmitRollupTransaction(ctx context.Context, req sequencing.SubmitRollupTransactionRequest) (*sequencing.SubmitRollupTransactionResponse, error) {
+	if utils.IsEnvelopeShaped(req.Tx) {
+		envelopeTxsRejectedCounter.Inc()
+		log.Printf("Rejecting submitted transaction of %d bytes: %v\n", len(req.Tx), ErrEnvelopeTx)
+		return nil, ErrEnvelopeTx
+	}
+
+	return f.SequencerInput.SubmitRollupTransaction(ctx, req)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rollkit/go-sequencing"
+
+	"github.com/facundomedica/rollinky/sequencer/utils"
+)
+
+type recordingInput struct {
+	txs [][]byte
+}
+
+func (r *recordingInput) SubmitRollupTransaction(_ context.Context, req sequencing.SubmitRollupTransactionRequest) (*sequencing.SubmitRollupTransactionResponse, error) {
+	r.txs = append(r.txs, req.Tx)
+	return &sequencing.SubmitRollupTransactionResponse{}, nil
+}
+
+func TestTxFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		tx      []byte
+		wantErr bool
+	}{
+		{
+			name: "user tx",
+			tx:   []byte{0x0a, 0x02, 0x08, 0x01},
+		},
+		{
+			name:    "tagged envelope",
+			tx:      utils.Envelope{Prices: []byte("prices"), Report: []byte("report")}.Marshal(),
+			wantErr: true,
+		},
+		{
+			name:    "untagged envelope",
+			tx:      utils.Encode([]byte("prices"), []byte("report")),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &recordingInput{}
+			_, err := NewTxFilter(input).SubmitRollupTransaction(context.Background(), sequencing.SubmitRollupTransactionRequest{Tx: tt.tx})
+
+			if tt.wantErr {
+				if !errors.Is(err, ErrEnvelopeTx) {
+					t.Errorf("expected ErrEnvelopeTx, got %v", err)
+				}
+				if len(input.txs) != 0 {
+					t.Error("rejected tx was forwarded")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if len(input.txs) != 1 {
+				t.Error("accepted tx was not forwarded")
+			}
+		})
+	}
+}
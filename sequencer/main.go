@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"encoding/base64"
 	"encoding/hex"
 	"flag"
 	"fmt"
@@ -15,7 +14,6 @@ import (
 	"time"
 
 	"github.com/BurntSushi/toml"
-	oracleclient "github.com/facundomedica/connect-client"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rollkit/centralized-sequencer/sequencing"
 	sequencingGRPC "github.com/rollkit/go-sequencing/proxy/grpc"
@@ -23,6 +21,7 @@ import (
 	oracletypes "github.com/skip-mev/connect/v2/service/servers/oracle/types"
 
 	sdklog "cosmossdk.io/log"
+	oracleclient "github.com/facundomedica/rollinky/connect/client"
 	"github.com/facundomedica/rollinky/sequencer/utils"
 	"github.com/skip-mev/connect/v2/service/metrics"
 )
@@ -32,6 +31,8 @@ const (
 	defaultPort      = "50051"
 	defaultBatchTime = 2 * time.Second
 	defaultDA        = "http://localhost:26658"
+
+	defaultMarketsRefresh = 30 * time.Second
 )
 
 func main() {
@@ -49,6 +50,10 @@ func main() {
 		metricsAddress   string
 		signerID         string
 		oracleConfigPath string
+		rollupGRPC       string
+		missingPairs     string
+		marketsRefresh   time.Duration
+		sequencerKey     string
 	)
 	flag.StringVar(&host, "host", defaultHost, "centralized sequencer host")
 	flag.StringVar(&port, "port", defaultPort, "centralized sequencer port")
@@ -63,6 +68,10 @@ func main() {
 	flag.StringVar(&metricsAddress, "metrics-address", ":8080", "Address to expose Prometheus metrics")
 	flag.StringVar(&signerID, "signer-id", "", "Intel SGX signer ID")
 	flag.StringVar(&oracleConfigPath, "config", "config.toml", "path to oracle config file")
+	flag.StringVar(&rollupGRPC, "rollup-grpc", "", "rollup node gRPC address used to read the market map (if empty, the sidecar's market map is used)")
+	flag.StringVar(&missingPairs, "missing-pairs", string(MissingPairsWarn), "what to do with oracle payloads missing enabled markets (warn, refuse)")
+	flag.DurationVar(&marketsRefresh, "markets-refresh", defaultMarketsRefresh, "how often to refresh the set of enabled markets")
+	flag.StringVar(&sequencerKey, "sequencer-key", "", "path to the file holding the hex encoded ed25519 seed oracle envelopes are signed with (requires -rollup-grpc)")
 
 	flag.Parse()
 
@@ -110,11 +119,47 @@ func main() {
 	}
 	oracle := NewOracle(oracleCfg, signerID)
 
+	missingPairsPolicy, err := ParseMissingPairsPolicy(missingPairs)
+	if err != nil {
+		log.Fatalf("Failed to parse missing pairs policy: %v", err)
+	}
+
+	var marketMapSource MarketMapSource
+	if rollupGRPC != "" {
+		marketMapSource, err = NewChainMarketMapSource(rollupGRPC)
+		if err != nil {
+			log.Fatalf("Failed to create market map source: %v", err)
+		}
+	} else {
+		marketMapSource = NewSidecarMarketMapSource(oracle.oracleClient)
+	}
+	oracle.requiredPairs = NewRequiredPairs(marketMapSource, missingPairsPolicy)
+	go oracle.requiredPairs.Run(context.Background(), marketsRefresh)
+
+	if sequencerKey != "" {
+		if rollupGRPC == "" {
+			log.Fatalf("Signing oracle envelopes requires -rollup-grpc to read the rollup chain ID and height")
+		}
+
+		key, err := LoadSequencerKey(sequencerKey)
+		if err != nil {
+			log.Fatalf("Failed to load sequencer key: %v", err)
+		}
+
+		heights, err := NewChainHeightSource(rollupGRPC)
+		if err != nil {
+			log.Fatalf("Failed to create height source: %v", err)
+		}
+
+		oracle.signer = NewEnvelopeSigner(key, heights)
+		log.Printf("Signing oracle envelopes with sequencer public key %s\n", oracle.signer.PublicKey())
+	}
+
 	centralizedSeq, err := sequencing.NewSequencer(da_address, da_auth_token, namespace, []byte(rollupId), batchTime, metrics, db_path, oracle)
 	if err != nil {
 		log.Fatalf("Failed to create centralized sequencer: %v", err)
 	}
-	grpcServer := sequencingGRPC.NewServer(centralizedSeq, centralizedSeq, centralizedSeq)
+	grpcServer := sequencingGRPC.NewServer(NewTxFilter(centralizedSeq), centralizedSeq, centralizedSeq)
 
 	log.Println("Starting centralized sequencing gRPC server on port 50051...")
 	if err := grpcServer.Serve(lis); err != nil {
@@ -136,6 +181,10 @@ func main() {
 type Oracle struct {
 	oracleClient oracleclient.OracleClient
 	signerID     []byte
+	// requiredPairs, if set, is used to check that payloads cover every enabled market.
+	requiredPairs *RequiredPairs
+	// signer, if set, signs the envelopes with the sequencer's key.
+	signer *EnvelopeSigner
 }
 
 func NewOracle(oracleCfg oracleconfig.AppConfig, signerID string) *Oracle {
@@ -147,10 +196,13 @@ func NewOracle(oracleCfg oracleconfig.AppConfig, signerID string) *Oracle {
 		panic(err)
 	}
 
+	// the daemon verifies every response as it is fetched, so Head only ever
+	// sees attested prices and doesn't pay for verification itself.
 	oracle.oracleClient, err = oracleclient.NewPriceDaemonClientFromConfig(
 		oracleCfg,
 		sdklog.NewLogger(os.Stderr),
 		metrics.NewMetrics("rollinky"),
+		oracleclient.WithVerifier(oracle.signerID, utils.VerifyReport),
 	)
 	if err != nil {
 		panic(err)
@@ -170,13 +222,16 @@ var _ sequencing.BatchExtender = (*Oracle)(nil)
 
 // Head implements sequencing.BatchExtender.
 func (o *Oracle) Head(max uint64) ([]byte, error) {
-	if cc, ok := o.oracleClient.(oracleclient.WithTrailer); ok {
-		start := time.Now()
-		ctx, _ := context.WithTimeout(context.Background(), time.Second*3)
-		prices, trailer, err := cc.PricesWithTrailer(ctx, &oracletypes.QueryPricesRequest{})
-
+	if cc, ok := o.oracleClient.(oracleclient.WithVerification); ok {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+		defer cancel()
+		prices, attestation, err := cc.VerifiedPrices(ctx, &oracletypes.QueryPricesRequest{})
 		if prices == nil || err != nil {
-			fmt.Println("prices is nil")
+			fmt.Println("no verified prices available: ", err)
+			return nil, nil
+		}
+
+		if o.requiredPairs != nil && !o.requiredPairs.Check(prices.Prices) {
 			return nil, nil
 		}
 
@@ -186,28 +241,28 @@ func (o *Oracle) Head(max uint64) ([]byte, error) {
 			return nil, err
 		}
 
-		fullTrailer := trailer.Get("x-enclave-report")
-		var enclaveReport []byte
-		if len(fullTrailer) != 0 {
-			report := fullTrailer[0]
+		fmt.Println("Including verified prices: ", prices.Prices)
 
-			enclaveReport, err = base64.RawStdEncoding.DecodeString(report)
-			if err != nil {
-				panic(err)
-			}
-		} else {
-			fmt.Println("No trailer found")
+		envelope := utils.Envelope{
+			Prices:         pricesBz,
+			Report:         attestation.Report,
+			Nonce:          attestation.Nonce,
+			Timestamp:      attestation.Timestamp,
+			ConfigDigest:   attestation.ConfigDigest,
+			MarketMap:      attestation.MarketMap,
+			ProviderPrices: attestation.ProviderPrices,
 		}
 
-		if err := utils.VerifyReport(enclaveReport, pricesBz, o.signerID); err != nil {
-			panic(err)
+		if o.signer != nil {
+			if err := o.signer.Sign(ctx, &envelope); err != nil {
+				fmt.Println("Error signing envelope: ", err)
+				return nil, nil
+			}
 		}
 
-		fmt.Println("Verified prices!: ", prices.Prices, "That took: ", time.Since(start))
-
-		return utils.Encode(pricesBz, enclaveReport), nil
+		return envelope.Marshal(), nil
 	} else {
-		fmt.Println("Oracle client does not support trailers")
+		fmt.Println("Oracle client does not support verification")
 	}
 
 	return nil, nil
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	oracletypes "github.com/skip-mev/connect/v2/service/servers/oracle/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// MissingPairsPolicy defines what the sequencer does with an oracle payload that
+// doesn't carry a price for every enabled market.
+type MissingPairsPolicy string
+
+const (
+	// MissingPairsWarn includes the payload anyway and logs the missing pairs.
+	MissingPairsWarn MissingPairsPolicy = "warn"
+	// MissingPairsRefuse drops the payload, so the block carries no prices.
+	MissingPairsRefuse MissingPairsPolicy = "refuse"
+)
+
+// ParseMissingPairsPolicy parses a MissingPairsPolicy from its string form.
+func ParseMissingPairsPolicy(s string) (MissingPairsPolicy, error) {
+	switch p := MissingPairsPolicy(s); p {
+	case MissingPairsWarn, MissingPairsRefuse:
+		return p, nil
+	default:
+		return "", fmt.Errorf("invalid missing pairs policy %q, expected %q or %q", s, MissingPairsWarn, MissingPairsRefuse)
+	}
+}
+
+// MarketMapSource returns the market map that determines which currency pairs
+// the rollup expects prices for.
+type MarketMapSource interface {
+	MarketMap(ctx context.Context) (*mmtypes.MarketMap, error)
+}
+
+// chainMarketMapSource queries the rollup's x/marketmap module over gRPC.
+type chainMarketMapSource struct {
+	client mmtypes.QueryClient
+}
+
+// NewChainMarketMapSource returns a MarketMapSource backed by the x/marketmap
+// module of the rollup node listening on addr.
+func NewChainMarketMapSource(addr string) (MarketMapSource, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial rollup gRPC at %s: %w", addr, err)
+	}
+
+	return &chainMarketMapSource{client: mmtypes.NewQueryClient(conn)}, nil
+}
+
+func (s *chainMarketMapSource) MarketMap(ctx context.Context) (*mmtypes.MarketMap, error) {
+	resp, err := s.client.MarketMap(ctx, &mmtypes.MarketMapRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.MarketMap, nil
+}
+
+// sidecarMarketMapSource asks the oracle sidecar for the market map it is
+// currently running with.
+type sidecarMarketMapSource struct {
+	client oracletypes.OracleClient
+}
+
+// NewSidecarMarketMapSource returns a MarketMapSource backed by the sidecar's
+// MarketMap RPC.
+func NewSidecarMarketMapSource(client oracletypes.OracleClient) MarketMapSource {
+	return &sidecarMarketMapSource{client: client}
+}
+
+func (s *sidecarMarketMapSource) MarketMap(ctx context.Context) (*mmtypes.MarketMap, error) {
+	resp, err := s.client.MarketMap(ctx, &oracletypes.QueryMarketMapRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil || resp.MarketMap == nil {
+		return nil, fmt.Errorf("sidecar returned an empty market map")
+	}
+
+	return resp.MarketMap, nil
+}
+
+var (
+	pairCoveredGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "rollinky",
+		Subsystem: "sequencer",
+		Name:      "pair_covered",
+		Help:      "Whether the last oracle payload carried a price for the enabled market (1) or not (0).",
+	}, []string{"pair"})
+	pairMissingCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "rollinky",
+		Subsystem: "sequencer",
+		Name:      "pair_missing_total",
+		Help:      "Number of oracle payloads that were missing a price for the enabled market.",
+	}, []string{"pair"})
+	payloadsRefusedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "rollinky",
+		Subsystem: "sequencer",
+		Name:      "payloads_refused_total",
+		Help:      "Number of oracle payloads not included because they were missing required pairs.",
+	})
+)
+
+// RequiredPairs keeps track of the currency pairs the rollup expects prices for,
+// i.e. the enabled tickers of its market map, and checks oracle payloads against
+// them.
+type RequiredPairs struct {
+	source MarketMapSource
+	policy MissingPairsPolicy
+
+	mtx   sync.RWMutex
+	pairs []string
+}
+
+// NewRequiredPairs creates a new RequiredPairs that reads the market map from source.
+func NewRequiredPairs(source MarketMapSource, policy MissingPairsPolicy) *RequiredPairs {
+	return &RequiredPairs{
+		source: source,
+		policy: policy,
+	}
+}
+
+// Refresh fetches the market map from the source and updates the set of
+// required pairs to its enabled tickers.
+func (r *RequiredPairs) Refresh(ctx context.Context) error {
+	mm, err := r.source.MarketMap(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch market map: %w", err)
+	}
+
+	pairs := make([]string, 0, len(mm.Markets))
+	for _, market := range mm.Markets {
+		if !market.Ticker.Enabled {
+			continue
+		}
+
+		pairs = append(pairs, market.Ticker.CurrencyPair.String())
+	}
+	sort.Strings(pairs)
+
+	r.mtx.Lock()
+	r.pairs = pairs
+	r.mtx.Unlock()
+
+	return nil
+}
+
+// Run refreshes the required pairs every interval until ctx is cancelled.
+func (r *RequiredPairs) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Refresh(ctx); err != nil {
+			log.Println("Failed to refresh required pairs: ", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Pairs returns the currently required pairs, sorted.
+func (r *RequiredPairs) Pairs() []string {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return r.pairs
+}
+
+// Missing returns the required pairs that have no price in prices, sorted.
+func (r *RequiredPairs) Missing(prices map[string]string) []string {
+	var missing []string
+	for _, pair := range r.Pairs() {
+		if _, ok := prices[pair]; !ok {
+			missing = append(missing, pair)
+		}
+	}
+
+	return missing
+}
+
+// Check reports the coverage of prices over the required pairs and returns
+// whether the payload should be included according to the policy.
+func (r *RequiredPairs) Check(prices map[string]string) bool {
+	missing := r.Missing(prices)
+
+	missingSet := make(map[string]struct{}, len(missing))
+	for _, pair := range missing {
+		missingSet[pair] = struct{}{}
+		pairMissingCounter.WithLabelValues(pair).Inc()
+	}
+	for _, pair := range r.Pairs() {
+		covered := 1.0
+		if _, ok := missingSet[pair]; ok {
+			covered = 0
+		}
+		pairCoveredGauge.WithLabelValues(pair).Set(covered)
+	}
+
+	if len(missing) == 0 {
+		return true
+	}
+
+	if r.policy == MissingPairsRefuse {
+		payloadsRefusedCounter.Inc()
+		log.Printf("Refusing oracle payload, missing prices for %d/%d required pairs: %v\n", len(missing), len(r.Pairs()), missing)
+		return false
+	}
+
+	log.Printf("Oracle payload is missing prices for %d/%d required pairs: %v\n", len(missing), len(r.Pairs()), missing)
+	return true
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+type staticMarketMapSource struct {
+	mm  *mmtypes.MarketMap
+	err error
+}
+
+func (s staticMarketMapSource) MarketMap(context.Context) (*mmtypes.MarketMap, error) {
+	return s.mm, s.err
+}
+
+func market(base, quote string, enabled bool) mmtypes.Market {
+	return mmtypes.Market{
+		Ticker: mmtypes.Ticker{
+			CurrencyPair: connecttypes.NewCurrencyPair(base, quote),
+			Enabled:      enabled,
+		},
+	}
+}
+
+func TestRequiredPairs(t *testing.T) {
+	source := staticMarketMapSource{
+		mm: &mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				"BTC/USD":  market("BTC", "USD", true),
+				"ETH/USD":  market("ETH", "USD", true),
+				"DOGE/USD": market("DOGE", "USD", false),
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		policy  MissingPairsPolicy
+		prices  map[string]string
+		missing []string
+		include bool
+	}{
+		{
+			name:    "all pairs covered",
+			policy:  MissingPairsRefuse,
+			prices:  map[string]string{"BTC/USD": "1", "ETH/USD": "2"},
+			include: true,
+		},
+		{
+			name:    "disabled pairs are not required",
+			policy:  MissingPairsRefuse,
+			prices:  map[string]string{"BTC/USD": "1", "ETH/USD": "2", "DOGE/USD": "3"},
+			include: true,
+		},
+		{
+			name:    "missing pair with warn policy",
+			policy:  MissingPairsWarn,
+			prices:  map[string]string{"BTC/USD": "1"},
+			missing: []string{"ETH/USD"},
+			include: true,
+		},
+		{
+			name:    "missing pairs with refuse policy",
+			policy:  MissingPairsRefuse,
+			prices:  map[string]string{},
+			missing: []string{"BTC/USD", "ETH/USD"},
+			include: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRequiredPairs(source, tt.policy)
+			if err := r.Refresh(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := r.Pairs(); !reflect.DeepEqual(got, []string{"BTC/USD", "ETH/USD"}) {
+				t.Errorf("unexpected required pairs: %v", got)
+			}
+
+			if got := r.Missing(tt.prices); !reflect.DeepEqual(got, tt.missing) {
+				t.Errorf("missing pairs mismatch: got %v, want %v", got, tt.missing)
+			}
+
+			if got := r.Check(tt.prices); got != tt.include {
+				t.Errorf("include mismatch: got %v, want %v", got, tt.include)
+			}
+		})
+	}
+}
+
+func TestRequiredPairsRefreshError(t *testing.T) {
+	r := NewRequiredPairs(staticMarketMapSource{err: errors.New("unavailable")}, MissingPairsRefuse)
+	if err := r.Refresh(context.Background()); err == nil {
+		t.Error("expected error, got nil")
+	}
+
+	// with no known markets nothing is required.
+	if !r.Check(map[string]string{}) {
+		t.Error("expected payload to be included")
+	}
+}
+
+func TestParseMissingPairsPolicy(t *testing.T) {
+	for _, s := range []string{"warn", "refuse"} {
+		if _, err := ParseMissingPairsPolicy(s); err != nil {
+			t.Errorf("unexpected error for %q: %v", s, err)
+		}
+	}
+
+	if _, err := ParseMissingPairsPolicy("ignore"); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
@@ -0,0 +1,365 @@
+package dcap
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// IntelSGXRootCA is the Intel SGX Root CA certificate that every PCK, TCB info
+// and QE identity signing chain must end in.
+const IntelSGXRootCA = `-----BEGIN CERTIFICATE-----
+MIICjzCCAjSgAwIBAgIUImUM1lqdNInzg7SVUr9QGzknBqwwCgYIKoZIzj0EAwIw
+aDEaMBgGA1UEAwwRSW50ZWwgU0dYIFJvb3QgQ0ExGjAYBgNVBAoMEUludGVsIENv
+cnBvcmF0aW9uMRQwEgYDVQQHDAtTYW50YSBDbGFyYTELMAkGA1UECAwCQ0ExCzAJ
+BgNVBAYTAlVTMB4XDTE4MDUyMTEwNDUxMFoXDTQ5MTIzMTIzNTk1OVowaDEaMBgG
+A1UEAwwRSW50ZWwgU0dYIFJvb3QgQ0ExGjAYBgNVBAoMEUludGVsIENvcnBvcmF0
+aW9uMRQwEgYDVQQHDAtTYW50YSBDbGFyYTELMAkGA1UECAwCQ0ExCzAJBgNVBAYT
+AlVTMFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEC6nEwMDIYZOj/iPWsCzaEKi7
+1OiOSLRFhWGjbnBVJfVnkY4u3IjkDYYL0MxO4mqsyYjlBalTVYxFP2sJBK5zlKOB
+uzCBuDAfBgNVHSMEGDAWgBQiZQzWWp00ifODtJVSv1AbOScGrDBSBgNVHR8ESzBJ
+MEegRaBDhkFodHRwczovL2NlcnRpZmljYXRlcy50cnVzdGVkc2VydmljZXMuaW50
+ZWwuY29tL0ludGVsU0dYUm9vdENBLmRlcjAdBgNVHQ4EFgQUImUM1lqdNInzg7SV
+Ur9QGzknBqwwDgYDVR0PAQH/BAQDAgEGMBIGA1UdEwEB/wQIMAYBAf8CAQEwCgYI
+KoZIzj0EAwIDSQAwRgIhAOW/5QkR+S9CiSDcNoowLuPRLsWGf/Yi7GSX94BgwTwg
+AiEA4J0lrHoMs+Xo5o/sX6O9QWxHRAvZUGOdRQ7cvqRXaqI=
+-----END CERTIFICATE-----
+`
+
+// Collateral is the set of pinned Intel PCS artifacts a quote is verified
+// against. Nothing is fetched at verification time, so the same collateral and
+// time always give the same verdict.
+type Collateral struct {
+	RootCA     *x509.Certificate
+	CRLs       []*x509.RevocationList
+	TCBInfos   map[string]*TCBInfo // keyed by lower case hex FMSPC
+	QEIdentity *QEIdentity
+}
+
+// collateralFile is the on-disk (and on-chain) encoding of the collateral. The
+// TCB info and QE identity documents are kept verbatim as returned by the PCS,
+// since their signatures cover the exact bytes.
+type collateralFile struct {
+	RootCA                string            `json:"root_ca,omitempty"`
+	CRLs                  []string          `json:"crls"`
+	TCBInfoIssuerChain    string            `json:"tcb_info_issuer_chain"`
+	TCBInfos              []json.RawMessage `json:"tcb_infos"`
+	QEIdentityIssuerChain string            `json:"qe_identity_issuer_chain"`
+	QEIdentity            json.RawMessage   `json:"qe_identity"`
+}
+
+// LoadCollateral reads the collateral from a JSON file.
+func LoadCollateral(path string) (*Collateral, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read collateral: %w", err)
+	}
+
+	c, err := ParseCollateral(bz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse collateral %s: %w", path, err)
+	}
+
+	return c, nil
+}
+
+// ParseCollateral decodes the JSON encoded collateral. Signatures are not
+// checked here but when verifying a quote, against the verification time.
+func ParseCollateral(bz []byte) (*Collateral, error) {
+	var f collateralFile
+	if err := json.Unmarshal(bz, &f); err != nil {
+		return nil, err
+	}
+
+	c := &Collateral{TCBInfos: make(map[string]*TCBInfo, len(f.TCBInfos))}
+
+	rootCA := f.RootCA
+	if rootCA == "" {
+		rootCA = IntelSGXRootCA
+	}
+	roots, err := parseCertChain([]byte(rootCA))
+	if err != nil {
+		return nil, fmt.Errorf("invalid root CA: %w", err)
+	}
+	c.RootCA = roots[0]
+
+	for i, s := range f.CRLs {
+		crl, err := parseCRL(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CRL %d: %w", i, err)
+		}
+		c.CRLs = append(c.CRLs, crl)
+	}
+
+	if len(f.TCBInfos) > 0 {
+		chain, err := parseCertChain([]byte(f.TCBInfoIssuerChain))
+		if err != nil {
+			return nil, fmt.Errorf("invalid TCB info issuer chain: %w", err)
+		}
+
+		for i, raw := range f.TCBInfos {
+			info, err := parseTCBInfo(raw, chain)
+			if err != nil {
+				return nil, fmt.Errorf("invalid TCB info %d: %w", i, err)
+			}
+			c.TCBInfos[strings.ToLower(info.FMSPC)] = info
+		}
+	}
+
+	if len(f.QEIdentity) > 0 {
+		chain, err := parseCertChain([]byte(f.QEIdentityIssuerChain))
+		if err != nil {
+			return nil, fmt.Errorf("invalid QE identity issuer chain: %w", err)
+		}
+
+		c.QEIdentity, err = parseQEIdentity(f.QEIdentity, chain)
+		if err != nil {
+			return nil, fmt.Errorf("invalid QE identity: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+// parseCRL accepts a CRL as PEM, or as hex or base64 encoded DER, which are the
+// encodings the PCS and PCCS serve them in.
+func parseCRL(s string) (*x509.RevocationList, error) {
+	s = strings.TrimSpace(s)
+
+	var der []byte
+	if block, _ := pem.Decode([]byte(s)); block != nil {
+		der = block.Bytes
+	} else if bz, err := hex.DecodeString(s); err == nil {
+		der = bz
+	} else if bz, err := base64.StdEncoding.DecodeString(s); err == nil {
+		der = bz
+	} else {
+		return nil, errors.New("unknown CRL encoding")
+	}
+
+	return x509.ParseRevocationList(der)
+}
+
+// TCBStatus is the status of a TCB level as reported by Intel.
+type TCBStatus string
+
+const (
+	TCBUpToDate                          TCBStatus = "UpToDate"
+	TCBSWHardeningNeeded                 TCBStatus = "SWHardeningNeeded"
+	TCBConfigurationNeeded               TCBStatus = "ConfigurationNeeded"
+	TCBConfigurationAndSWHardeningNeeded TCBStatus = "ConfigurationAndSWHardeningNeeded"
+	TCBOutOfDate                         TCBStatus = "OutOfDate"
+	TCBOutOfDateConfigurationNeeded      TCBStatus = "OutOfDateConfigurationNeeded"
+	TCBRevoked                           TCBStatus = "Revoked"
+)
+
+// signedDocument is the common shape of the PCS TCB info and QE identity
+// responses: a body and a hex encoded ECDSA signature over its exact bytes.
+type signedDocument struct {
+	body        []byte
+	signature   []byte
+	issuerChain []*x509.Certificate
+	issueDate   time.Time
+	nextUpdate  time.Time
+}
+
+// TCBInfo is a platform TCB info document (version 3) for one FMSPC.
+type TCBInfo struct {
+	signedDocument
+
+	FMSPC  string
+	PCEID  string
+	Levels []TCBLevel
+}
+
+// TCBLevel is a TCB level of a TCB info document.
+type TCBLevel struct {
+	Components  [tcbComponentCount]int
+	PCESVN      int
+	Status      TCBStatus
+	AdvisoryIDs []string
+}
+
+// QEIdentity is the Quoting Enclave identity document (version 2).
+type QEIdentity struct {
+	signedDocument
+
+	MiscSelect     uint32
+	MiscSelectMask uint32
+	Attributes     []byte
+	AttributesMask []byte
+	MRSIGNER       []byte
+	ISVProdID      uint16
+	Levels         []QELevel
+}
+
+// QELevel is a TCB level of the QE identity document.
+type QELevel struct {
+	ISVSVN      uint16
+	Status      TCBStatus
+	AdvisoryIDs []string
+}
+
+func parseSignedDocument(raw []byte, bodyKey string, chain []*x509.Certificate) (signedDocument, error) {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return signedDocument{}, err
+	}
+
+	body, ok := doc[bodyKey]
+	if !ok {
+		return signedDocument{}, fmt.Errorf("missing %s", bodyKey)
+	}
+
+	var sigHex string
+	if err := json.Unmarshal(doc["signature"], &sigHex); err != nil {
+		return signedDocument{}, fmt.Errorf("invalid signature: %w", err)
+	}
+	sig, err := hex.DecodeString(sigHex)
+	if err != nil || len(sig) != signatureSize {
+		return signedDocument{}, errors.New("invalid signature")
+	}
+
+	var dates struct {
+		IssueDate  time.Time `json:"issueDate"`
+		NextUpdate time.Time `json:"nextUpdate"`
+	}
+	if err := json.Unmarshal(body, &dates); err != nil {
+		return signedDocument{}, err
+	}
+
+	return signedDocument{
+		body:        body,
+		signature:   sig,
+		issuerChain: chain,
+		issueDate:   dates.IssueDate,
+		nextUpdate:  dates.NextUpdate,
+	}, nil
+}
+
+func parseTCBInfo(raw []byte, chain []*x509.Certificate) (*TCBInfo, error) {
+	doc, err := parseSignedDocument(raw, "tcbInfo", chain)
+	if err != nil {
+		return nil, err
+	}
+
+	var body struct {
+		ID        string `json:"id"`
+		Version   int    `json:"version"`
+		FMSPC     string `json:"fmspc"`
+		PCEID     string `json:"pceId"`
+		TCBLevels []struct {
+			TCB struct {
+				SGXTCBComponents []struct {
+					SVN int `json:"svn"`
+				} `json:"sgxtcbcomponents"`
+				PCESVN int `json:"pcesvn"`
+			} `json:"tcb"`
+			TCBStatus   TCBStatus `json:"tcbStatus"`
+			AdvisoryIDs []string  `json:"advisoryIDs"`
+		} `json:"tcbLevels"`
+	}
+	if err := json.Unmarshal(doc.body, &body); err != nil {
+		return nil, err
+	}
+
+	if body.Version != 3 || (body.ID != "" && body.ID != "SGX") {
+		return nil, fmt.Errorf("unsupported TCB info %s version %d", body.ID, body.Version)
+	}
+
+	info := &TCBInfo{
+		signedDocument: doc,
+		FMSPC:          body.FMSPC,
+		PCEID:          body.PCEID,
+	}
+	for _, l := range body.TCBLevels {
+		if len(l.TCB.SGXTCBComponents) != tcbComponentCount {
+			return nil, fmt.Errorf("expected %d TCB components, got %d", tcbComponentCount, len(l.TCB.SGXTCBComponents))
+		}
+
+		level := TCBLevel{
+			PCESVN:      l.TCB.PCESVN,
+			Status:      l.TCBStatus,
+			AdvisoryIDs: l.AdvisoryIDs,
+		}
+		for i, c := range l.TCB.SGXTCBComponents {
+			level.Components[i] = c.SVN
+		}
+		info.Levels = append(info.Levels, level)
+	}
+
+	return info, nil
+}
+
+func parseQEIdentity(raw []byte, chain []*x509.Certificate) (*QEIdentity, error) {
+	doc, err := parseSignedDocument(raw, "enclaveIdentity", chain)
+	if err != nil {
+		return nil, err
+	}
+
+	var body struct {
+		ID             string `json:"id"`
+		Version        int    `json:"version"`
+		MiscSelect     string `json:"miscselect"`
+		MiscSelectMask string `json:"miscselectMask"`
+		Attributes     string `json:"attributes"`
+		AttributesMask string `json:"attributesMask"`
+		MRSIGNER       string `json:"mrsigner"`
+		ISVProdID      uint16 `json:"isvprodid"`
+		TCBLevels      []struct {
+			TCB struct {
+				ISVSVN uint16 `json:"isvsvn"`
+			} `json:"tcb"`
+			TCBStatus   TCBStatus `json:"tcbStatus"`
+			AdvisoryIDs []string  `json:"advisoryIDs"`
+		} `json:"tcbLevels"`
+	}
+	if err := json.Unmarshal(doc.body, &body); err != nil {
+		return nil, err
+	}
+
+	if body.Version != 2 || body.ID != "QE" {
+		return nil, fmt.Errorf("unsupported enclave identity %s version %d", body.ID, body.Version)
+	}
+
+	qe := &QEIdentity{signedDocument: doc, ISVProdID: body.ISVProdID}
+
+	var miscSelect, miscSelectMask []byte
+	for _, f := range []struct {
+		s    string
+		dst  *[]byte
+		size int
+	}{
+		{body.MiscSelect, &miscSelect, 4},
+		{body.MiscSelectMask, &miscSelectMask, 4},
+		{body.Attributes, &qe.Attributes, 16},
+		{body.AttributesMask, &qe.AttributesMask, 16},
+		{body.MRSIGNER, &qe.MRSIGNER, 32},
+	} {
+		bz, err := hex.DecodeString(f.s)
+		if err != nil || len(bz) != f.size {
+			return nil, fmt.Errorf("invalid field %q", f.s)
+		}
+		*f.dst = bz
+	}
+	// the PCS encodes miscselect big endian, the report little endian.
+	qe.MiscSelect = uint32(miscSelect[0])<<24 | uint32(miscSelect[1])<<16 | uint32(miscSelect[2])<<8 | uint32(miscSelect[3])
+	qe.MiscSelectMask = uint32(miscSelectMask[0])<<24 | uint32(miscSelectMask[1])<<16 | uint32(miscSelectMask[2])<<8 | uint32(miscSelectMask[3])
+
+	for _, l := range body.TCBLevels {
+		qe.Levels = append(qe.Levels, QELevel{
+			ISVSVN:      l.TCB.ISVSVN,
+			Status:      l.TCBStatus,
+			AdvisoryIDs: l.AdvisoryIDs,
+		})
+	}
+
+	return qe, nil
+}
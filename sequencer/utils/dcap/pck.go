@@ -0,0 +1,116 @@
+package dcap
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+)
+
+var (
+	oidSGXExtensions = asn1.ObjectIdentifier{1, 2, 840, 113741, 1, 13, 1}
+	oidSGXTCB        = asn1.ObjectIdentifier{1, 2, 840, 113741, 1, 13, 1, 2}
+	oidSGXPCESVN     = asn1.ObjectIdentifier{1, 2, 840, 113741, 1, 13, 1, 2, 17}
+	oidSGXPCEID      = asn1.ObjectIdentifier{1, 2, 840, 113741, 1, 13, 1, 3}
+	oidSGXFMSPC      = asn1.ObjectIdentifier{1, 2, 840, 113741, 1, 13, 1, 4}
+)
+
+// tcbComponentCount is the number of SGX TCB component SVNs.
+const tcbComponentCount = 16
+
+// pckExtensions are the Intel SGX extensions of a PCK certificate that are
+// needed to find the platform's TCB level.
+type pckExtensions struct {
+	FMSPC      []byte
+	PCEID      []byte
+	PCESVN     int
+	Components [tcbComponentCount]int
+}
+
+type sgxExtension struct {
+	ID    asn1.ObjectIdentifier
+	Value asn1.RawValue
+}
+
+// parsePCKExtensions reads the SGX extensions from a PCK leaf certificate.
+func parsePCKExtensions(cert *x509.Certificate) (*pckExtensions, error) {
+	var raw []byte
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(oidSGXExtensions) {
+			raw = ext.Value
+			break
+		}
+	}
+	if raw == nil {
+		return nil, errors.New("PCK certificate has no SGX extensions")
+	}
+
+	var entries []sgxExtension
+	if _, err := asn1.Unmarshal(raw, &entries); err != nil {
+		return nil, fmt.Errorf("invalid SGX extensions: %w", err)
+	}
+
+	exts := &pckExtensions{PCESVN: -1}
+	for i := range exts.Components {
+		exts.Components[i] = -1
+	}
+
+	for _, entry := range entries {
+		var err error
+		switch {
+		case entry.ID.Equal(oidSGXTCB):
+			err = exts.parseTCB(entry.Value.FullBytes)
+		case entry.ID.Equal(oidSGXPCEID):
+			_, err = asn1.Unmarshal(entry.Value.FullBytes, &exts.PCEID)
+		case entry.ID.Equal(oidSGXFMSPC):
+			_, err = asn1.Unmarshal(entry.Value.FullBytes, &exts.FMSPC)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid SGX extension %s: %w", entry.ID, err)
+		}
+	}
+
+	if exts.FMSPC == nil || exts.PCEID == nil || exts.PCESVN < 0 {
+		return nil, errors.New("PCK certificate is missing required SGX extensions")
+	}
+	for i, svn := range exts.Components {
+		if svn < 0 {
+			return nil, fmt.Errorf("PCK certificate is missing TCB component %d", i+1)
+		}
+	}
+
+	return exts, nil
+}
+
+func (e *pckExtensions) parseTCB(raw []byte) error {
+	var entries []sgxExtension
+	if _, err := asn1.Unmarshal(raw, &entries); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		id := entry.ID
+		if len(id) != len(oidSGXTCB)+1 || !id[:len(oidSGXTCB)].Equal(oidSGXTCB) {
+			continue
+		}
+
+		n := id[len(id)-1]
+		if n < 1 || n > tcbComponentCount && !id.Equal(oidSGXPCESVN) {
+			// the CPUSVN entry is the concatenation of the component SVNs.
+			continue
+		}
+
+		var svn int
+		if _, err := asn1.Unmarshal(entry.Value.FullBytes, &svn); err != nil {
+			return err
+		}
+
+		if id.Equal(oidSGXPCESVN) {
+			e.PCESVN = svn
+		} else {
+			e.Components[n-1] = svn
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package dcap
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+const (
+	// oeReportHeaderSize is the size of the Open Enclave report header that EGo
+	// prepends to the SGX quote.
+	oeReportHeaderSize     = 16
+	oeReportHeaderVersion  = 1
+	oeEnclaveTypeSGX       = 2
+	quoteVersion3          = 3
+	attestationKeyTypeP256 = 2
+	certDataTypePCKChain   = 5
+
+	quoteHeaderSize = 48
+	reportBodySize  = 384
+	signatureSize   = 64
+	publicKeySize   = 64
+
+	// sgxFlagDebug is the DEBUG bit of the SGX enclave attributes.
+	sgxFlagDebug = 0x2
+)
+
+// reportBody is an SGX enclave report body (sgx_report_body_t).
+type reportBody struct {
+	raw []byte
+
+	CPUSVN     []byte
+	MiscSelect uint32
+	Attributes []byte
+	MRENCLAVE  []byte
+	MRSIGNER   []byte
+	ISVProdID  uint16
+	ISVSVN     uint16
+	ReportData []byte
+}
+
+func parseReportBody(b []byte) reportBody {
+	return reportBody{
+		raw:        b,
+		CPUSVN:     b[0:16],
+		MiscSelect: binary.LittleEndian.Uint32(b[16:20]),
+		Attributes: b[48:64],
+		MRENCLAVE:  b[64:96],
+		MRSIGNER:   b[128:160],
+		ISVProdID:  binary.LittleEndian.Uint16(b[256:258]),
+		ISVSVN:     binary.LittleEndian.Uint16(b[258:260]),
+		ReportData: b[320:384],
+	}
+}
+
+// debug reports whether the enclave was started in debug mode.
+func (r reportBody) debug() bool {
+	return binary.LittleEndian.Uint64(r.Attributes[0:8])&sgxFlagDebug != 0
+}
+
+// quote is a parsed SGX ECDSA quote (version 3).
+type quote struct {
+	// signedData is the header and the enclave report body, which is what the
+	// attestation key signs.
+	signedData []byte
+
+	Body reportBody
+
+	Signature      []byte
+	AttestationKey []byte
+	QEReport       reportBody
+	QESignature    []byte
+	QEAuthData     []byte
+	PCKChain       []*x509.Certificate
+}
+
+// parseQuote parses an SGX quote, optionally prefixed with the Open Enclave
+// report header as returned by EGo's GetRemoteReport.
+func parseQuote(b []byte) (*quote, error) {
+	b = stripOEHeader(b)
+
+	if len(b) < quoteHeaderSize+reportBodySize+4 {
+		return nil, errors.New("quote too short")
+	}
+
+	if v := binary.LittleEndian.Uint16(b[0:2]); v != quoteVersion3 {
+		return nil, fmt.Errorf("unsupported quote version %d", v)
+	}
+	if t := binary.LittleEndian.Uint16(b[2:4]); t != attestationKeyTypeP256 {
+		return nil, fmt.Errorf("unsupported attestation key type %d", t)
+	}
+
+	q := &quote{
+		signedData: b[:quoteHeaderSize+reportBodySize],
+		Body:       parseReportBody(b[quoteHeaderSize : quoteHeaderSize+reportBodySize]),
+	}
+
+	sigLen := binary.LittleEndian.Uint32(b[quoteHeaderSize+reportBodySize:])
+	sig := b[quoteHeaderSize+reportBodySize+4:]
+	if uint64(len(sig)) != uint64(sigLen) {
+		return nil, errors.New("invalid quote signature data length")
+	}
+
+	r := &reader{b: sig}
+	q.Signature = r.next(signatureSize)
+	q.AttestationKey = r.next(publicKeySize)
+	q.QEReport = parseReportBody(r.next(reportBodySize))
+	q.QESignature = r.next(signatureSize)
+	q.QEAuthData = r.next(int(r.uint16()))
+	certType := r.uint16()
+	certData := r.next(int(r.uint32()))
+	if r.err != nil {
+		return nil, fmt.Errorf("invalid quote signature data: %w", r.err)
+	}
+
+	if certType != certDataTypePCKChain {
+		return nil, fmt.Errorf("unsupported certification data type %d", certType)
+	}
+
+	chain, err := parseCertChain(certData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PCK certificate chain: %w", err)
+	}
+	q.PCKChain = chain
+
+	return q, nil
+}
+
+// stripOEHeader removes the Open Enclave report header if b starts with one.
+func stripOEHeader(b []byte) []byte {
+	if len(b) < oeReportHeaderSize {
+		return b
+	}
+
+	version := binary.LittleEndian.Uint32(b[0:4])
+	typ := binary.LittleEndian.Uint32(b[4:8])
+	size := binary.LittleEndian.Uint64(b[8:16])
+	if version == oeReportHeaderVersion && typ == oeEnclaveTypeSGX && size == uint64(len(b)-oeReportHeaderSize) {
+		return b[oeReportHeaderSize:]
+	}
+
+	return b
+}
+
+// parseCertChain parses a concatenation of PEM certificates, leaf first.
+func parseCertChain(b []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := bytes.TrimRight(b, "\x00")
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("no certificates found")
+	}
+
+	return certs, nil
+}
+
+// reader reads little endian fields from a byte slice, remembering the first
+// out of bounds read.
+type reader struct {
+	b   []byte
+	err error
+}
+
+func (r *reader) next(n int) []byte {
+	if r.err != nil || n > len(r.b) {
+		r.err = errors.New("unexpected end of data")
+		return make([]byte, n)
+	}
+
+	out := r.b[:n]
+	r.b = r.b[n:]
+	return out
+}
+
+func (r *reader) uint16() uint16 {
+	return binary.LittleEndian.Uint16(r.next(2))
+}
+
+func (r *reader) uint32() uint32 {
+	return binary.LittleEndian.Uint32(r.next(4))
+}
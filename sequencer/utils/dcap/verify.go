@@ -0,0 +1,362 @@
+// Package dcap verifies Intel SGX DCAP quotes, as produced by EGo's
+// GetRemoteReport, against pinned collateral.
+//
+// Unlike eclient.VerifyRemoteReport it does not use the host's quote provider
+// library nor the network: the result only depends on the quote, the
+// collateral and the verification time, so it can be used inside consensus.
+package dcap
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/edgelesssys/ego/attestation/tcbstatus"
+)
+
+// ErrTCBLevelInvalid is returned by Verify if the quote is valid, but the TCB
+// is not considered up to date. Check the report's TCBStatus.
+var ErrTCBLevelInvalid = errors.New("TCB level is not up to date")
+
+// Report is the verified enclave report, mirroring EGo's attestation.Report.
+type Report struct {
+	Data            []byte
+	SecurityVersion uint
+	Debug           bool
+	UniqueID        []byte
+	SignerID        []byte
+	ProductID       []byte
+	TCBStatus       tcbstatus.Status
+	TCBAdvisories   []string
+}
+
+// Verify verifies an SGX quote against the collateral at time now.
+func Verify(quoteBytes []byte, c *Collateral, now time.Time) (Report, error) {
+	if c == nil {
+		return Report{}, errors.New("no collateral")
+	}
+
+	q, err := parseQuote(quoteBytes)
+	if err != nil {
+		return Report{}, err
+	}
+
+	// the PCK certificate chain vouches for the QE, which vouches for the
+	// attestation key, which signs the enclave report.
+	if err := c.verifyChain(q.PCKChain, now); err != nil {
+		return Report{}, fmt.Errorf("invalid PCK certificate chain: %w", err)
+	}
+
+	pck, ok := q.PCKChain[0].PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return Report{}, errors.New("PCK certificate has no ECDSA key")
+	}
+	if err := verifySignature(pck, q.QEReport.raw, q.QESignature); err != nil {
+		return Report{}, fmt.Errorf("invalid QE report signature: %w", err)
+	}
+
+	keyHash := sha256.Sum256(append(append([]byte{}, q.AttestationKey...), q.QEAuthData...))
+	if !bytes.Equal(q.QEReport.ReportData[:32], keyHash[:]) || !bytes.Equal(q.QEReport.ReportData[32:], make([]byte, 32)) {
+		return Report{}, errors.New("QE report data does not match the attestation key")
+	}
+
+	attestationKey, err := parsePublicKey(q.AttestationKey)
+	if err != nil {
+		return Report{}, fmt.Errorf("invalid attestation key: %w", err)
+	}
+	if err := verifySignature(attestationKey, q.signedData, q.Signature); err != nil {
+		return Report{}, fmt.Errorf("invalid quote signature: %w", err)
+	}
+
+	// the TCB levels of the platform and the QE determine the TCB status.
+	platformStatus, platformAdvisories, err := c.platformTCB(q.PCKChain[0], now)
+	if err != nil {
+		return Report{}, err
+	}
+
+	qeStatus, qeAdvisories, err := c.qeTCB(q.QEReport, now)
+	if err != nil {
+		return Report{}, err
+	}
+
+	status := convergeTCBStatus(platformStatus, qeStatus)
+
+	body := q.Body
+	productID := make([]byte, 2)
+	productID[0], productID[1] = byte(body.ISVProdID), byte(body.ISVProdID>>8)
+
+	report := Report{
+		Data:            append([]byte{}, body.ReportData...),
+		SecurityVersion: uint(body.ISVSVN),
+		Debug:           body.debug(),
+		UniqueID:        append([]byte{}, body.MRENCLAVE...),
+		SignerID:        append([]byte{}, body.MRSIGNER...),
+		ProductID:       productID,
+		TCBStatus:       toEgoStatus(status),
+		TCBAdvisories:   mergeAdvisories(platformAdvisories, qeAdvisories),
+	}
+
+	if status != TCBUpToDate {
+		return report, ErrTCBLevelInvalid
+	}
+
+	return report, nil
+}
+
+// verifyChain verifies that chain (leaf first) ends in the pinned root CA at
+// time now and that none of its certificates are revoked.
+func (c *Collateral) verifyChain(chain []*x509.Certificate, now time.Time) error {
+	if len(chain) == 0 {
+		return errors.New("empty certificate chain")
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(c.RootCA)
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		if !cert.Equal(c.RootCA) {
+			intermediates.AddCert(cert)
+		}
+	}
+
+	chains, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Intel's chains are linear, so there is a single verified chain.
+	verified := chains[0]
+	for i := 0; i < len(verified)-1; i++ {
+		if err := c.checkRevocation(verified[i], verified[i+1], now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkRevocation checks cert against the CRL issued by its issuer, which must
+// be part of the collateral and valid at time now.
+func (c *Collateral) checkRevocation(cert, issuer *x509.Certificate, now time.Time) error {
+	for _, crl := range c.CRLs {
+		if !bytes.Equal(crl.RawIssuer, issuer.RawSubject) || crl.CheckSignatureFrom(issuer) != nil {
+			continue
+		}
+
+		if now.Before(crl.ThisUpdate) || (!crl.NextUpdate.IsZero() && now.After(crl.NextUpdate)) {
+			return fmt.Errorf("CRL of %q is not valid at %s", issuer.Subject.CommonName, now.UTC().Format(time.RFC3339))
+		}
+
+		for _, revoked := range crl.RevokedCertificateEntries {
+			if revoked.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+				return fmt.Errorf("certificate %q is revoked", cert.Subject.CommonName)
+			}
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("no CRL for %q in collateral", issuer.Subject.CommonName)
+}
+
+// verifyDocument checks the signature and validity period of a TCB info or QE
+// identity document.
+func (c *Collateral) verifyDocument(doc signedDocument, now time.Time) error {
+	if err := c.verifyChain(doc.issuerChain, now); err != nil {
+		return fmt.Errorf("invalid issuer chain: %w", err)
+	}
+
+	key, ok := doc.issuerChain[0].PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return errors.New("issuer has no ECDSA key")
+	}
+	if err := verifySignature(key, doc.body, doc.signature); err != nil {
+		return err
+	}
+
+	if now.Before(doc.issueDate) || now.After(doc.nextUpdate) {
+		return fmt.Errorf("not valid at %s (valid from %s to %s)",
+			now.UTC().Format(time.RFC3339), doc.issueDate.Format(time.RFC3339), doc.nextUpdate.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// platformTCB returns the status of the platform's TCB level, according to the
+// TCB info of the PCK certificate's FMSPC.
+func (c *Collateral) platformTCB(pck *x509.Certificate, now time.Time) (TCBStatus, []string, error) {
+	exts, err := parsePCKExtensions(pck)
+	if err != nil {
+		return "", nil, err
+	}
+
+	fmspc := hex.EncodeToString(exts.FMSPC)
+	info, ok := c.TCBInfos[fmspc]
+	if !ok {
+		return "", nil, fmt.Errorf("no TCB info for FMSPC %s in collateral", fmspc)
+	}
+
+	if err := c.verifyDocument(info.signedDocument, now); err != nil {
+		return "", nil, fmt.Errorf("invalid TCB info: %w", err)
+	}
+
+	if !strings.EqualFold(info.PCEID, hex.EncodeToString(exts.PCEID)) {
+		return "", nil, errors.New("TCB info PCE ID does not match the PCK certificate")
+	}
+
+	// levels are sorted from newest to oldest, the first one the platform
+	// satisfies is its level.
+	for _, level := range info.Levels {
+		if level.matches(exts) {
+			return level.Status, level.AdvisoryIDs, nil
+		}
+	}
+
+	return "", nil, errors.New("platform TCB level not found in TCB info")
+}
+
+func (l TCBLevel) matches(exts *pckExtensions) bool {
+	for i, svn := range l.Components {
+		if exts.Components[i] < svn {
+			return false
+		}
+	}
+
+	return exts.PCESVN >= l.PCESVN
+}
+
+// qeTCB checks the QE report against the QE identity and returns the status of
+// its TCB level.
+func (c *Collateral) qeTCB(qe reportBody, now time.Time) (TCBStatus, []string, error) {
+	id := c.QEIdentity
+	if id == nil {
+		return "", nil, errors.New("no QE identity in collateral")
+	}
+
+	if err := c.verifyDocument(id.signedDocument, now); err != nil {
+		return "", nil, fmt.Errorf("invalid QE identity: %w", err)
+	}
+
+	if !bytes.Equal(qe.MRSIGNER, id.MRSIGNER) {
+		return "", nil, errors.New("QE signer does not match the QE identity")
+	}
+	if qe.ISVProdID != id.ISVProdID {
+		return "", nil, errors.New("QE product ID does not match the QE identity")
+	}
+	if qe.MiscSelect&id.MiscSelectMask != id.MiscSelect {
+		return "", nil, errors.New("QE miscselect does not match the QE identity")
+	}
+	for i := range id.Attributes {
+		if qe.Attributes[i]&id.AttributesMask[i] != id.Attributes[i] {
+			return "", nil, errors.New("QE attributes do not match the QE identity")
+		}
+	}
+
+	for _, level := range id.Levels {
+		if qe.ISVSVN >= level.ISVSVN {
+			return level.Status, level.AdvisoryIDs, nil
+		}
+	}
+
+	return TCBRevoked, nil, nil
+}
+
+// convergeTCBStatus combines the platform and QE TCB statuses the same way
+// Intel's quote verification library does.
+func convergeTCBStatus(platform, qe TCBStatus) TCBStatus {
+	switch qe {
+	case TCBOutOfDate:
+		switch platform {
+		case TCBUpToDate, TCBSWHardeningNeeded:
+			return TCBOutOfDate
+		case TCBConfigurationNeeded, TCBConfigurationAndSWHardeningNeeded:
+			return TCBOutOfDateConfigurationNeeded
+		}
+	case TCBRevoked:
+		return TCBRevoked
+	}
+
+	return platform
+}
+
+func toEgoStatus(s TCBStatus) tcbstatus.Status {
+	switch s {
+	case TCBUpToDate:
+		return tcbstatus.UpToDate
+	case TCBOutOfDate:
+		return tcbstatus.OutOfDate
+	case TCBRevoked:
+		return tcbstatus.Revoked
+	case TCBConfigurationNeeded:
+		return tcbstatus.ConfigurationNeeded
+	case TCBOutOfDateConfigurationNeeded:
+		return tcbstatus.OutOfDateConfigurationNeeded
+	case TCBSWHardeningNeeded:
+		return tcbstatus.SWHardeningNeeded
+	case TCBConfigurationAndSWHardeningNeeded:
+		return tcbstatus.ConfigurationAndSWHardeningNeeded
+	default:
+		return tcbstatus.Unknown
+	}
+}
+
+func mergeAdvisories(lists ...[]string) []string {
+	var out []string
+	seen := make(map[string]struct{})
+	for _, list := range lists {
+		for _, id := range list {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			out = append(out, id)
+		}
+	}
+
+	return out
+}
+
+// parsePublicKey parses a raw P-256 public key (X || Y).
+func parsePublicKey(b []byte) (*ecdsa.PublicKey, error) {
+	if len(b) != publicKeySize {
+		return nil, errors.New("invalid key length")
+	}
+
+	x := new(big.Int).SetBytes(b[:32])
+	y := new(big.Int).SetBytes(b[32:])
+	if !elliptic.P256().IsOnCurve(x, y) {
+		return nil, errors.New("key is not on curve P-256")
+	}
+
+	return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+}
+
+// verifySignature verifies a raw (R || S) ECDSA signature over the SHA-256
+// hash of data.
+func verifySignature(key *ecdsa.PublicKey, data, sig []byte) error {
+	if len(sig) != signatureSize {
+		return errors.New("invalid signature length")
+	}
+
+	hash := sha256.Sum256(data)
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if !ecdsa.Verify(key, hash[:], r, s) {
+		return errors.New("signature verification failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,436 @@
+package dcap
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgelesssys/ego/attestation/tcbstatus"
+)
+
+var (
+	testNow   = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	testFMSPC = []byte{0x00, 0x90, 0x6e, 0xd5, 0x00, 0x00}
+	testPCEID = []byte{0x00, 0x00}
+	qeSigner  = bytes.Repeat([]byte{0x8c}, 32)
+)
+
+// fixture is a synthetic Intel PKI, a quote signed through it and the
+// collateral to verify that quote.
+type fixture struct {
+	t *testing.T
+
+	rootKey, pckCAKey, pckKey, tcbKey *ecdsa.PrivateKey
+	rootCert, pckCACert, pckCert      *x509.Certificate
+	tcbCert                           *x509.Certificate
+
+	platformSVN int
+	tcbLevels   []string
+	revokePCK   bool
+
+	reportData []byte
+}
+
+func newFixture(t *testing.T) *fixture {
+	f := &fixture{
+		t:           t,
+		platformSVN: 5,
+		tcbLevels:   []string{level(5, "UpToDate"), level(2, "OutOfDate")},
+		reportData:  bytes.Repeat([]byte{0xab}, 64),
+	}
+
+	f.rootKey, f.rootCert = f.cert(1, "Test SGX Root CA", true, nil, nil, nil)
+	f.pckCAKey, f.pckCACert = f.cert(2, "Test SGX PCK Platform CA", true, f.rootCert, f.rootKey, nil)
+	f.tcbKey, f.tcbCert = f.cert(3, "Test SGX TCB Signing", false, f.rootCert, f.rootKey, nil)
+
+	return f
+}
+
+func level(svn int, status string) string {
+	components := make([]string, tcbComponentCount)
+	for i := range components {
+		components[i] = fmt.Sprintf(`{"svn":%d}`, svn)
+	}
+	return fmt.Sprintf(`{"tcb":{"sgxtcbcomponents":[%s],"pcesvn":%d},"tcbDate":"2024-01-01T00:00:00Z","tcbStatus":%q,"advisoryIDs":["INTEL-SA-%05d"]}`,
+		strings.Join(components, ","), svn, status, svn)
+}
+
+func (f *fixture) cert(serial int64, cn string, ca bool, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, exts []pkix.Extension) (*ecdsa.PrivateKey, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             testNow.Add(-365 * 24 * time.Hour),
+		NotAfter:              testNow.Add(365 * 24 * time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  ca,
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtraExtensions:       exts,
+	}
+	if ca {
+		tmpl.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	}
+
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+
+	return key, cert
+}
+
+func (f *fixture) sgxExtension() pkix.Extension {
+	mustMarshal := func(v any) asn1.RawValue {
+		bz, err := asn1.Marshal(v)
+		if err != nil {
+			f.t.Fatal(err)
+		}
+		return asn1.RawValue{FullBytes: bz}
+	}
+	oid := func(base asn1.ObjectIdentifier, n int) asn1.ObjectIdentifier {
+		return append(append(asn1.ObjectIdentifier{}, base...), n)
+	}
+
+	var tcb []sgxExtension
+	for i := 1; i <= tcbComponentCount; i++ {
+		tcb = append(tcb, sgxExtension{ID: oid(oidSGXTCB, i), Value: mustMarshal(f.platformSVN)})
+	}
+	tcb = append(tcb,
+		sgxExtension{ID: oidSGXPCESVN, Value: mustMarshal(f.platformSVN)},
+		sgxExtension{ID: oid(oidSGXTCB, 18), Value: mustMarshal(bytes.Repeat([]byte{byte(f.platformSVN)}, 16))},
+	)
+
+	value := mustMarshal([]sgxExtension{
+		{ID: oid(oidSGXExtensions, 1), Value: mustMarshal(bytes.Repeat([]byte{1}, 16))},
+		{ID: oidSGXTCB, Value: mustMarshal(tcb)},
+		{ID: oidSGXPCEID, Value: mustMarshal(testPCEID)},
+		{ID: oidSGXFMSPC, Value: mustMarshal(testFMSPC)},
+	})
+
+	return pkix.Extension{Id: oidSGXExtensions, Value: value.FullBytes}
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, data []byte) []byte {
+	hash := sha256.Sum256(data)
+	r, s, err := ecdsa.Sign(rand.Reader, key, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sig := make([]byte, signatureSize)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return sig
+}
+
+func pemEncode(certs ...*x509.Certificate) string {
+	var buf bytes.Buffer
+	for _, cert := range certs {
+		_ = pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	}
+	return buf.String()
+}
+
+func reportBodyBytes(mrsigner []byte, prodID, svn uint16, attributes uint64, data []byte) []byte {
+	b := make([]byte, reportBodySize)
+	binary.LittleEndian.PutUint64(b[48:56], attributes)
+	copy(b[64:96], bytes.Repeat([]byte{0xee}, 32))
+	copy(b[128:160], mrsigner)
+	binary.LittleEndian.PutUint16(b[256:258], prodID)
+	binary.LittleEndian.PutUint16(b[258:260], svn)
+	copy(b[320:384], data)
+	return b
+}
+
+// quote builds an EGo remote report: an Open Enclave header followed by an
+// SGX v3 quote.
+func (f *fixture) quote() []byte {
+	t := f.t
+
+	f.pckKey, f.pckCert = f.cert(100, "Test SGX PCK Certificate", false, f.pckCACert, f.pckCAKey, []pkix.Extension{f.sgxExtension()})
+
+	attestationKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rawKey := make([]byte, publicKeySize)
+	attestationKey.X.FillBytes(rawKey[:32])
+	attestationKey.Y.FillBytes(rawKey[32:])
+
+	authData := []byte("auth data")
+	keyHash := sha256.Sum256(append(append([]byte{}, rawKey...), authData...))
+	qeReport := reportBodyBytes(qeSigner, 1, 8, 0x11, keyHash[:])
+
+	header := make([]byte, quoteHeaderSize)
+	binary.LittleEndian.PutUint16(header[0:2], quoteVersion3)
+	binary.LittleEndian.PutUint16(header[2:4], attestationKeyTypeP256)
+	body := reportBodyBytes(bytes.Repeat([]byte{0x10}, 32), 1, 2, 0x4, f.reportData)
+	signed := append(append([]byte{}, header...), body...)
+
+	certData := []byte(pemEncode(f.pckCert, f.pckCACert, f.rootCert))
+
+	var sig bytes.Buffer
+	sig.Write(sign(t, attestationKey, signed))
+	sig.Write(rawKey)
+	sig.Write(qeReport)
+	sig.Write(sign(t, f.pckKey, qeReport))
+	_ = binary.Write(&sig, binary.LittleEndian, uint16(len(authData)))
+	sig.Write(authData)
+	_ = binary.Write(&sig, binary.LittleEndian, uint16(certDataTypePCKChain))
+	_ = binary.Write(&sig, binary.LittleEndian, uint32(len(certData)))
+	sig.Write(certData)
+
+	var q bytes.Buffer
+	q.Write(signed)
+	_ = binary.Write(&q, binary.LittleEndian, uint32(sig.Len()))
+	q.Write(sig.Bytes())
+
+	var report bytes.Buffer
+	_ = binary.Write(&report, binary.LittleEndian, uint32(oeReportHeaderVersion))
+	_ = binary.Write(&report, binary.LittleEndian, uint32(oeEnclaveTypeSGX))
+	_ = binary.Write(&report, binary.LittleEndian, uint64(q.Len()))
+	report.Write(q.Bytes())
+
+	return report.Bytes()
+}
+
+func (f *fixture) crl(issuer *x509.Certificate, key *ecdsa.PrivateKey, revoked ...*big.Int) string {
+	var entries []x509.RevocationListEntry
+	for _, serial := range revoked {
+		entries = append(entries, x509.RevocationListEntry{SerialNumber: serial, RevocationTime: testNow.Add(-time.Hour)})
+	}
+
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    big.NewInt(1),
+		ThisUpdate:                testNow.Add(-24 * time.Hour),
+		NextUpdate:                testNow.Add(30 * 24 * time.Hour),
+		RevokedCertificateEntries: entries,
+	}, issuer, key)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+
+	return hex.EncodeToString(der)
+}
+
+func (f *fixture) document(bodyKey, body string) json.RawMessage {
+	sig := sign(f.t, f.tcbKey, []byte(body))
+	return json.RawMessage(fmt.Sprintf(`{%q:%s,"signature":%q}`, bodyKey, body, hex.EncodeToString(sig)))
+}
+
+func (f *fixture) collateral() *Collateral {
+	t := f.t
+
+	var revoked []*big.Int
+	if f.revokePCK {
+		revoked = append(revoked, f.pckCert.SerialNumber)
+	}
+
+	tcbInfo := fmt.Sprintf(`{"id":"SGX","version":3,"issueDate":"2024-05-01T00:00:00Z","nextUpdate":"2024-07-01T00:00:00Z","fmspc":%q,"pceId":%q,"tcbType":0,"tcbEvaluationDataNumber":16,"tcbLevels":[%s]}`,
+		strings.ToUpper(hex.EncodeToString(testFMSPC)), hex.EncodeToString(testPCEID), strings.Join(f.tcbLevels, ","))
+	qeIdentity := fmt.Sprintf(`{"id":"QE","version":2,"issueDate":"2024-05-01T00:00:00Z","nextUpdate":"2024-07-01T00:00:00Z","tcbEvaluationDataNumber":16,"miscselect":"00000000","miscselectMask":"FFFFFFFF","attributes":"11000000000000000000000000000000","attributesMask":"FBFFFFFFFFFFFFFF0000000000000000","mrsigner":%q,"isvprodid":1,"tcbLevels":[{"tcb":{"isvsvn":8},"tcbDate":"2024-01-01T00:00:00Z","tcbStatus":"UpToDate"}]}`,
+		strings.ToUpper(hex.EncodeToString(qeSigner)))
+
+	file := collateralFile{
+		RootCA: pemEncode(f.rootCert),
+		CRLs: []string{
+			f.crl(f.rootCert, f.rootKey),
+			f.crl(f.pckCACert, f.pckCAKey, revoked...),
+		},
+		TCBInfoIssuerChain:    pemEncode(f.tcbCert, f.rootCert),
+		TCBInfos:              []json.RawMessage{f.document("tcbInfo", tcbInfo)},
+		QEIdentityIssuerChain: pemEncode(f.tcbCert, f.rootCert),
+		QEIdentity:            f.document("enclaveIdentity", qeIdentity),
+	}
+
+	bz, err := json.Marshal(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := ParseCollateral(bz)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+func TestVerify(t *testing.T) {
+	f := newFixture(t)
+	quote := f.quote()
+
+	report, err := Verify(quote, f.collateral(), testNow)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(report.Data, f.reportData) {
+		t.Errorf("unexpected report data %x", report.Data)
+	}
+	if !bytes.Equal(report.SignerID, bytes.Repeat([]byte{0x10}, 32)) {
+		t.Errorf("unexpected signer ID %x", report.SignerID)
+	}
+	if binary.LittleEndian.Uint16(report.ProductID) != 1 || report.SecurityVersion != 2 {
+		t.Errorf("unexpected product ID %x or security version %d", report.ProductID, report.SecurityVersion)
+	}
+	if report.Debug {
+		t.Error("expected a non debug enclave")
+	}
+	if report.TCBStatus != tcbstatus.UpToDate {
+		t.Errorf("unexpected TCB status %v", report.TCBStatus)
+	}
+
+	// the Open Enclave header is optional.
+	if _, err := Verify(quote[oeReportHeaderSize:], f.collateral(), testNow); err != nil {
+		t.Errorf("unexpected error for a raw quote: %v", err)
+	}
+
+	// the verdict only depends on its inputs.
+	again, err := Verify(quote, f.collateral(), testNow)
+	if err != nil || !bytes.Equal(again.Data, report.Data) || again.TCBStatus != report.TCBStatus {
+		t.Errorf("verification is not deterministic: %v", err)
+	}
+}
+
+func TestVerifyFailures(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(f *fixture)
+		now     time.Time
+		tamper  func(quote []byte)
+		collat  func(c *Collateral)
+		wantErr string
+	}{
+		{
+			name:    "collateral expired",
+			now:     testNow.Add(60 * 24 * time.Hour),
+			wantErr: "CRL",
+		},
+		{
+			name:    "collateral not yet valid",
+			now:     time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+			wantErr: "CRL",
+		},
+		{
+			name:    "revoked PCK certificate",
+			modify:  func(f *fixture) { f.revokePCK = true },
+			wantErr: "revoked",
+		},
+		{
+			name:    "tampered report data",
+			tamper:  func(quote []byte) { quote[oeReportHeaderSize+quoteHeaderSize+320] ^= 0xff },
+			wantErr: "invalid quote signature",
+		},
+		{
+			name: "unknown FMSPC",
+			collat: func(c *Collateral) {
+				c.TCBInfos = map[string]*TCBInfo{}
+			},
+			wantErr: "no TCB info",
+		},
+		{
+			name:    "missing CRL",
+			collat:  func(c *Collateral) { c.CRLs = c.CRLs[:1] },
+			wantErr: "no CRL",
+		},
+		{
+			name: "untrusted root",
+			collat: func(c *Collateral) {
+				roots, _ := parseCertChain([]byte(IntelSGXRootCA))
+				c.RootCA = roots[0]
+			},
+			wantErr: "certificate",
+		},
+		{
+			name:    "platform TCB below every level",
+			modify:  func(f *fixture) { f.platformSVN = 1 },
+			wantErr: "TCB level not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			if tt.modify != nil {
+				tt.modify(f)
+			}
+
+			quote := f.quote()
+			if tt.tamper != nil {
+				tt.tamper(quote)
+			}
+
+			c := f.collateral()
+			if tt.collat != nil {
+				tt.collat(c)
+			}
+
+			now := testNow
+			if !tt.now.IsZero() {
+				now = tt.now
+			}
+
+			_, err := Verify(quote, c, now)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestVerifyOutOfDate(t *testing.T) {
+	f := newFixture(t)
+	f.platformSVN = 3
+
+	report, err := Verify(f.quote(), f.collateral(), testNow)
+	if !errors.Is(err, ErrTCBLevelInvalid) {
+		t.Fatalf("expected ErrTCBLevelInvalid, got %v", err)
+	}
+
+	if report.TCBStatus != tcbstatus.OutOfDate {
+		t.Errorf("unexpected TCB status %v", report.TCBStatus)
+	}
+	if len(report.TCBAdvisories) != 1 || report.TCBAdvisories[0] != "INTEL-SA-00002" {
+		t.Errorf("unexpected advisories %v", report.TCBAdvisories)
+	}
+}
+
+func TestParseCollateralDefaultsToIntelRoot(t *testing.T) {
+	c, err := ParseCollateral([]byte(`{"crls":[]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.RootCA.Subject.CommonName != "Intel SGX Root CA" {
+		t.Errorf("unexpected root CA %q", c.RootCA.Subject.CommonName)
+	}
+}
@@ -0,0 +1,248 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// EnvelopeTag marks the oracle payload the sequencer puts at the head of a
+// block. It starts with a zero byte, which no protobuf encoded transaction can
+// start with, and the sequencer refuses user transactions that carry it, so
+// only the sequencer can produce a tagged envelope.
+var EnvelopeTag = []byte("\x00rollinky/oracle/v1")
+
+// SignedEnvelopeTag marks an oracle envelope signed by the sequencer. The
+// signature covers the tag, so it can't be moved to an envelope of another
+// kind.
+var SignedEnvelopeTag = []byte("\x00rollinky/oracle/v2")
+
+// ConfigDigestSize is the size of the sidecar config digest.
+const ConfigDigestSize = sha256.Size
+
+// NonceSize is the size of the nonce the sequencer binds the enclave report
+// to, the sidecar only accepts nonces of this size.
+const NonceSize = 32
+
+// ErrNotEnvelope is returned by UnmarshalEnvelope if the data is not tagged as
+// an oracle envelope.
+var ErrNotEnvelope = errors.New("not an oracle envelope")
+
+// Envelope is the oracle payload: the prices returned by the sidecar and the
+// enclave report attesting them, bound to the nonce of the sequencer's request,
+// the time the report was created, the digest of the sidecar's config, the
+// market map the prices were aggregated with and, if the sidecar attests
+// them, the per-provider prices.
+//
+// A signed envelope also carries the rollup height the sequencer built it for
+// and the sequencer's signature over the envelope and the height (see Sign).
+type Envelope struct {
+	Prices         []byte
+	Report         []byte
+	Nonce          []byte
+	Timestamp      int64
+	ConfigDigest   []byte
+	MarketMap      []byte
+	ProviderPrices []byte
+	Height         uint64
+	Signature      []byte
+}
+
+// Marshal encodes the envelope, prefixed with EnvelopeTag, or with
+// SignedEnvelopeTag if it is signed.
+func (e Envelope) Marshal() []byte {
+	if e.Signature == nil {
+		return append(append([]byte{}, EnvelopeTag...), e.body()...)
+	}
+
+	signature := Encode(binary.BigEndian.AppendUint64(nil, e.Height), e.Signature)
+	return append(append([]byte{}, SignedEnvelopeTag...), Encode(e.body(), signature)...)
+}
+
+// body encodes the prices, the report and the data bound into the report.
+func (e Envelope) body() []byte {
+	// the timestamp and the config digest have a fixed size, the market map and
+	// the provider prices are what follows them.
+	bound := binary.BigEndian.AppendUint64(nil, uint64(e.Timestamp))
+	bound = append(bound, e.ConfigDigest...)
+	if e.MarketMap != nil || e.ProviderPrices != nil {
+		bound = append(bound, Encode(e.MarketMap, e.ProviderPrices)...)
+	}
+	attestation := Encode(e.Nonce, bound)
+	return Encode(Encode(e.Prices, e.Report), attestation)
+}
+
+// SignBytes returns the bytes the sequencer signs for the rollup of the given
+// chain ID: the signed envelope tag, the prices, the report, the data bound
+// into the report, the chain ID and the height. The chain ID isn't part of the
+// envelope, the rollup checks the signature against its own, so an envelope
+// can't be replayed on another chain sharing the sequencer key.
+func (e Envelope) SignBytes(chainID string) []byte {
+	signed := Encode([]byte(chainID), binary.BigEndian.AppendUint64(nil, e.Height))
+	return append(append([]byte{}, SignedEnvelopeTag...), Encode(e.body(), signed)...)
+}
+
+// Sign signs the envelope for the given chain ID and rollup height with the
+// sequencer's key.
+func (e *Envelope) Sign(key ed25519.PrivateKey, chainID string, height uint64) {
+	e.Height = height
+	e.Signature = ed25519.Sign(key, e.SignBytes(chainID))
+}
+
+// VerifySignature reports whether the envelope is signed for chainID by the
+// sequencer holding the private key of pubKey.
+func (e Envelope) VerifySignature(pubKey ed25519.PublicKey, chainID string) bool {
+	return len(e.Signature) > 0 && len(pubKey) == ed25519.PublicKeySize && ed25519.Verify(pubKey, e.SignBytes(chainID), e.Signature)
+}
+
+// UnmarshalEnvelope decodes a tagged oracle envelope, signed or not.
+func UnmarshalEnvelope(data []byte) (Envelope, error) {
+	var (
+		body              []byte
+		height, signature []byte
+		err               error
+	)
+	switch {
+	case bytes.HasPrefix(data, SignedEnvelopeTag):
+		var signed []byte
+		body, signed, err = Decode(data[len(SignedEnvelopeTag):])
+		if err != nil {
+			return Envelope{}, err
+		}
+
+		height, signature, err = Decode(signed)
+		if err != nil {
+			return Envelope{}, err
+		}
+		if len(height) != 8 {
+			return Envelope{}, fmt.Errorf("invalid height length")
+		}
+		if len(signature) == 0 {
+			return Envelope{}, fmt.Errorf("missing sequencer signature")
+		}
+	case bytes.HasPrefix(data, EnvelopeTag):
+		body = data[len(EnvelopeTag):]
+	default:
+		return Envelope{}, ErrNotEnvelope
+	}
+
+	envelope, err := unmarshalBody(body)
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	if signature != nil {
+		envelope.Height = binary.BigEndian.Uint64(height)
+		envelope.Signature = signature
+	}
+
+	return envelope, nil
+}
+
+// unmarshalBody decodes the prices, the report and the data bound into the
+// report.
+func unmarshalBody(body []byte) (Envelope, error) {
+	payload, attestation, err := Decode(body)
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	prices, report, err := Decode(payload)
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	nonce, bound, err := Decode(attestation)
+	if err != nil {
+		return Envelope{}, err
+	}
+	if len(nonce) != NonceSize {
+		return Envelope{}, fmt.Errorf("invalid nonce length: %d bytes, expected %d", len(nonce), NonceSize)
+	}
+	if len(bound) < 8 {
+		return Envelope{}, fmt.Errorf("invalid timestamp length")
+	}
+
+	var configDigest, marketMap, providerPrices []byte
+	if len(bound) > 8 {
+		if len(bound) < 8+ConfigDigestSize {
+			return Envelope{}, fmt.Errorf("invalid config digest length")
+		}
+		configDigest = bound[8 : 8+ConfigDigestSize]
+
+		if len(bound) > 8+ConfigDigestSize {
+			marketMap, providerPrices, err = Decode(bound[8+ConfigDigestSize:])
+			if err != nil {
+				return Envelope{}, fmt.Errorf("invalid market map: %w", err)
+			}
+			marketMap, providerPrices = nilIfEmpty(marketMap), nilIfEmpty(providerPrices)
+		}
+	}
+
+	return Envelope{
+		Prices:         prices,
+		Report:         report,
+		Nonce:          nonce,
+		Timestamp:      int64(binary.BigEndian.Uint64(bound[:8])),
+		ConfigDigest:   configDigest,
+		MarketMap:      marketMap,
+		ProviderPrices: providerPrices,
+	}, nil
+}
+
+func nilIfEmpty(bz []byte) []byte {
+	if len(bz) == 0 {
+		return nil
+	}
+
+	return bz
+}
+
+// ReportData returns the data whose hash the enclave report must carry: the
+// prices, the nonce, the big endian timestamp, the config digest, the SHA-256
+// digest of the market map (empty without one) and the provider prices, each
+// prefixed with its big endian uint32 length. It matches what the sidecar
+// binds into the report (see BindReportData in the connect client).
+func (e Envelope) ReportData() []byte {
+	fields := [][]byte{e.Prices, e.Nonce, binary.BigEndian.AppendUint64(nil, uint64(e.Timestamp)), e.ConfigDigest, e.MarketMapDigest(), e.ProviderPrices}
+
+	var data []byte
+	for _, field := range fields {
+		data = binary.BigEndian.AppendUint32(data, uint32(len(field))) //nolint:gosec
+		data = append(data, field...)
+	}
+
+	return data
+}
+
+// MarketMapDigest returns the SHA-256 digest of the market map, nil if the
+// envelope carries none.
+func (e Envelope) MarketMapDigest() []byte {
+	if len(e.MarketMap) == 0 {
+		return nil
+	}
+
+	digest := sha256.Sum256(e.MarketMap)
+	return digest[:]
+}
+
+// Time returns the time the enclave report was created at.
+func (e Envelope) Time() time.Time {
+	return time.Unix(0, e.Timestamp)
+}
+
+// IsEnvelopeShaped reports whether data looks like an oracle envelope, either
+// tagged (signed or not) or in the untagged encoding previously used. Such
+// data must never be accepted as a user transaction.
+func IsEnvelopeShaped(data []byte) bool {
+	if bytes.HasPrefix(data, EnvelopeTag) || bytes.HasPrefix(data, SignedEnvelopeTag) {
+		return true
+	}
+
+	_, _, err := Decode(data)
+	return err == nil
+}
@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// UnmarshalMarketMap decodes the market map carried in an envelope, the one
+// the sidecar aggregated the prices with (see EncodeMarketMap in the connect
+// client).
+func UnmarshalMarketMap(bz []byte) (mmtypes.MarketMap, error) {
+	var marketMap mmtypes.MarketMap
+	if err := json.Unmarshal(bz, &marketMap); err != nil {
+		return mmtypes.MarketMap{}, fmt.Errorf("failed to decode market map: %w", err)
+	}
+
+	return marketMap, nil
+}
@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ProviderPrice is the price a single provider reported for a currency pair,
+// converted to the pair and scaled to its decimals, as attested by the sidecar
+// (see ProviderPrices in the connect client).
+type ProviderPrice struct {
+	Provider  string `json:"provider"`
+	Price     string `json:"price"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// UnmarshalProviderPrices decodes the provider prices carried in an envelope,
+// keyed by currency pair.
+func UnmarshalProviderPrices(bz []byte) (map[string][]ProviderPrice, error) {
+	var prices map[string][]ProviderPrice
+	if err := json.Unmarshal(bz, &prices); err != nil {
+		return nil, fmt.Errorf("failed to decode provider prices: %w", err)
+	}
+
+	return prices, nil
+}
@@ -0,0 +1,14 @@
+package utils
+
+// VerifiedReport is the identity of the enclave a verified report was
+// produced by.
+type VerifiedReport struct {
+	// SignerID is the enclave's signer ID (MRSIGNER).
+	SignerID []byte
+	// ProductID is the enclave's product ID.
+	ProductID uint16
+	// SecurityVersion is the enclave's security version number (SVN).
+	SecurityVersion uint
+	// TCBStatus is the TCB status of the platform the report was produced on.
+	TCBStatus string
+}
@@ -14,38 +14,93 @@ import (
 	"github.com/edgelesssys/ego/attestation"
 	"github.com/edgelesssys/ego/attestation/tcbstatus"
 	"github.com/edgelesssys/ego/eclient"
+
+	"github.com/facundomedica/rollinky/sequencer/utils/dcap"
 )
 
 // VerifyReport verifies the enclave report.
 func VerifyReport(reportBytes, certBytes, signer []byte) error {
+	_, err := VerifyRemoteReport(reportBytes, certBytes, [][]byte{signer})
+	return err
+}
+
+// VerifyRemoteReport verifies the enclave report, which must have been produced
+// by an enclave signed by one of signers, and returns the identity of the
+// enclave that produced it.
+func VerifyRemoteReport(reportBytes, certBytes []byte, signers [][]byte) (VerifiedReport, error) {
 	start := time.Now()
 	report, err := eclient.VerifyRemoteReport(reportBytes)
-	if err == attestation.ErrTCBLevelInvalid {
-		fmt.Printf("Warning: TCB level is invalid: %v\n%v\n", report.TCBStatus, tcbstatus.Explain(report.TCBStatus))
-		fmt.Println("We'll ignore this issue in this sample. For an app that should run in production, you must decide which of the different TCBStatus values are acceptable for you to continue.")
-	} else if err != nil {
-		return err
+	// a platform whose TCB level isn't up to date still produces a valid
+	// report, its status is returned for the caller to check.
+	if err != nil && !errors.Is(err, attestation.ErrTCBLevelInvalid) {
+		return VerifiedReport{}, err
+	}
+
+	if err := checkReport(report.Data, report.SecurityVersion, report.Debug, report.ProductID, report.SignerID, certBytes, signers); err != nil {
+		return VerifiedReport{}, err
+	}
+
+	fmt.Println("Verification took:", time.Since(start))
+
+	return newVerifiedReport(report.SignerID, report.ProductID, report.SecurityVersion, report.TCBStatus), nil
+}
+
+// VerifyReportWithCollateral verifies the enclave report against pinned DCAP
+// collateral at time now, without using the quote provider or the network.
+// Given the same inputs it always returns the same result, so it is safe to
+// use inside consensus with the block time.
+//
+// A report from a platform whose TCB level isn't up to date is returned with
+// its TCB status and no error: the caller must check the status against the
+// ones it accepts.
+func VerifyReportWithCollateral(reportBytes, certBytes []byte, signers [][]byte, collateral *dcap.Collateral, now time.Time) (VerifiedReport, error) {
+	report, err := dcap.Verify(reportBytes, collateral, now)
+	if err != nil && !errors.Is(err, dcap.ErrTCBLevelInvalid) {
+		return VerifiedReport{}, err
 	}
 
+	if err := checkReport(report.Data, report.SecurityVersion, report.Debug, report.ProductID, report.SignerID, certBytes, signers); err != nil {
+		return VerifiedReport{}, err
+	}
+
+	return newVerifiedReport(report.SignerID, report.ProductID, report.SecurityVersion, report.TCBStatus), nil
+}
+
+func newVerifiedReport(signerID, productID []byte, securityVersion uint, status tcbstatus.Status) VerifiedReport {
+	return VerifiedReport{
+		SignerID:        signerID,
+		ProductID:       binary.LittleEndian.Uint16(productID),
+		SecurityVersion: securityVersion,
+		TCBStatus:       status.String(),
+	}
+}
+
+// checkReport checks that a verified report is bound to certBytes and was
+// produced by one of the expected enclaves, which must not be a debug enclave.
+func checkReport(data []byte, securityVersion uint, debug bool, productID, signerID, certBytes []byte, signers [][]byte) error {
 	hash := sha256.Sum256(certBytes)
-	if !bytes.Equal(report.Data[:len(hash)], hash[:]) {
+	if !bytes.Equal(data[:len(hash)], hash[:]) {
 		return errors.New("report data does not match the certificate's hash")
 	}
 
 	// You can either verify the UniqueID or the tuple (SignerID, ProductID, SecurityVersion, Debug).
 
-	if report.SecurityVersion < 1 {
+	// the memory of a debug enclave can be read and written by the host, its
+	// reports prove nothing.
+	if debug {
+		return errors.New("report is from a debug enclave")
+	}
+	if securityVersion < 1 {
 		return errors.New("invalid security version")
 	}
-	if binary.LittleEndian.Uint16(report.ProductID) != 1 {
+	if binary.LittleEndian.Uint16(productID) != 1 {
 		return errors.New("invalid product")
 	}
-	if !bytes.Equal(report.SignerID, signer) {
-		return errors.New("invalid signer")
+	for _, signer := range signers {
+		if bytes.Equal(signerID, signer) {
+			return nil
+		}
 	}
 
-	fmt.Println("Verification took:", time.Since(start))
-	// For production, you must also verify that report.Debug == false
-
-	return nil
+	return errors.New("invalid signer")
 }
@@ -3,7 +3,28 @@
 
 package utils
 
+import (
+	"time"
+
+	"github.com/edgelesssys/ego/attestation/tcbstatus"
+
+	"github.com/facundomedica/rollinky/sequencer/utils/dcap"
+)
+
 // VerifyReport verifies the enclave report, this is a No-op
 func VerifyReport(reportBytes, certBytes, signer []byte) error {
 	return nil
 }
+
+// VerifyRemoteReport verifies the enclave report, this is a No-op returning
+// an empty identity
+func VerifyRemoteReport(reportBytes, certBytes []byte, signers [][]byte) (VerifiedReport, error) {
+	return VerifiedReport{}, nil
+}
+
+// VerifyReportWithCollateral verifies the enclave report against pinned DCAP
+// collateral, this is a No-op returning an empty identity on an up to date
+// platform
+func VerifyReportWithCollateral(reportBytes, certBytes []byte, signers [][]byte, collateral *dcap.Collateral, now time.Time) (VerifiedReport, error) {
+	return VerifiedReport{TCBStatus: tcbstatus.UpToDate.String()}, nil
+}
@@ -0,0 +1,27 @@
+//go:build !no_tee
+// +build !no_tee
+
+package utils
+
+import (
+	"crypto/sha256"
+	"strings"
+	"testing"
+)
+
+func TestCheckReport(t *testing.T) {
+	certBytes := []byte("report data")
+	hash := sha256.Sum256(certBytes)
+	data := append(hash[:], make([]byte, 32)...)
+	signer := []byte("signer")
+
+	if err := checkReport(data, 1, false, []byte{1, 0}, signer, certBytes, [][]byte{signer}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// a debug enclave is rejected even if it is signed by an expected signer.
+	err := checkReport(data, 1, true, []byte{1, 0}, signer, certBytes, [][]byte{signer})
+	if err == nil || !strings.Contains(err.Error(), "debug enclave") {
+		t.Errorf("expected debug enclave error, got %v", err)
+	}
+}
@@ -2,6 +2,10 @@ package utils
 
 import (
 	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -103,3 +107,204 @@ func TestDecodeErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvelope(t *testing.T) {
+	envelope := Envelope{
+		Prices:         []byte("prices"),
+		Report:         []byte("report"),
+		Nonce:          bytes.Repeat([]byte{0x01}, NonceSize),
+		Timestamp:      1700000000000000000,
+		ConfigDigest:   bytes.Repeat([]byte{0xaa}, ConfigDigestSize),
+		MarketMap:      []byte("markets"),
+		ProviderPrices: []byte("providers"),
+	}
+
+	got, err := UnmarshalEnvelope(envelope.Marshal())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, envelope) {
+		t.Errorf("envelope mismatch: got %+v, want %+v", got, envelope)
+	}
+
+	marketMapDigest := sha256.Sum256([]byte("markets"))
+	want := []byte("\x00\x00\x00\x06prices" +
+		"\x00\x00\x00\x20" + strings.Repeat("\x01", NonceSize) +
+		"\x00\x00\x00\x08\x17\x97\x9c\xfe\x36\x2a\x00\x00" +
+		"\x00\x00\x00\x20" + strings.Repeat("\xaa", ConfigDigestSize) +
+		"\x00\x00\x00\x20" + string(marketMapDigest[:]) +
+		"\x00\x00\x00\x09providers")
+	if !bytes.Equal(got.ReportData(), want) {
+		t.Errorf("report data mismatch: got %q, want %q", got.ReportData(), want)
+	}
+
+	// bytes moved from one bound field to another change the report data.
+	shifted := got
+	shifted.Prices = append(append([]byte{}, got.Prices...), got.Nonce[:1]...)
+	shifted.Nonce = got.Nonce[1:]
+	if bytes.Equal(shifted.ReportData(), want) {
+		t.Error("expected report data of shifted fields to differ")
+	}
+
+	// a nonce of another size is malformed.
+	short := envelope
+	short.Nonce = []byte("nonce")
+	if _, err := UnmarshalEnvelope(short.Marshal()); err == nil {
+		t.Error("expected error for short nonce, got nil")
+	}
+
+	// provider prices are optional.
+	envelope.ProviderPrices = nil
+	got, err = UnmarshalEnvelope(envelope.Marshal())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, envelope) {
+		t.Errorf("envelope mismatch: got %+v, want %+v", got, envelope)
+	}
+
+	// so is the market map, its digest is then empty.
+	envelope.MarketMap = nil
+	got, err = UnmarshalEnvelope(envelope.Marshal())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, envelope) {
+		t.Errorf("envelope mismatch: got %+v, want %+v", got, envelope)
+	}
+	if got.MarketMapDigest() != nil {
+		t.Errorf("expected no market map digest, got %x", got.MarketMapDigest())
+	}
+
+	// a truncated config digest is malformed.
+	envelope.ConfigDigest = []byte("digest")
+	if _, err := UnmarshalEnvelope(envelope.Marshal()); err == nil {
+		t.Error("expected error, got nil")
+	}
+
+	// an untagged payload, e.g. a user tx replaying an old envelope, is not an envelope.
+	if _, err := UnmarshalEnvelope(Encode([]byte("prices"), []byte("report"))); err != ErrNotEnvelope {
+		t.Errorf("expected ErrNotEnvelope, got %v", err)
+	}
+
+	// a tagged envelope without the attestation is malformed.
+	malformed := append(append([]byte{}, EnvelopeTag...), Encode([]byte("prices"), []byte("report"))...)
+	if _, err := UnmarshalEnvelope(malformed); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+func TestSignedEnvelope(t *testing.T) {
+	pubKey, key, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	envelope := Envelope{
+		Prices:         []byte("prices"),
+		Report:         []byte("report"),
+		Nonce:          bytes.Repeat([]byte{0x01}, NonceSize),
+		Timestamp:      1700000000000000000,
+		ConfigDigest:   bytes.Repeat([]byte{0xaa}, ConfigDigestSize),
+		MarketMap:      []byte("markets"),
+		ProviderPrices: []byte("providers"),
+	}
+	envelope.Sign(key, "rollinky", 42)
+
+	data := envelope.Marshal()
+	if !bytes.HasPrefix(data, SignedEnvelopeTag) {
+		t.Fatalf("signed envelope not tagged as signed: %q", data[:len(SignedEnvelopeTag)])
+	}
+
+	got, err := UnmarshalEnvelope(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, envelope) {
+		t.Errorf("envelope mismatch: got %+v, want %+v", got, envelope)
+	}
+	if !got.VerifySignature(pubKey, "rollinky") {
+		t.Error("expected signature to verify")
+	}
+
+	// the signature covers the chain ID, the height and the prices.
+	if got.VerifySignature(pubKey, "other-chain") {
+		t.Error("expected signature for another chain to fail")
+	}
+	replayed := got
+	replayed.Height = 43
+	if replayed.VerifySignature(pubKey, "rollinky") {
+		t.Error("expected signature over another height to fail")
+	}
+	tampered := got
+	tampered.Prices = []byte("other prices")
+	if tampered.VerifySignature(pubKey, "rollinky") {
+		t.Error("expected signature over other prices to fail")
+	}
+
+	// another key didn't sign it.
+	otherPubKey, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.VerifySignature(otherPubKey, "rollinky") {
+		t.Error("expected signature to fail with another key")
+	}
+
+	// an unsigned envelope never verifies.
+	unsigned, err := UnmarshalEnvelope(Envelope{Prices: []byte("prices"), Nonce: envelope.Nonce, Report: []byte("report")}.Marshal())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unsigned.VerifySignature(pubKey, "rollinky") {
+		t.Error("expected unsigned envelope to fail")
+	}
+
+	// a signed envelope must carry a signature.
+	envelope.Signature = []byte{}
+	if _, err := UnmarshalEnvelope(envelope.Marshal()); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+func TestIsEnvelopeShaped(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want bool
+	}{
+		{
+			name: "tagged envelope",
+			data: Envelope{Prices: []byte("prices"), Report: []byte("report")}.Marshal(),
+			want: true,
+		},
+		{
+			name: "signed envelope",
+			data: Envelope{Prices: []byte("prices"), Report: []byte("report"), Signature: []byte("signature")}.Marshal(),
+			want: true,
+		},
+		{
+			name: "malformed tagged envelope",
+			data: append(append([]byte{}, EnvelopeTag...), 1, 2, 3),
+			want: true,
+		},
+		{
+			name: "untagged envelope",
+			data: Encode([]byte("prices"), []byte("report")),
+			want: true,
+		},
+		{
+			name: "protobuf tx",
+			data: []byte{0x0a, 0x02, 0x08, 0x01, 0x12, 0x00, 0x1a, 0x00},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsEnvelopeShaped(tt.data); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// MockOracleKeeper returns a fixed list of currency pairs and their prices.
+type MockOracleKeeper struct {
+	CurrencyPairs []connecttypes.CurrencyPair
+	Prices        map[connecttypes.CurrencyPair]oracletypes.QuotePrice
+}
+
+func (m *MockOracleKeeper) GetAllCurrencyPairs(context.Context) []connecttypes.CurrencyPair {
+	return m.CurrencyPairs
+}
+
+func (m *MockOracleKeeper) GetPriceForCurrencyPair(_ context.Context, cp connecttypes.CurrencyPair) (oracletypes.QuotePrice, error) {
+	price, ok := m.Prices[cp]
+	if !ok {
+		return oracletypes.QuotePrice{}, oracletypes.QuotePriceNotExistError{}
+	}
+	return price, nil
+}
+
+// MockMarketMapKeeper returns the markets of the map by ticker.
+type MockMarketMapKeeper map[string]mmtypes.Market
+
+func (m MockMarketMapKeeper) GetMarket(_ context.Context, tickerStr string) (mmtypes.Market, error) {
+	market, ok := m[tickerStr]
+	if !ok {
+		return mmtypes.Market{}, fmt.Errorf("market not found for ticker %s", tickerStr)
+	}
+	return market, nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/oraclecircuit/keeper"
+	"rollinky/x/oraclecircuit/types"
+)
+
+// MockCircuitKeeper keeps the disabled messages of the circuit breaker in
+// memory.
+type MockCircuitKeeper struct {
+	Disabled map[string]bool
+}
+
+func NewMockCircuitKeeper() *MockCircuitKeeper {
+	return &MockCircuitKeeper{Disabled: make(map[string]bool)}
+}
+
+func (m *MockCircuitKeeper) IsAllowed(_ context.Context, msgURL string) (bool, error) {
+	return !m.Disabled[msgURL], nil
+}
+
+func (m *MockCircuitKeeper) DisableMsg(_ context.Context, msgURL string) error {
+	m.Disabled[msgURL] = true
+	return nil
+}
+
+func (m *MockCircuitKeeper) EnableMsg(_ context.Context, msgURL string) error {
+	delete(m.Disabled, msgURL)
+	return nil
+}
+
+func OraclecircuitKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return OraclecircuitKeeperWithMocks(t, NewMockCircuitKeeper(), &MockOracleKeeper{})
+}
+
+func OraclecircuitKeeperWithMocks(
+	t testing.TB,
+	circuitKeeper types.CircuitKeeper,
+	oracleKeeper types.OracleKeeper,
+) (keeper.Keeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := newStoreContext(t, storeKey)
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		govAuthority,
+		circuitKeeper,
+		oracleKeeper,
+	)
+
+	// Initialize params
+	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	return k, ctx
+}
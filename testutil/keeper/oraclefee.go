@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/oraclefee/keeper"
+	"rollinky/x/oraclefee/types"
+)
+
+func OraclefeeKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return OraclefeeKeeperWithMocks(t, &MockOracleKeeper{}, MockMarketMapKeeper{})
+}
+
+func OraclefeeKeeperWithMocks(
+	t testing.TB,
+	oracleKeeper types.OracleKeeper,
+	marketMapKeeper types.MarketMapKeeper,
+) (keeper.Keeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := newStoreContext(t, storeKey)
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		govAuthority,
+		oracleKeeper,
+		marketMapKeeper,
+	)
+
+	// Initialize params
+	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	return k, ctx
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// UpdateParamsCase is a params update sent by the authority. It must fail
+// with ExpErrMsg if set, and succeed otherwise.
+type UpdateParamsCase[P any] struct {
+	Name      string
+	Params    P
+	ExpErrMsg string
+}
+
+// CheckMsgUpdateParams checks that update, which sends a MsgUpdateParams,
+// rejects any signer but authority, then runs the cases. A rejected update
+// must leave the params read with get as they were.
+func CheckMsgUpdateParams[P any](
+	t *testing.T,
+	authority string,
+	update func(authority string, params P) error,
+	get func() P,
+	cases []UpdateParamsCase[P],
+) {
+	t.Run("invalid authority", func(t *testing.T) {
+		err := update("invalid", get())
+		require.ErrorContains(t, err, "invalid authority")
+	})
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			before := get()
+			err := update(authority, tc.Params)
+			if tc.ExpErrMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.ExpErrMsg)
+			require.Equal(t, before, get())
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"rollinky/x/pricealert/keeper"
+	"rollinky/x/pricealert/types"
+)
+
+// MockBankKeeper keeps the balances of accounts and modules in memory, by
+// address and by module name.
+type MockBankKeeper struct {
+	Balances map[string]sdk.Coins
+}
+
+func NewMockBankKeeper() *MockBankKeeper {
+	return &MockBankKeeper{Balances: make(map[string]sdk.Coins)}
+}
+
+func (m *MockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.Balances[addr.String()]
+}
+
+func (m *MockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return m.send(senderAddr.String(), recipientModule, amt)
+}
+
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.send(senderModule, recipientAddr.String(), amt)
+}
+
+func (m *MockBankKeeper) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return m.send(senderModule, recipientModule, amt)
+}
+
+func (m *MockBankKeeper) send(from, to string, amt sdk.Coins) error {
+	balance, anyNeg := m.Balances[from].SafeSub(amt...)
+	if anyNeg {
+		return fmt.Errorf("insufficient funds: %s < %s", m.Balances[from], amt)
+	}
+	m.Balances[from] = balance
+	m.Balances[to] = m.Balances[to].Add(amt...)
+	return nil
+}
+
+// MockAuthzKeeper records the messages dispatched, consuming Gas for them,
+// and fails them with Err when set.
+type MockAuthzKeeper struct {
+	Dispatched [][]sdk.Msg
+	Gas        uint64
+	Err        error
+}
+
+func (m *MockAuthzKeeper) DispatchActions(ctx context.Context, _ sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(m.Gas, "dispatch actions")
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	m.Dispatched = append(m.Dispatched, msgs)
+	return make([][]byte, len(msgs)), nil
+}
+
+func PricealertKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return PricealertKeeperWithMocks(t, NewMockBankKeeper(), &MockAuthzKeeper{})
+}
+
+func PricealertKeeperWithMocks(
+	t testing.TB,
+	bankKeeper types.BankKeeper,
+	authzKeeper types.AuthzKeeper,
+) (keeper.Keeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := newStoreContext(t, storeKey)
+
+	// the signers of the alert messages are resolved from the registry.
+	registry := codectestutil.CodecOptions{}.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		govAuthority,
+		bankKeeper,
+		authzKeeper,
+	)
+
+	// Initialize params
+	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	return k, ctx
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+// govAuthority is the authority of the module keepers, the gov module
+// account.
+var govAuthority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+// newStoreContext mounts an in-memory store under storeKey and returns a
+// context on it.
+func newStoreContext(t testing.TB, storeKey *storetypes.KVStoreKey) sdk.Context {
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	return sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/attestation/types"
+)
+
+// SetAttestationRecord set a specific attestationRecord in the store from its index
+func (k Keeper) SetAttestationRecord(ctx context.Context, attestationRecord types.AttestationRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AttestationRecordKeyPrefix))
+	b := k.cdc.MustMarshal(&attestationRecord)
+	store.Set(types.AttestationRecordKey(
+		attestationRecord.BlockHeight,
+	), b)
+}
+
+// GetAttestationRecord returns a attestationRecord from its index
+func (k Keeper) GetAttestationRecord(
+	ctx context.Context,
+	blockHeight uint64,
+) (val types.AttestationRecord, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AttestationRecordKeyPrefix))
+
+	b := store.Get(types.AttestationRecordKey(
+		blockHeight,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAttestationRecord removes a attestationRecord from the store
+func (k Keeper) RemoveAttestationRecord(
+	ctx context.Context,
+	blockHeight uint64,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AttestationRecordKeyPrefix))
+	store.Delete(types.AttestationRecordKey(
+		blockHeight,
+	))
+}
+
+// GetAllAttestationRecord returns all attestationRecord
+func (k Keeper) GetAllAttestationRecord(ctx context.Context) (list []types.AttestationRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AttestationRecordKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AttestationRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// PruneAttestationRecords removes the attestation records older than the
+// attestation record retention, counted back from the current height.
+func (k Keeper) PruneAttestationRecords(ctx context.Context) {
+	retention := k.GetParams(ctx).AttestationRecordRetention
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec
+	if retention == 0 || height < retention {
+		return
+	}
+
+	// records are keyed by big endian height, so the expired ones come first.
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AttestationRecordKeyPrefix))
+	iterator := store.Iterator(nil, types.AttestationRecordKey(height-retention+1))
+
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expired = append(expired, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/testutil/nullify"
+	"rollinky/x/attestation/keeper"
+	"rollinky/x/attestation/types"
+)
+
+func createNAttestationRecord(keeper keeper.Keeper, ctx context.Context, n int) []types.AttestationRecord {
+	items := make([]types.AttestationRecord, n)
+	for i := range items {
+		items[i].BlockHeight = uint64(i + 1)
+		items[i].TcbStatus = "UpToDate"
+		keeper.SetAttestationRecord(ctx, items[i])
+	}
+	return items
+}
+
+func TestAttestationRecordGet(t *testing.T) {
+	keeper, ctx := keepertest.AttestationKeeper(t)
+	items := createNAttestationRecord(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetAttestationRecord(ctx,
+			item.BlockHeight,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestAttestationRecordRemove(t *testing.T) {
+	keeper, ctx := keepertest.AttestationKeeper(t)
+	items := createNAttestationRecord(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveAttestationRecord(ctx,
+			item.BlockHeight,
+		)
+		_, found := keeper.GetAttestationRecord(ctx,
+			item.BlockHeight,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestAttestationRecordGetAll(t *testing.T) {
+	keeper, ctx := keepertest.AttestationKeeper(t)
+	items := createNAttestationRecord(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllAttestationRecord(ctx)),
+	)
+}
+
+func TestPruneAttestationRecords(t *testing.T) {
+	k, ctx := keepertest.AttestationKeeper(t)
+	items := createNAttestationRecord(k, ctx, 300)
+
+	// nothing is pruned without a retention.
+	ctx = sdk.UnwrapSDKContext(ctx).WithBlockHeight(300)
+	k.PruneAttestationRecords(ctx)
+	require.Len(t, k.GetAllAttestationRecord(ctx), 300)
+
+	params := types.DefaultParams()
+	params.AttestationRecordRetention = 100
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// heights are compared numerically, not as strings.
+	k.PruneAttestationRecords(ctx)
+	require.Equal(t,
+		nullify.Fill(items[200:]),
+		nullify.Fill(k.GetAllAttestationRecord(ctx)),
+	)
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"rollinky/x/attestation/types"
+)
+
+// SetCollateral pins the JSON encoded DCAP collateral enclave reports are
+// verified against.
+func (k Keeper) SetCollateral(ctx context.Context, collateral []byte) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.CollateralKey, collateral)
+}
+
+// GetCollateral returns the pinned JSON encoded DCAP collateral, nil if none is
+// pinned.
+func (k Keeper) GetCollateral(ctx context.Context) []byte {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return store.Get(types.CollateralKey)
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"rollinky/x/attestation/types"
+)
+
+// ValidateConfigDigest checks the sidecar config digest attested alongside a
+// block's prices. If the chain requires an approved config, the digest must be
+// one of the approved digests.
+func (k Keeper) ValidateConfigDigest(ctx context.Context, digest []byte) error {
+	params := k.GetParams(ctx)
+	if !params.RequireApprovedConfig {
+		return nil
+	}
+
+	if !params.IsApprovedConfigDigest(digest) {
+		return errorsmod.Wrapf(types.ErrConfigNotApproved, "config digest %x", digest)
+	}
+
+	return nil
+}
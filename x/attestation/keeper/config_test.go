@@ -0,0 +1,27 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/x/attestation/types"
+)
+
+func TestValidateConfigDigest(t *testing.T) {
+	k, ctx := keepertest.AttestationKeeper(t)
+
+	approved := sha256.Sum256([]byte("approved"))
+	other := sha256.Sum256([]byte("other"))
+
+	// nothing is enforced by default.
+	require.NoError(t, k.ValidateConfigDigest(ctx, other[:]))
+
+	require.NoError(t, k.SetParams(ctx, types.NewParams([]string{hex.EncodeToString(approved[:])}, true, types.DefaultMaxPriceFailureRatio, types.DefaultAttestationRecordRetention, types.DefaultSequencerPublicKey, types.DefaultMaxProviderPriceAge, types.DefaultAllowedTCBStatuses, types.DefaultMaxReportAge, types.DefaultFailMissingPrices)))
+	require.NoError(t, k.ValidateConfigDigest(ctx, approved[:]))
+	require.ErrorIs(t, k.ValidateConfigDigest(ctx, other[:]), types.ErrConfigNotApproved)
+	require.ErrorIs(t, k.ValidateConfigDigest(ctx, nil), types.ErrConfigNotApproved)
+}
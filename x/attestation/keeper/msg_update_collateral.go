@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/attestation/types"
+)
+
+func (k msgServer) UpdateCollateral(goCtx context.Context, req *types.MsgUpdateCollateral) (*types.MsgUpdateCollateralResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if err := types.ValidateCollateral(req.Collateral); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetCollateral(ctx, req.Collateral)
+
+	return &types.MsgUpdateCollateralResponse{}, nil
+}
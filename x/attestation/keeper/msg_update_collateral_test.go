@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"rollinky/x/attestation/types"
+)
+
+func TestMsgUpdateCollateral(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+	collateral := []byte(`{"crls": []}`)
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateCollateral
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgUpdateCollateral{
+				Authority:  "invalid",
+				Collateral: collateral,
+			},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "empty collateral",
+			input: &types.MsgUpdateCollateral{
+				Authority: k.GetAuthority(),
+			},
+			expErrMsg: "collateral is empty",
+		},
+		{
+			name: "malformed collateral",
+			input: &types.MsgUpdateCollateral{
+				Authority:  k.GetAuthority(),
+				Collateral: []byte(`{"crls": ["not a crl"]}`),
+			},
+			expErrMsg: "invalid CRL 0",
+		},
+		{
+			name: "all good",
+			input: &types.MsgUpdateCollateral{
+				Authority:  k.GetAuthority(),
+				Collateral: collateral,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.UpdateCollateral(wctx, tc.input)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, collateral, k.GetCollateral(wctx))
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/x/attestation/types"
+)
+
+func TestGetParams(t *testing.T) {
+	k, ctx := keepertest.AttestationKeeper(t)
+	params := types.DefaultParams()
+
+	require.NoError(t, k.SetParams(ctx, params))
+	require.EqualValues(t, params, k.GetParams(ctx))
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"rollinky/x/attestation/types"
+)
+
+// SetPriceDispersion set a specific priceDispersion in the store from its index
+func (k Keeper) SetPriceDispersion(ctx context.Context, priceDispersion types.PriceDispersion) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceDispersionKeyPrefix))
+	b := k.cdc.MustMarshal(&priceDispersion)
+	store.Set(types.PriceDispersionKey(
+		priceDispersion.CurrencyPair,
+	), b)
+}
+
+// GetPriceDispersion returns a priceDispersion from its index
+func (k Keeper) GetPriceDispersion(
+	ctx context.Context,
+	currencyPair string,
+) (val types.PriceDispersion, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceDispersionKeyPrefix))
+
+	b := store.Get(types.PriceDispersionKey(
+		currencyPair,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePriceDispersion removes a priceDispersion from the store
+func (k Keeper) RemovePriceDispersion(
+	ctx context.Context,
+	currencyPair string,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceDispersionKeyPrefix))
+	store.Delete(types.PriceDispersionKey(
+		currencyPair,
+	))
+}
+
+// GetAllPriceDispersion returns all priceDispersion
+func (k Keeper) GetAllPriceDispersion(ctx context.Context) (list []types.PriceDispersion) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceDispersionKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PriceDispersion
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
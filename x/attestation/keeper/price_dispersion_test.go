@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/testutil/nullify"
+	"rollinky/x/attestation/keeper"
+	"rollinky/x/attestation/types"
+)
+
+func createNPriceDispersion(keeper keeper.Keeper, ctx context.Context, n int) []types.PriceDispersion {
+	items := make([]types.PriceDispersion, n)
+	for i := range items {
+		items[i].CurrencyPair = "TOKEN" + strconv.Itoa(i) + "/USD"
+		items[i].ProviderCount = uint32(i + 1)
+		keeper.SetPriceDispersion(ctx, items[i])
+	}
+	return items
+}
+
+func TestPriceDispersionGet(t *testing.T) {
+	keeper, ctx := keepertest.AttestationKeeper(t)
+	items := createNPriceDispersion(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetPriceDispersion(ctx,
+			item.CurrencyPair,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestPriceDispersionRemove(t *testing.T) {
+	keeper, ctx := keepertest.AttestationKeeper(t)
+	items := createNPriceDispersion(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemovePriceDispersion(ctx,
+			item.CurrencyPair,
+		)
+		_, found := keeper.GetPriceDispersion(ctx,
+			item.CurrencyPair,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestPriceDispersionGetAll(t *testing.T) {
+	keeper, ctx := keepertest.AttestationKeeper(t)
+	items := createNPriceDispersion(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllPriceDispersion(ctx)),
+	)
+}
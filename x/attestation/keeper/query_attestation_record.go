@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rollinky/x/attestation/types"
+)
+
+func (k Keeper) AttestationRecordAll(ctx context.Context, req *types.QueryAllAttestationRecordRequest) (*types.QueryAllAttestationRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var attestationRecords []types.AttestationRecord
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	attestationRecordStore := prefix.NewStore(store, types.KeyPrefix(types.AttestationRecordKeyPrefix))
+
+	pageRes, err := query.Paginate(attestationRecordStore, req.Pagination, func(key []byte, value []byte) error {
+		var attestationRecord types.AttestationRecord
+		if err := k.cdc.Unmarshal(value, &attestationRecord); err != nil {
+			return err
+		}
+
+		attestationRecords = append(attestationRecords, attestationRecord)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAttestationRecordResponse{AttestationRecord: attestationRecords, Pagination: pageRes}, nil
+}
+
+func (k Keeper) AttestationAtHeight(ctx context.Context, req *types.QueryAttestationAtHeightRequest) (*types.QueryAttestationAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetAttestationRecord(
+		ctx,
+		req.BlockHeight,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryAttestationAtHeightResponse{AttestationRecord: val}, nil
+}
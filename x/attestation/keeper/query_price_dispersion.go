@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rollinky/x/attestation/types"
+)
+
+func (k Keeper) PriceDispersionAll(ctx context.Context, req *types.QueryAllPriceDispersionRequest) (*types.QueryAllPriceDispersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var priceDispersions []types.PriceDispersion
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	priceDispersionStore := prefix.NewStore(store, types.KeyPrefix(types.PriceDispersionKeyPrefix))
+
+	pageRes, err := query.Paginate(priceDispersionStore, req.Pagination, func(key []byte, value []byte) error {
+		var priceDispersion types.PriceDispersion
+		if err := k.cdc.Unmarshal(value, &priceDispersion); err != nil {
+			return err
+		}
+
+		priceDispersions = append(priceDispersions, priceDispersion)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPriceDispersionResponse{PriceDispersion: priceDispersions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PriceDispersion(ctx context.Context, req *types.QueryGetPriceDispersionRequest) (*types.QueryGetPriceDispersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetPriceDispersion(
+		ctx,
+		req.CurrencyPair,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPriceDispersionResponse{PriceDispersion: val}, nil
+}
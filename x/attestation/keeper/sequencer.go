@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/attestation/types"
+)
+
+// ValidateSequencerSignature checks that an oracle envelope was built by the
+// chain's sequencer: signed with the sequencer key for a height above the last
+// accepted one and not above the current block's. If no sequencer key is set,
+// any envelope is accepted.
+func (k Keeper) ValidateSequencerSignature(ctx context.Context, signBytes, signature []byte, height uint64) error {
+	pubKey := k.GetParams(ctx).SequencerPubKey()
+	if pubKey == nil {
+		return nil
+	}
+
+	if len(signature) == 0 {
+		return errorsmod.Wrap(types.ErrInvalidSequencerSignature, "envelope is not signed")
+	}
+	if !ed25519.Verify(pubKey, signBytes, signature) {
+		return errorsmod.Wrapf(types.ErrInvalidSequencerSignature, "envelope not signed by sequencer %x", []byte(pubKey))
+	}
+
+	// a signed envelope replayed in a later block carries a height that was
+	// already accepted.
+	if last := k.GetLastSequencerHeight(ctx); height <= last {
+		return errorsmod.Wrapf(types.ErrInvalidSequencerHeight, "height %d is not above the last accepted height %d", height, last)
+	}
+	if blockHeight := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()); height > blockHeight { //nolint:gosec
+		return errorsmod.Wrapf(types.ErrInvalidSequencerHeight, "height %d is above the block height %d", height, blockHeight)
+	}
+
+	return nil
+}
+
+// SetLastSequencerHeight sets the height of the last accepted sequencer signed
+// envelope.
+func (k Keeper) SetLastSequencerHeight(ctx context.Context, height uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.LastSequencerHeightKey, binary.BigEndian.AppendUint64(nil, height))
+}
+
+// GetLastSequencerHeight returns the height of the last accepted sequencer
+// signed envelope, zero if none was accepted.
+func (k Keeper) GetLastSequencerHeight(ctx context.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.LastSequencerHeightKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
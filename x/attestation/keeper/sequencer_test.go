@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/x/attestation/types"
+)
+
+func TestValidateSequencerSignature(t *testing.T) {
+	k, ctx := keepertest.AttestationKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	pubKey, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	_, otherKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	signBytes := []byte("envelope")
+	signature := ed25519.Sign(key, signBytes)
+
+	// nothing is enforced by default.
+	require.NoError(t, k.ValidateSequencerSignature(ctx, signBytes, nil, 0))
+
+	params := types.DefaultParams()
+	params.SequencerPublicKey = hex.EncodeToString(pubKey)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	require.NoError(t, k.ValidateSequencerSignature(ctx, signBytes, signature, 10))
+	require.ErrorIs(t, k.ValidateSequencerSignature(ctx, signBytes, nil, 10), types.ErrInvalidSequencerSignature)
+	require.ErrorIs(t, k.ValidateSequencerSignature(ctx, signBytes, ed25519.Sign(otherKey, signBytes), 10), types.ErrInvalidSequencerSignature)
+	require.ErrorIs(t, k.ValidateSequencerSignature(ctx, []byte("other envelope"), signature, 10), types.ErrInvalidSequencerSignature)
+
+	// heights above the block's can't be claimed.
+	require.ErrorIs(t, k.ValidateSequencerSignature(ctx, signBytes, signature, 11), types.ErrInvalidSequencerHeight)
+
+	// heights already accepted can't be replayed.
+	k.SetLastSequencerHeight(ctx, 8)
+	require.Equal(t, uint64(8), k.GetLastSequencerHeight(ctx))
+	require.ErrorIs(t, k.ValidateSequencerSignature(ctx, signBytes, signature, 8), types.ErrInvalidSequencerHeight)
+	require.NoError(t, k.ValidateSequencerSignature(ctx, signBytes, signature, 9))
+}
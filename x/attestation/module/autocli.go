@@ -0,0 +1,61 @@
+package attestation
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "rollinky/api/rollinky/attestation"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "PriceDispersionAll",
+					Use:       "list-price-dispersion",
+					Short:     "List all price dispersions",
+				},
+				{
+					RpcMethod:      "PriceDispersion",
+					Use:            "show-price-dispersion [currency-pair]",
+					Short:          "Shows the provider price dispersion of a currency pair",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "currency_pair"}},
+				},
+				{
+					RpcMethod: "AttestationRecordAll",
+					Use:       "list-attestation-record",
+					Short:     "List all attestation records",
+				},
+				{
+					RpcMethod:      "AttestationAtHeight",
+					Use:            "at-height [block-height]",
+					Short:          "Shows the attestation behind the prices written at a height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "block_height"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "UpdateCollateral",
+					Skip:      true, // skipped because authority gated
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
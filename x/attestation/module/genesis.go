@@ -0,0 +1,42 @@
+package attestation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/attestation/keeper"
+	"rollinky/x/attestation/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the priceDispersion
+	for _, elem := range genState.PriceDispersionList {
+		k.SetPriceDispersion(ctx, elem)
+	}
+	// Set all the attestationRecord
+	for _, elem := range genState.AttestationRecordList {
+		k.SetAttestationRecord(ctx, elem)
+	}
+	k.SetLastSequencerHeight(ctx, genState.LastSequencerHeight)
+	if len(genState.Collateral) > 0 {
+		k.SetCollateral(ctx, genState.Collateral)
+	}
+	// this line is used by starport scaffolding # genesis/module/init
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	genesis.PriceDispersionList = k.GetAllPriceDispersion(ctx)
+	genesis.AttestationRecordList = k.GetAllAttestationRecord(ctx)
+	genesis.LastSequencerHeight = k.GetLastSequencerHeight(ctx)
+	genesis.Collateral = k.GetCollateral(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
@@ -0,0 +1,51 @@
+package attestation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/testutil/nullify"
+	attestation "rollinky/x/attestation/module"
+	"rollinky/x/attestation/types"
+)
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+
+		PriceDispersionList: []types.PriceDispersion{
+			{
+				CurrencyPair: "BTC/USD",
+			},
+			{
+				CurrencyPair: "ETH/USD",
+			},
+		},
+		AttestationRecordList: []types.AttestationRecord{
+			{
+				BlockHeight: 1,
+			},
+			{
+				BlockHeight: 2,
+			},
+		},
+		LastSequencerHeight: 7,
+		Collateral:          []byte(`{"crls": []}`),
+		// this line is used by starport scaffolding # genesis/test/state
+	}
+
+	k, ctx := keepertest.AttestationKeeper(t)
+	attestation.InitGenesis(ctx, k, genesisState)
+	got := attestation.ExportGenesis(ctx, k)
+	require.NotNil(t, got)
+
+	nullify.Fill(&genesisState)
+	nullify.Fill(got)
+
+	require.ElementsMatch(t, genesisState.PriceDispersionList, got.PriceDispersionList)
+	require.ElementsMatch(t, genesisState.AttestationRecordList, got.AttestationRecordList)
+	require.Equal(t, genesisState.LastSequencerHeight, got.LastSequencerHeight)
+	require.Equal(t, genesisState.Collateral, got.Collateral)
+	// this line is used by starport scaffolding # genesis/test/assert
+}
@@ -0,0 +1,702 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollinky/attestation/attestation_record.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AttestationRecord records the enclave report a block's prices were verified
+// against, so that it can later be proven which enclave produced the prices
+// written at a height.
+type AttestationRecord struct {
+	// block_height is the height the prices were written at.
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// report_hash is the hex encoded SHA-256 hash of the enclave report.
+	ReportHash string `protobuf:"bytes,2,opt,name=report_hash,json=reportHash,proto3" json:"report_hash,omitempty"`
+	// signer_id is the hex encoded signer ID (MRSIGNER) of the enclave.
+	SignerId string `protobuf:"bytes,3,opt,name=signer_id,json=signerId,proto3" json:"signer_id,omitempty"`
+	// product_id is the product ID of the enclave.
+	ProductId uint32 `protobuf:"varint,4,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// security_version is the security version number (SVN) of the enclave.
+	SecurityVersion uint64 `protobuf:"varint,5,opt,name=security_version,json=securityVersion,proto3" json:"security_version,omitempty"`
+	// tcb_status is the TCB status of the platform the report was produced on.
+	TcbStatus string `protobuf:"bytes,6,opt,name=tcb_status,json=tcbStatus,proto3" json:"tcb_status,omitempty"`
+	// prices_hash is the hex encoded SHA-256 hash of the prices the report
+	// attested, as returned by the sidecar.
+	PricesHash string `protobuf:"bytes,7,opt,name=prices_hash,json=pricesHash,proto3" json:"prices_hash,omitempty"`
+	// config_digest is the hex encoded digest of the sidecar config the report
+	// attested.
+	ConfigDigest string `protobuf:"bytes,8,opt,name=config_digest,json=configDigest,proto3" json:"config_digest,omitempty"`
+	// market_map_digest is the hex encoded SHA-256 digest of the market map the
+	// report attested the prices were aggregated with.
+	MarketMapDigest string `protobuf:"bytes,9,opt,name=market_map_digest,json=marketMapDigest,proto3" json:"market_map_digest,omitempty"`
+}
+
+func (m *AttestationRecord) Reset()         { *m = AttestationRecord{} }
+func (m *AttestationRecord) String() string { return proto.CompactTextString(m) }
+func (*AttestationRecord) ProtoMessage()    {}
+func (*AttestationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26cd762087875af1, []int{0}
+}
+func (m *AttestationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationRecord.Merge(m, src)
+}
+func (m *AttestationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationRecord proto.InternalMessageInfo
+
+func (m *AttestationRecord) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *AttestationRecord) GetReportHash() string {
+	if m != nil {
+		return m.ReportHash
+	}
+	return ""
+}
+
+func (m *AttestationRecord) GetSignerId() string {
+	if m != nil {
+		return m.SignerId
+	}
+	return ""
+}
+
+func (m *AttestationRecord) GetProductId() uint32 {
+	if m != nil {
+		return m.ProductId
+	}
+	return 0
+}
+
+func (m *AttestationRecord) GetSecurityVersion() uint64 {
+	if m != nil {
+		return m.SecurityVersion
+	}
+	return 0
+}
+
+func (m *AttestationRecord) GetTcbStatus() string {
+	if m != nil {
+		return m.TcbStatus
+	}
+	return ""
+}
+
+func (m *AttestationRecord) GetPricesHash() string {
+	if m != nil {
+		return m.PricesHash
+	}
+	return ""
+}
+
+func (m *AttestationRecord) GetConfigDigest() string {
+	if m != nil {
+		return m.ConfigDigest
+	}
+	return ""
+}
+
+func (m *AttestationRecord) GetMarketMapDigest() string {
+	if m != nil {
+		return m.MarketMapDigest
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AttestationRecord)(nil), "rollinky.attestation.AttestationRecord")
+}
+
+func init() {
+	proto.RegisterFile("rollinky/attestation/attestation_record.proto", fileDescriptor_26cd762087875af1)
+}
+
+var fileDescriptor_26cd762087875af1 = []byte{
+	// 327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xb1, 0x4e, 0x02, 0x31,
+	0x18, 0x80, 0x29, 0x22, 0x72, 0x05, 0x82, 0x34, 0x0e, 0x97, 0xa8, 0x27, 0xea, 0x82, 0x26, 0xe2,
+	0x60, 0xe2, 0xae, 0x71, 0x80, 0xc1, 0xe5, 0x4c, 0x1c, 0x5c, 0x9a, 0x5e, 0xaf, 0xde, 0x35, 0xc0,
+	0xb5, 0x69, 0x7f, 0x8c, 0xbc, 0x85, 0x8f, 0xe5, 0x26, 0xa3, 0xa3, 0x81, 0x17, 0x31, 0xd7, 0x8a,
+	0xe2, 0x76, 0xf7, 0xfd, 0x5f, 0xdb, 0x2f, 0xf9, 0xf1, 0x85, 0x51, 0x93, 0x89, 0x2c, 0xc6, 0xf3,
+	0x4b, 0x06, 0x20, 0x2c, 0x30, 0x90, 0xaa, 0xd8, 0xfc, 0xa6, 0x46, 0x70, 0x65, 0xd2, 0x81, 0x36,
+	0x0a, 0x14, 0xd9, 0x5b, 0xeb, 0x83, 0x0d, 0xe5, 0xe4, 0xa3, 0x8a, 0xbb, 0x37, 0x7f, 0xff, 0xb1,
+	0x3b, 0x41, 0x8e, 0x71, 0x2b, 0x99, 0x28, 0x3e, 0xa6, 0xb9, 0x90, 0x59, 0x0e, 0x21, 0xea, 0xa1,
+	0x7e, 0x2d, 0x6e, 0x3a, 0x36, 0x74, 0x88, 0x1c, 0xe1, 0xa6, 0x11, 0x5a, 0x19, 0xa0, 0x39, 0xb3,
+	0x79, 0x58, 0xed, 0xa1, 0x7e, 0x10, 0x63, 0x8f, 0x86, 0xcc, 0xe6, 0x64, 0x1f, 0x07, 0x56, 0x66,
+	0x85, 0x30, 0x54, 0xa6, 0xe1, 0x96, 0x1b, 0x37, 0x3c, 0x18, 0xa5, 0xe4, 0x10, 0x63, 0x6d, 0x54,
+	0x3a, 0xe3, 0x50, 0x4e, 0x6b, 0x3d, 0xd4, 0x6f, 0xc7, 0xc1, 0x0f, 0x19, 0xa5, 0xe4, 0x0c, 0xef,
+	0x5a, 0xc1, 0x67, 0x46, 0xc2, 0x9c, 0xbe, 0x08, 0x63, 0xa5, 0x2a, 0xc2, 0x6d, 0xd7, 0xd0, 0x59,
+	0xf3, 0x47, 0x8f, 0xcb, 0x9b, 0x80, 0x27, 0xb4, 0xec, 0x9f, 0xd9, 0xb0, 0xee, 0xde, 0x09, 0x80,
+	0x27, 0x0f, 0x0e, 0x94, 0x99, 0xda, 0x48, 0x2e, 0xac, 0xcf, 0xdc, 0xf1, 0x99, 0x1e, 0xb9, 0xcc,
+	0x53, 0xdc, 0xe6, 0xaa, 0x78, 0x96, 0x19, 0x4d, 0x65, 0x26, 0x2c, 0x84, 0x0d, 0xa7, 0xb4, 0x3c,
+	0xbc, 0x73, 0x8c, 0x9c, 0xe3, 0xee, 0x94, 0x99, 0xb1, 0x00, 0x3a, 0x65, 0x7a, 0x2d, 0x06, 0x4e,
+	0xec, 0xf8, 0xc1, 0x3d, 0xd3, 0xde, 0xbd, 0xbd, 0x7e, 0x5f, 0x46, 0x68, 0xb1, 0x8c, 0xd0, 0xd7,
+	0x32, 0x42, 0x6f, 0xab, 0xa8, 0xb2, 0x58, 0x45, 0x95, 0xcf, 0x55, 0x54, 0x79, 0x3a, 0xf8, 0x5d,
+	0xd8, 0xeb, 0xbf, 0x95, 0xc1, 0x5c, 0x0b, 0x9b, 0xd4, 0xdd, 0x9a, 0xae, 0xbe, 0x07, 0x00, 0x47,
+	0xef, 0x73, 0x45, 0xd7, 0x01, 0x00, 0x00,
+}
+
+func (m *AttestationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketMapDigest) > 0 {
+		i -= len(m.MarketMapDigest)
+		copy(dAtA[i:], m.MarketMapDigest)
+		i = encodeVarintAttestationRecord(dAtA, i, uint64(len(m.MarketMapDigest)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ConfigDigest) > 0 {
+		i -= len(m.ConfigDigest)
+		copy(dAtA[i:], m.ConfigDigest)
+		i = encodeVarintAttestationRecord(dAtA, i, uint64(len(m.ConfigDigest)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PricesHash) > 0 {
+		i -= len(m.PricesHash)
+		copy(dAtA[i:], m.PricesHash)
+		i = encodeVarintAttestationRecord(dAtA, i, uint64(len(m.PricesHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TcbStatus) > 0 {
+		i -= len(m.TcbStatus)
+		copy(dAtA[i:], m.TcbStatus)
+		i = encodeVarintAttestationRecord(dAtA, i, uint64(len(m.TcbStatus)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SecurityVersion != 0 {
+		i = encodeVarintAttestationRecord(dAtA, i, uint64(m.SecurityVersion))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ProductId != 0 {
+		i = encodeVarintAttestationRecord(dAtA, i, uint64(m.ProductId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SignerId) > 0 {
+		i -= len(m.SignerId)
+		copy(dAtA[i:], m.SignerId)
+		i = encodeVarintAttestationRecord(dAtA, i, uint64(len(m.SignerId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReportHash) > 0 {
+		i -= len(m.ReportHash)
+		copy(dAtA[i:], m.ReportHash)
+		i = encodeVarintAttestationRecord(dAtA, i, uint64(len(m.ReportHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintAttestationRecord(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAttestationRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAttestationRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AttestationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovAttestationRecord(uint64(m.BlockHeight))
+	}
+	l = len(m.ReportHash)
+	if l > 0 {
+		n += 1 + l + sovAttestationRecord(uint64(l))
+	}
+	l = len(m.SignerId)
+	if l > 0 {
+		n += 1 + l + sovAttestationRecord(uint64(l))
+	}
+	if m.ProductId != 0 {
+		n += 1 + sovAttestationRecord(uint64(m.ProductId))
+	}
+	if m.SecurityVersion != 0 {
+		n += 1 + sovAttestationRecord(uint64(m.SecurityVersion))
+	}
+	l = len(m.TcbStatus)
+	if l > 0 {
+		n += 1 + l + sovAttestationRecord(uint64(l))
+	}
+	l = len(m.PricesHash)
+	if l > 0 {
+		n += 1 + l + sovAttestationRecord(uint64(l))
+	}
+	l = len(m.ConfigDigest)
+	if l > 0 {
+		n += 1 + l + sovAttestationRecord(uint64(l))
+	}
+	l = len(m.MarketMapDigest)
+	if l > 0 {
+		n += 1 + l + sovAttestationRecord(uint64(l))
+	}
+	return n
+}
+
+func sovAttestationRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttestationRecord(x uint64) (n int) {
+	return sovAttestationRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AttestationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestationRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestationRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestationRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestationRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestationRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			m.ProductId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProductId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityVersion", wireType)
+			}
+			m.SecurityVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecurityVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TcbStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestationRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestationRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TcbStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricesHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestationRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestationRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PricesHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestationRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestationRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketMapDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestationRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestationRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketMapDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestationRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestationRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAttestationRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAttestationRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestationRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAttestationRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAttestationRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAttestationRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAttestationRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAttestationRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAttestationRecord = fmt.Errorf("proto: unexpected end of group")
+)
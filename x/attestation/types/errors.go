@@ -0,0 +1,18 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/attestation module sentinel errors
+var (
+	ErrInvalidSigner     = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrConfigNotApproved = sdkerrors.Register(ModuleName, 1101, "sidecar config not approved")
+	ErrStalePrice        = sdkerrors.Register(ModuleName, 1102, "oracle price is stale")
+
+	ErrInvalidSequencerSignature = sdkerrors.Register(ModuleName, 1103, "invalid sequencer signature")
+	ErrInvalidSequencerHeight    = sdkerrors.Register(ModuleName, 1104, "invalid sequencer height")
+	ErrInvalidCollateral         = sdkerrors.Register(ModuleName, 1105, "invalid DCAP collateral")
+)
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	// this line is used by starport scaffolding # genesis/types/import
+)
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		PriceDispersionList:   []PriceDispersion{},
+		AttestationRecordList: []AttestationRecord{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in priceDispersion
+	priceDispersionIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PriceDispersionList {
+		index := string(PriceDispersionKey(elem.CurrencyPair))
+		if _, ok := priceDispersionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for priceDispersion")
+		}
+		priceDispersionIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in attestationRecord
+	attestationRecordIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.AttestationRecordList {
+		index := string(AttestationRecordKey(elem.BlockHeight))
+		if _, ok := attestationRecordIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for attestationRecord")
+		}
+		attestationRecordIndexMap[index] = struct{}{}
+	}
+	if len(gs.Collateral) > 0 {
+		if err := ValidateCollateral(gs.Collateral); err != nil {
+			return err
+		}
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
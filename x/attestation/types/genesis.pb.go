@@ -0,0 +1,551 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollinky/attestation/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the attestation module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params                Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PriceDispersionList   []PriceDispersion   `protobuf:"bytes,2,rep,name=price_dispersion_list,json=priceDispersionList,proto3" json:"price_dispersion_list"`
+	AttestationRecordList []AttestationRecord `protobuf:"bytes,3,rep,name=attestation_record_list,json=attestationRecordList,proto3" json:"attestation_record_list"`
+	// last_sequencer_height is the height of the last sequencer signed envelope
+	// the chain accepted.
+	LastSequencerHeight uint64 `protobuf:"varint,4,opt,name=last_sequencer_height,json=lastSequencerHeight,proto3" json:"last_sequencer_height,omitempty"`
+	// collateral is the JSON encoded DCAP collateral enclave reports are
+	// verified against. Without it, no oracle envelope is accepted.
+	Collateral []byte `protobuf:"bytes,5,opt,name=collateral,proto3" json:"collateral,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d03d3c18fcfac2f, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetPriceDispersionList() []PriceDispersion {
+	if m != nil {
+		return m.PriceDispersionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestationRecordList() []AttestationRecord {
+	if m != nil {
+		return m.AttestationRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastSequencerHeight() uint64 {
+	if m != nil {
+		return m.LastSequencerHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCollateral() []byte {
+	if m != nil {
+		return m.Collateral
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "rollinky.attestation.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("rollinky/attestation/genesis.proto", fileDescriptor_3d03d3c18fcfac2f)
+}
+
+var fileDescriptor_3d03d3c18fcfac2f = []byte{
+	// 360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x86, 0x33, 0x6d, 0x6f, 0xe1, 0x4e, 0xbb, 0xb9, 0x69, 0xcb, 0x0d, 0xa5, 0xc4, 0x58, 0x10,
+	0x83, 0x62, 0x02, 0x15, 0xdc, 0x8a, 0x45, 0xd0, 0x85, 0x0b, 0x49, 0x77, 0x6e, 0xc2, 0x98, 0x1e,
+	0xd2, 0xc1, 0x34, 0x13, 0x67, 0x46, 0xb0, 0x6f, 0xe1, 0x63, 0xb8, 0xf4, 0x31, 0xba, 0xec, 0xd2,
+	0x95, 0x48, 0xbb, 0x70, 0xe1, 0x4b, 0x48, 0x26, 0x69, 0x6d, 0x6b, 0xdc, 0x84, 0xc3, 0xf9, 0xbf,
+	0xff, 0xfc, 0x39, 0x73, 0x70, 0x97, 0xb3, 0x28, 0xa2, 0xf1, 0xdd, 0xc4, 0x25, 0x52, 0x82, 0x90,
+	0x44, 0x52, 0x16, 0xbb, 0x21, 0xc4, 0x20, 0xa8, 0x70, 0x12, 0xce, 0x24, 0xd3, 0x9b, 0x4b, 0xc6,
+	0x59, 0x63, 0xda, 0xff, 0xc8, 0x98, 0xc6, 0xcc, 0x55, 0xdf, 0x0c, 0x6c, 0x37, 0x43, 0x16, 0x32,
+	0x55, 0xba, 0x69, 0x95, 0x77, 0x8f, 0x0a, 0x23, 0xd6, 0x6a, 0x9f, 0x43, 0xc0, 0xf8, 0x30, 0xc7,
+	0x77, 0x0b, 0xf1, 0x84, 0x70, 0x32, 0xce, 0x7f, 0xa8, 0x7d, 0x58, 0x8c, 0x70, 0x1a, 0x80, 0x3f,
+	0xa4, 0x22, 0x01, 0x2e, 0x28, 0x8b, 0x33, 0xb8, 0xfb, 0x59, 0xc2, 0xf5, 0x8b, 0x6c, 0x9f, 0x81,
+	0x24, 0x12, 0xf4, 0x53, 0x5c, 0xcd, 0xa6, 0x19, 0xc8, 0x42, 0x76, 0xad, 0xd7, 0x71, 0x8a, 0xf6,
+	0x73, 0xae, 0x15, 0xd3, 0xff, 0x3b, 0x7d, 0xdb, 0xd1, 0x9e, 0x3f, 0x5e, 0x0e, 0x90, 0x97, 0xdb,
+	0x74, 0x1f, 0xb7, 0xb6, 0xb3, 0xfc, 0x88, 0x0a, 0x69, 0x94, 0xac, 0xb2, 0x5d, 0xeb, 0xed, 0xfd,
+	0x32, 0x2f, 0xb5, 0x9c, 0xaf, 0x1c, 0xfd, 0x4a, 0x3a, 0xd8, 0x6b, 0x24, 0x9b, 0xed, 0x2b, 0x2a,
+	0xa4, 0x0e, 0xf8, 0xff, 0xcf, 0xe7, 0xc9, 0x22, 0xca, 0x2a, 0x62, 0xbf, 0x38, 0xe2, 0xec, 0xbb,
+	0xf6, 0x94, 0x27, 0x0f, 0x69, 0x91, 0x6d, 0x41, 0xc5, 0xf4, 0x70, 0x2b, 0x22, 0x42, 0xfa, 0x02,
+	0xee, 0x1f, 0x20, 0x0e, 0x80, 0xfb, 0x23, 0xa0, 0xe1, 0x48, 0x1a, 0x15, 0x0b, 0xd9, 0x15, 0xaf,
+	0x91, 0x8a, 0x83, 0xa5, 0x76, 0xa9, 0x24, 0xdd, 0xc4, 0x38, 0x60, 0x51, 0x44, 0x24, 0x70, 0x12,
+	0x19, 0x7f, 0x2c, 0x64, 0xd7, 0xbd, 0xb5, 0x4e, 0xff, 0x64, 0x3a, 0x37, 0xd1, 0x6c, 0x6e, 0xa2,
+	0xf7, 0xb9, 0x89, 0x9e, 0x16, 0xa6, 0x36, 0x5b, 0x98, 0xda, 0xeb, 0xc2, 0xd4, 0x6e, 0x3a, 0xab,
+	0xa3, 0x3d, 0x6e, 0x9c, 0x4d, 0x4e, 0x12, 0x10, 0xb7, 0x55, 0x75, 0xac, 0xe3, 0xaf, 0x01, 0x00,
+	0xa6, 0x19, 0xa7, 0xb6, 0x90, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Collateral) > 0 {
+		i -= len(m.Collateral)
+		copy(dAtA[i:], m.Collateral)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Collateral)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastSequencerHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSequencerHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AttestationRecordList) > 0 {
+		for iNdEx := len(m.AttestationRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttestationRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PriceDispersionList) > 0 {
+		for iNdEx := len(m.PriceDispersionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceDispersionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PriceDispersionList) > 0 {
+		for _, e := range m.PriceDispersionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AttestationRecordList) > 0 {
+		for _, e := range m.AttestationRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastSequencerHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSequencerHeight))
+	}
+	l = len(m.Collateral)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDispersionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDispersionList = append(m.PriceDispersionList, PriceDispersion{})
+			if err := m.PriceDispersionList[len(m.PriceDispersionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationRecordList = append(m.AttestationRecordList, AttestationRecord{})
+			if err := m.AttestationRecordList[len(m.AttestationRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSequencerHeight", wireType)
+			}
+			m.LastSequencerHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSequencerHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = append(m.Collateral[:0], dAtA[iNdEx:postIndex]...)
+			if m.Collateral == nil {
+				m.Collateral = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
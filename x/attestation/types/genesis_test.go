@@ -0,0 +1,151 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"rollinky/x/attestation/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PriceDispersionList: []types.PriceDispersion{
+					{
+						CurrencyPair: "BTC/USD",
+					},
+					{
+						CurrencyPair: "ETH/USD",
+					},
+				},
+				AttestationRecordList: []types.AttestationRecord{
+					{
+						BlockHeight: 1,
+					},
+					{
+						BlockHeight: 2,
+					},
+				},
+				// this line is used by starport scaffolding # types/genesis/validField
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid collateral",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				Collateral: []byte(`not json`),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated priceDispersion",
+			genState: &types.GenesisState{
+				PriceDispersionList: []types.PriceDispersion{
+					{
+						CurrencyPair: "BTC/USD",
+					},
+					{
+						CurrencyPair: "BTC/USD",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated attestationRecord",
+			genState: &types.GenesisState{
+				AttestationRecordList: []types.AttestationRecord{
+					{
+						BlockHeight: 1,
+					},
+					{
+						BlockHeight: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid max price failure ratio",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, false, math.LegacyNewDec(2), 0, "", types.DefaultMaxProviderPriceAge, types.DefaultAllowedTCBStatuses, types.DefaultMaxReportAge, types.DefaultFailMissingPrices),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid sequencer public key",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, false, types.DefaultMaxPriceFailureRatio, 0, "abcd", types.DefaultMaxProviderPriceAge, types.DefaultAllowedTCBStatuses, types.DefaultMaxReportAge, types.DefaultFailMissingPrices),
+			},
+			valid: false,
+		},
+		{
+			desc: "negative max provider price age",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, false, types.DefaultMaxPriceFailureRatio, 0, "", -time.Second, types.DefaultAllowedTCBStatuses, types.DefaultMaxReportAge, types.DefaultFailMissingPrices),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated allowed TCB status",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, false, types.DefaultMaxPriceFailureRatio, 0, "", types.DefaultMaxProviderPriceAge, []string{"UpToDate", "UpToDate"}, types.DefaultMaxReportAge, false),
+			},
+			valid: false,
+		},
+		{
+			desc: "unknown allowed TCB status",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, false, types.DefaultMaxPriceFailureRatio, 0, "", types.DefaultMaxProviderPriceAge, []string{"UpToDate", "uptodate"}, types.DefaultMaxReportAge, false),
+			},
+			valid: false,
+		},
+		{
+			desc: "allowed TCB statuses",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, false, types.DefaultMaxPriceFailureRatio, 0, "", types.DefaultMaxProviderPriceAge, []string{"UpToDate", "SWHardeningNeeded"}, types.DefaultMaxReportAge, false),
+			},
+			valid: true,
+		},
+		{
+			desc: "no allowed TCB status",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, false, types.DefaultMaxPriceFailureRatio, 0, "", types.DefaultMaxProviderPriceAge, nil, types.DefaultMaxReportAge, false),
+			},
+			valid: false,
+		},
+		{
+			desc: "zero max report age",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, false, types.DefaultMaxPriceFailureRatio, 0, "", types.DefaultMaxProviderPriceAge, types.DefaultAllowedTCBStatuses, 0, false),
+			},
+			valid: false,
+		},
+		// this line is used by starport scaffolding # types/genesis/testcase
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
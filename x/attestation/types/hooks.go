@@ -0,0 +1,51 @@
+package types
+
+import (
+	"context"
+	"sort"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// OraclePriceHooks are called by the PreBlocker once it has written the
+// oracle prices of a block. An error only reverts the state changes of the
+// hook that returned it, the prices and the other hooks are unaffected.
+type OraclePriceHooks interface {
+	// AfterPricesUpdated is called with the prices written in the block, if
+	// any were.
+	AfterPricesUpdated(ctx context.Context, prices map[connecttypes.CurrencyPair]oracletypes.QuotePrice) error
+	// AfterPriceMissed is called for every currency pair of the oracle module
+	// whose price was not written in the block.
+	AfterPriceMissed(ctx context.Context, cp connecttypes.CurrencyPair) error
+}
+
+// OraclePriceHooksWrapper is a wrapper for modules to inject OraclePriceHooks
+// using depinject.
+type OraclePriceHooksWrapper struct{ OraclePriceHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (OraclePriceHooksWrapper) IsOnePerModuleType() {}
+
+// SortedCurrencyPairs returns the currency pairs of the prices in a fixed
+// order, so that hooks iterating them write state the same way on every node.
+func SortedCurrencyPairs(prices map[connecttypes.CurrencyPair]oracletypes.QuotePrice) []connecttypes.CurrencyPair {
+	pairs := make([]connecttypes.CurrencyPair, 0, len(prices))
+	for cp := range prices {
+		pairs = append(pairs, cp)
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].String() < pairs[j].String() })
+
+	return pairs
+}
+
+// EmitEvent emits a typed event from the PreBlocker or an oracle price hook.
+// These can't fail the block, an event that can't be encoded is only logged.
+func EmitEvent(ctx context.Context, logger log.Logger, event proto.Message) {
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		logger.Error("failed to emit event", "event", proto.MessageName(event), "err", err)
+	}
+}
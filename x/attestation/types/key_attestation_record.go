@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// AttestationRecordKeyPrefix is the prefix to retrieve all AttestationRecord
+	AttestationRecordKeyPrefix = "AttestationRecord/value/"
+)
+
+// AttestationRecordKey returns the store key to retrieve an AttestationRecord
+// from the index fields. Heights are big endian, so records are iterated in
+// height order.
+func AttestationRecordKey(
+	blockHeight uint64,
+) []byte {
+	var key []byte
+
+	blockHeightBytes := binary.BigEndian.AppendUint64(nil, blockHeight)
+	key = append(key, blockHeightBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,19 @@
+package types
+
+const (
+	// PriceDispersionKeyPrefix is the prefix to retrieve all PriceDispersion
+	PriceDispersionKeyPrefix = "PriceDispersion/value/"
+)
+
+// PriceDispersionKey returns the store key to retrieve a PriceDispersion from the index fields
+func PriceDispersionKey(
+	currencyPair string,
+) []byte {
+	var key []byte
+
+	currencyPairBytes := []byte(currencyPair)
+	key = append(key, currencyPairBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,28 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "attestation"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_attestation"
+)
+
+var (
+	ParamsKey = []byte("p_attestation")
+
+	// LastSequencerHeightKey stores the height of the last sequencer signed
+	// envelope the chain accepted.
+	LastSequencerHeightKey = []byte("LastSequencerHeight/value/")
+
+	// CollateralKey stores the JSON encoded DCAP collateral enclave reports
+	// are verified against.
+	CollateralKey = []byte("Collateral/value/")
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/facundomedica/rollinky/sequencer/utils/dcap"
+)
+
+var _ sdk.Msg = &MsgUpdateCollateral{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateCollateral) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateCollateral(m.Collateral)
+}
+
+// ValidateCollateral checks that collateral decodes. Its signatures are only
+// checked when verifying a report, against the block time.
+func ValidateCollateral(collateral []byte) error {
+	if len(collateral) == 0 {
+		return errorsmod.Wrap(ErrInvalidCollateral, "collateral is empty")
+	}
+	if _, err := dcap.ParseCollateral(collateral); err != nil {
+		return errorsmod.Wrap(ErrInvalidCollateral, err.Error())
+	}
+
+	return nil
+}
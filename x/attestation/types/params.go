@@ -0,0 +1,374 @@
+package types
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/edgelesssys/ego/attestation/tcbstatus"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyApprovedConfigDigests = []byte("ApprovedConfigDigests")
+	// DefaultApprovedConfigDigests is empty: no sidecar config is approved.
+	DefaultApprovedConfigDigests []string = nil
+)
+
+var (
+	KeyRequireApprovedConfig = []byte("RequireApprovedConfig")
+	// DefaultRequireApprovedConfig is false, so that a chain can start before it
+	// has approved any sidecar config.
+	DefaultRequireApprovedConfig = false
+)
+
+var (
+	KeyMaxPriceFailureRatio = []byte("MaxPriceFailureRatio")
+	// DefaultMaxPriceFailureRatio discards the prices of a block if more than
+	// half of them fail validation.
+	DefaultMaxPriceFailureRatio = math.LegacyNewDecWithPrec(5, 1)
+)
+
+var (
+	KeyAttestationRecordRetention = []byte("AttestationRecordRetention")
+	// DefaultAttestationRecordRetention keeps the attestation records of all
+	// heights.
+	DefaultAttestationRecordRetention uint64 = 0
+)
+
+var (
+	KeySequencerPublicKey = []byte("SequencerPublicKey")
+	// DefaultSequencerPublicKey is empty: envelopes are accepted from any
+	// sequencer.
+	DefaultSequencerPublicKey = ""
+)
+
+var (
+	KeyMaxProviderPriceAge = []byte("MaxProviderPriceAge")
+	// DefaultMaxProviderPriceAge drops provider prices received more than two
+	// minutes before the block time, the sidecar's default max price age.
+	DefaultMaxProviderPriceAge = 2 * time.Minute
+)
+
+var (
+	KeyAllowedTCBStatuses = []byte("AllowedTCBStatuses")
+	// DefaultAllowedTCBStatuses only accepts reports from platforms whose TCB
+	// level is up to date.
+	DefaultAllowedTCBStatuses = []string{"UpToDate"}
+)
+
+var (
+	KeyMaxReportAge = []byte("MaxReportAge")
+	// DefaultMaxReportAge accepts enclave reports created up to a minute from
+	// the block time.
+	DefaultMaxReportAge = time.Minute
+)
+
+var (
+	KeyFailMissingPrices = []byte("FailMissingPrices")
+	// DefaultFailMissingPrices is false: an enabled market without a price is
+	// skipped.
+	DefaultFailMissingPrices = false
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	approvedConfigDigests []string,
+	requireApprovedConfig bool,
+	maxPriceFailureRatio math.LegacyDec,
+	attestationRecordRetention uint64,
+	sequencerPublicKey string,
+	maxProviderPriceAge time.Duration,
+	allowedTCBStatuses []string,
+	maxReportAge time.Duration,
+	failMissingPrices bool,
+) Params {
+	return Params{
+		ApprovedConfigDigests:      approvedConfigDigests,
+		RequireApprovedConfig:      requireApprovedConfig,
+		MaxPriceFailureRatio:       maxPriceFailureRatio,
+		AttestationRecordRetention: attestationRecordRetention,
+		SequencerPublicKey:         sequencerPublicKey,
+		MaxProviderPriceAge:        maxProviderPriceAge,
+		AllowedTcbStatuses:         allowedTCBStatuses,
+		MaxReportAge:               maxReportAge,
+		FailMissingPrices:          failMissingPrices,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultApprovedConfigDigests,
+		DefaultRequireApprovedConfig,
+		DefaultMaxPriceFailureRatio,
+		DefaultAttestationRecordRetention,
+		DefaultSequencerPublicKey,
+		DefaultMaxProviderPriceAge,
+		DefaultAllowedTCBStatuses,
+		DefaultMaxReportAge,
+		DefaultFailMissingPrices,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyApprovedConfigDigests, &p.ApprovedConfigDigests, validateApprovedConfigDigests),
+		paramtypes.NewParamSetPair(KeyRequireApprovedConfig, &p.RequireApprovedConfig, validateRequireApprovedConfig),
+		paramtypes.NewParamSetPair(KeyMaxPriceFailureRatio, &p.MaxPriceFailureRatio, validateMaxPriceFailureRatio),
+		paramtypes.NewParamSetPair(KeyAttestationRecordRetention, &p.AttestationRecordRetention, validateAttestationRecordRetention),
+		paramtypes.NewParamSetPair(KeySequencerPublicKey, &p.SequencerPublicKey, validateSequencerPublicKey),
+		paramtypes.NewParamSetPair(KeyMaxProviderPriceAge, &p.MaxProviderPriceAge, validateMaxProviderPriceAge),
+		paramtypes.NewParamSetPair(KeyAllowedTCBStatuses, &p.AllowedTcbStatuses, validateAllowedTCBStatuses),
+		paramtypes.NewParamSetPair(KeyMaxReportAge, &p.MaxReportAge, validateMaxReportAge),
+		paramtypes.NewParamSetPair(KeyFailMissingPrices, &p.FailMissingPrices, validateFailMissingPrices),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateApprovedConfigDigests(p.ApprovedConfigDigests); err != nil {
+		return err
+	}
+
+	if err := validateRequireApprovedConfig(p.RequireApprovedConfig); err != nil {
+		return err
+	}
+
+	if err := validateMaxPriceFailureRatio(p.MaxPriceFailureRatio); err != nil {
+		return err
+	}
+
+	if err := validateAttestationRecordRetention(p.AttestationRecordRetention); err != nil {
+		return err
+	}
+
+	if err := validateSequencerPublicKey(p.SequencerPublicKey); err != nil {
+		return err
+	}
+
+	if err := validateMaxProviderPriceAge(p.MaxProviderPriceAge); err != nil {
+		return err
+	}
+
+	if err := validateAllowedTCBStatuses(p.AllowedTcbStatuses); err != nil {
+		return err
+	}
+
+	if err := validateMaxReportAge(p.MaxReportAge); err != nil {
+		return err
+	}
+
+	if err := validateFailMissingPrices(p.FailMissingPrices); err != nil {
+		return err
+	}
+
+	if p.RequireApprovedConfig && len(p.ApprovedConfigDigests) == 0 {
+		return fmt.Errorf("require approved config is set but no config digest is approved")
+	}
+
+	return nil
+}
+
+// IsApprovedConfigDigest reports whether digest is one of the approved sidecar
+// config digests.
+func (p Params) IsApprovedConfigDigest(digest []byte) bool {
+	encoded := hex.EncodeToString(digest)
+	for _, approved := range p.ApprovedConfigDigests {
+		if approved == encoded {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SequencerPubKey returns the sequencer's public key, or nil if none is set.
+func (p Params) SequencerPubKey() ed25519.PublicKey {
+	if p.SequencerPublicKey == "" {
+		return nil
+	}
+
+	// the key is validated along with the params.
+	bz, _ := hex.DecodeString(p.SequencerPublicKey)
+	return bz
+}
+
+// validateApprovedConfigDigests validates the ApprovedConfigDigests param
+func validateApprovedConfigDigests(v interface{}) error {
+	approvedConfigDigests, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]struct{}, len(approvedConfigDigests))
+	for _, digest := range approvedConfigDigests {
+		// digests are compared as strings, only accept the canonical encoding.
+		if digest != strings.ToLower(digest) {
+			return fmt.Errorf("config digest %s must be lowercase hex", digest)
+		}
+
+		bz, err := hex.DecodeString(digest)
+		if err != nil {
+			return fmt.Errorf("invalid config digest %s: %w", digest, err)
+		}
+		if len(bz) != sha256.Size {
+			return fmt.Errorf("invalid config digest %s: expected %d bytes, got %d", digest, sha256.Size, len(bz))
+		}
+
+		if _, ok := seen[digest]; ok {
+			return fmt.Errorf("duplicate config digest %s", digest)
+		}
+		seen[digest] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateRequireApprovedConfig validates the RequireApprovedConfig param
+func validateRequireApprovedConfig(v interface{}) error {
+	_, ok := v.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxPriceFailureRatio validates the MaxPriceFailureRatio param
+func validateMaxPriceFailureRatio(v interface{}) error {
+	maxPriceFailureRatio, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxPriceFailureRatio.IsNil() {
+		return fmt.Errorf("max price failure ratio must be set")
+	}
+	if maxPriceFailureRatio.IsNegative() || maxPriceFailureRatio.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max price failure ratio must be between 0 and 1: %s", maxPriceFailureRatio)
+	}
+
+	return nil
+}
+
+// validateAttestationRecordRetention validates the AttestationRecordRetention param
+func validateAttestationRecordRetention(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateSequencerPublicKey validates the SequencerPublicKey param
+func validateSequencerPublicKey(v interface{}) error {
+	sequencerPublicKey, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if sequencerPublicKey == "" {
+		return nil
+	}
+
+	bz, err := hex.DecodeString(sequencerPublicKey)
+	if err != nil {
+		return fmt.Errorf("invalid sequencer public key %s: %w", sequencerPublicKey, err)
+	}
+	if len(bz) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid sequencer public key %s: expected %d bytes, got %d", sequencerPublicKey, ed25519.PublicKeySize, len(bz))
+	}
+
+	return nil
+}
+
+// validateMaxProviderPriceAge validates the MaxProviderPriceAge param
+func validateMaxProviderPriceAge(v interface{}) error {
+	maxProviderPriceAge, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxProviderPriceAge < 0 {
+		return fmt.Errorf("max provider price age must not be negative: %s", maxProviderPriceAge)
+	}
+
+	return nil
+}
+
+// validateAllowedTCBStatuses validates the AllowedTCBStatuses param
+func validateAllowedTCBStatuses(v interface{}) error {
+	allowedTCBStatuses, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	// no report would be accepted.
+	if len(allowedTCBStatuses) == 0 {
+		return fmt.Errorf("at least one TCB status must be allowed")
+	}
+
+	seen := make(map[string]struct{}, len(allowedTCBStatuses))
+	for _, status := range allowedTCBStatuses {
+		if !IsTCBStatus(status) {
+			return fmt.Errorf("invalid allowed TCB status %q", status)
+		}
+
+		if _, ok := seen[status]; ok {
+			return fmt.Errorf("duplicate allowed TCB status %s", status)
+		}
+		seen[status] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsTCBStatus reports whether status is the name of a TCB status, as verified
+// reports carry it.
+func IsTCBStatus(status string) bool {
+	for s := tcbstatus.UpToDate; s <= tcbstatus.Unknown; s++ {
+		if s.String() == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateMaxReportAge validates the MaxReportAge param
+func validateMaxReportAge(v interface{}) error {
+	maxReportAge, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxReportAge <= 0 {
+		return fmt.Errorf("max report age must be positive: %s", maxReportAge)
+	}
+
+	return nil
+}
+
+// validateFailMissingPrices validates the FailMissingPrices param
+func validateFailMissingPrices(v interface{}) error {
+	_, ok := v.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
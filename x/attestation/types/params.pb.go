@@ -0,0 +1,811 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollinky/attestation/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// approved_config_digests are the hex encoded SHA-256 digests of the sidecar
+	// oracle configs the chain accepts prices from. The market map isn't part of
+	// the digest, it is attested with every report instead.
+	ApprovedConfigDigests []string `protobuf:"bytes,1,rep,name=approved_config_digests,json=approvedConfigDigests,proto3" json:"approved_config_digests,omitempty"`
+	// require_approved_config, if set, rejects oracle envelopes whose attested
+	// config digest is not in approved_config_digests.
+	RequireApprovedConfig bool `protobuf:"varint,2,opt,name=require_approved_config,json=requireApprovedConfig,proto3" json:"require_approved_config,omitempty"`
+	// max_price_failure_ratio is the highest share of the prices carried by a
+	// block that may fail validation (malformed currency pairs or prices, or
+	// prices breaking their market's rules). If more fail, none of the block's
+	// prices are written.
+	MaxPriceFailureRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_price_failure_ratio,json=maxPriceFailureRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_failure_ratio"`
+	// attestation_record_retention is the number of most recent heights whose
+	// attestation records are kept, older records are pruned. Zero keeps all.
+	AttestationRecordRetention uint64 `protobuf:"varint,4,opt,name=attestation_record_retention,json=attestationRecordRetention,proto3" json:"attestation_record_retention,omitempty"`
+	// sequencer_public_key is the hex encoded ed25519 public key of the
+	// sequencer. If set, oracle envelopes must be signed by it for a height
+	// above the last one accepted and not above the block's. If empty,
+	// envelopes are accepted from any sequencer.
+	SequencerPublicKey string `protobuf:"bytes,5,opt,name=sequencer_public_key,json=sequencerPublicKey,proto3" json:"sequencer_public_key,omitempty"`
+	// max_provider_price_age is how long before the block time a provider price
+	// attested by the sidecar may have been received. Older provider prices are
+	// dropped before the median is taken. Zero accepts provider prices of any
+	// age.
+	MaxProviderPriceAge time.Duration `protobuf:"bytes,6,opt,name=max_provider_price_age,json=maxProviderPriceAge,proto3,stdduration" json:"max_provider_price_age"`
+	// allowed_tcb_statuses are the TCB statuses an enclave report's platform may
+	// have: "UpToDate", "OutOfDate", "Revoked", "ConfigurationNeeded",
+	// "OutOfDateConfigurationNeeded", "SWHardeningNeeded",
+	// "ConfigurationAndSWHardeningNeeded" or "Unknown". At least one must be
+	// allowed.
+	AllowedTcbStatuses []string `protobuf:"bytes,7,rep,name=allowed_tcb_statuses,json=allowedTcbStatuses,proto3" json:"allowed_tcb_statuses,omitempty"`
+	// max_report_age is how far from the block time the enclave report carried
+	// in a block may have been created.
+	MaxReportAge time.Duration `protobuf:"bytes,8,opt,name=max_report_age,json=maxReportAge,proto3,stdduration" json:"max_report_age"`
+	// fail_missing_prices, if set, counts an enabled market without a price in
+	// the block as a failure towards max_price_failure_ratio. Otherwise the
+	// pair is skipped and the other prices are written.
+	FailMissingPrices bool `protobuf:"varint,9,opt,name=fail_missing_prices,json=failMissingPrices,proto3" json:"fail_missing_prices,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab7e6c234aceb7dc, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetApprovedConfigDigests() []string {
+	if m != nil {
+		return m.ApprovedConfigDigests
+	}
+	return nil
+}
+
+func (m *Params) GetRequireApprovedConfig() bool {
+	if m != nil {
+		return m.RequireApprovedConfig
+	}
+	return false
+}
+
+func (m *Params) GetAttestationRecordRetention() uint64 {
+	if m != nil {
+		return m.AttestationRecordRetention
+	}
+	return 0
+}
+
+func (m *Params) GetSequencerPublicKey() string {
+	if m != nil {
+		return m.SequencerPublicKey
+	}
+	return ""
+}
+
+func (m *Params) GetMaxProviderPriceAge() time.Duration {
+	if m != nil {
+		return m.MaxProviderPriceAge
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedTcbStatuses() []string {
+	if m != nil {
+		return m.AllowedTcbStatuses
+	}
+	return nil
+}
+
+func (m *Params) GetMaxReportAge() time.Duration {
+	if m != nil {
+		return m.MaxReportAge
+	}
+	return 0
+}
+
+func (m *Params) GetFailMissingPrices() bool {
+	if m != nil {
+		return m.FailMissingPrices
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "rollinky.attestation.Params")
+}
+
+func init() { proto.RegisterFile("rollinky/attestation/params.proto", fileDescriptor_ab7e6c234aceb7dc) }
+
+var fileDescriptor_ab7e6c234aceb7dc = []byte{
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x73, 0xf4, 0x0f, 0xad, 0x41, 0x48, 0x75, 0x53, 0xea, 0x86, 0xe2, 0x04, 0x06, 0x14,
+	0x55, 0xc2, 0x46, 0x20, 0x65, 0x60, 0xa2, 0x21, 0x42, 0x42, 0x80, 0x14, 0x19, 0x06, 0xc4, 0x72,
+	0xba, 0x9c, 0xdf, 0x98, 0x53, 0x6c, 0x9f, 0x7b, 0x77, 0x2e, 0xc9, 0x57, 0xe8, 0xc4, 0xc8, 0xc8,
+	0xc8, 0xd8, 0x81, 0x0f, 0xd1, 0xb1, 0x62, 0x42, 0x0c, 0x05, 0x25, 0x43, 0xf9, 0x18, 0xe8, 0xee,
+	0x9c, 0x2a, 0x45, 0x42, 0x62, 0xb1, 0x7c, 0xf7, 0x7b, 0xdf, 0xd7, 0xcf, 0x73, 0x8f, 0xcf, 0xb9,
+	0x23, 0x78, 0x9a, 0xb2, 0x7c, 0x34, 0x09, 0x89, 0x52, 0x20, 0x15, 0x51, 0x8c, 0xe7, 0x61, 0x41,
+	0x04, 0xc9, 0x64, 0x50, 0x08, 0xae, 0xb8, 0x5b, 0x9f, 0x97, 0x04, 0x0b, 0x25, 0x8d, 0x0d, 0x92,
+	0xb1, 0x9c, 0x87, 0xe6, 0x69, 0x0b, 0x1b, 0x3b, 0x94, 0xcb, 0x8c, 0x4b, 0x6c, 0x56, 0xa1, 0x5d,
+	0x54, 0xa8, 0x9e, 0xf0, 0x84, 0xdb, 0x7d, 0xfd, 0x56, 0xed, 0xfa, 0x09, 0xe7, 0x49, 0x0a, 0xa1,
+	0x59, 0x0d, 0xca, 0x61, 0x18, 0x97, 0xc2, 0x4c, 0xb7, 0xfc, 0xee, 0xd1, 0x8a, 0xb3, 0xda, 0x37,
+	0x52, 0xdc, 0x8e, 0xb3, 0x4d, 0x8a, 0x42, 0xf0, 0x43, 0x88, 0x31, 0xe5, 0xf9, 0x90, 0x25, 0x38,
+	0x66, 0x09, 0x48, 0x25, 0x3d, 0xd4, 0x5a, 0x6a, 0xaf, 0x47, 0x5b, 0x73, 0xfc, 0xd4, 0xd0, 0x9e,
+	0x85, 0xba, 0x4f, 0xc0, 0x41, 0xc9, 0x04, 0xe0, 0xbf, 0xfa, 0xbd, 0x2b, 0x2d, 0xd4, 0x5e, 0x8b,
+	0xb6, 0x2a, 0xbc, 0x7f, 0xa9, 0xdd, 0xcd, 0x9c, 0xed, 0x8c, 0x8c, 0x71, 0x21, 0x18, 0x05, 0x3c,
+	0x24, 0x2c, 0x2d, 0x05, 0x60, 0x23, 0xce, 0x5b, 0x6a, 0xa1, 0xf6, 0x7a, 0xb7, 0x73, 0x72, 0xd6,
+	0xac, 0xfd, 0x38, 0x6b, 0xde, 0xb2, 0x3e, 0x65, 0x3c, 0x0a, 0x18, 0x0f, 0x33, 0xa2, 0xde, 0x07,
+	0x2f, 0x21, 0x21, 0x74, 0xd2, 0x03, 0xfa, 0xed, 0xeb, 0x7d, 0xa7, 0x3a, 0x86, 0x1e, 0xd0, 0x2f,
+	0xe7, 0xc7, 0x7b, 0x28, 0xaa, 0x67, 0x64, 0xdc, 0xd7, 0x53, 0x9f, 0xd9, 0xa1, 0x91, 0x9e, 0xe9,
+	0x3e, 0x71, 0x76, 0x17, 0x0e, 0x17, 0x0b, 0xa0, 0x5c, 0xc4, 0x58, 0x80, 0x82, 0x5c, 0x6f, 0x78,
+	0xcb, 0x2d, 0xd4, 0x5e, 0x8e, 0x1a, 0x0b, 0x35, 0x91, 0x29, 0x89, 0xe6, 0x15, 0xee, 0x03, 0xa7,
+	0x2e, 0xe1, 0xa0, 0x84, 0x9c, 0x82, 0xc0, 0x45, 0x39, 0x48, 0x19, 0xc5, 0x23, 0x98, 0x78, 0x2b,
+	0x5a, 0x6d, 0xe4, 0x5e, 0xb0, 0xbe, 0x41, 0x2f, 0x60, 0xe2, 0xbe, 0x75, 0x6e, 0x5a, 0x8b, 0xfc,
+	0x90, 0xc5, 0xba, 0xc9, 0x78, 0x25, 0x09, 0x78, 0xab, 0x2d, 0xd4, 0xbe, 0xf6, 0x70, 0x27, 0xb0,
+	0xf1, 0x04, 0xf3, 0x78, 0x82, 0x5e, 0x15, 0x4f, 0x77, 0x4d, 0x9b, 0xff, 0xf4, 0xb3, 0x89, 0xa2,
+	0x4d, 0x63, 0xc7, 0x4e, 0x30, 0xb6, 0xf6, 0x13, 0xd0, 0x5a, 0x48, 0x9a, 0xf2, 0x0f, 0x10, 0x63,
+	0x45, 0x07, 0x58, 0x2b, 0x2e, 0x25, 0x48, 0xef, 0xaa, 0x49, 0xca, 0xad, 0xd8, 0x1b, 0x3a, 0x78,
+	0x5d, 0x11, 0xf7, 0xb9, 0x73, 0x43, 0x6b, 0x11, 0x50, 0x70, 0xa1, 0x8c, 0x86, 0xb5, 0xff, 0xd7,
+	0x70, 0x3d, 0x23, 0xe3, 0xc8, 0x74, 0xea, 0x8f, 0x07, 0xce, 0xa6, 0xce, 0x0b, 0x67, 0x4c, 0x4a,
+	0x96, 0x27, 0xd6, 0x96, 0xf4, 0xd6, 0x4d, 0xda, 0x1b, 0x1a, 0xbd, 0xb2, 0xc4, 0xc8, 0x95, 0x8f,
+	0xef, 0xfd, 0xfe, 0xdc, 0x44, 0x47, 0xe7, 0xc7, 0x7b, 0xb7, 0x2f, 0xae, 0xc2, 0xf8, 0xd2, 0x65,
+	0xb0, 0x7f, 0x60, 0xb7, 0x73, 0x32, 0xf5, 0xd1, 0xe9, 0xd4, 0x47, 0xbf, 0xa6, 0x3e, 0xfa, 0x38,
+	0xf3, 0x6b, 0xa7, 0x33, 0xbf, 0xf6, 0x7d, 0xe6, 0xd7, 0xde, 0xed, 0xfe, 0xa3, 0x51, 0x4d, 0x0a,
+	0x90, 0x83, 0x55, 0x23, 0xfd, 0xd1, 0x9f, 0x01, 0x00, 0xbb, 0xf6, 0x11, 0x6d, 0x6a, 0x03, 0x00,
+	0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ApprovedConfigDigests) != len(that1.ApprovedConfigDigests) {
+		return false
+	}
+	for i := range this.ApprovedConfigDigests {
+		if this.ApprovedConfigDigests[i] != that1.ApprovedConfigDigests[i] {
+			return false
+		}
+	}
+	if this.RequireApprovedConfig != that1.RequireApprovedConfig {
+		return false
+	}
+	if !this.MaxPriceFailureRatio.Equal(that1.MaxPriceFailureRatio) {
+		return false
+	}
+	if this.AttestationRecordRetention != that1.AttestationRecordRetention {
+		return false
+	}
+	if this.SequencerPublicKey != that1.SequencerPublicKey {
+		return false
+	}
+	if this.MaxProviderPriceAge != that1.MaxProviderPriceAge {
+		return false
+	}
+	if len(this.AllowedTcbStatuses) != len(that1.AllowedTcbStatuses) {
+		return false
+	}
+	for i := range this.AllowedTcbStatuses {
+		if this.AllowedTcbStatuses[i] != that1.AllowedTcbStatuses[i] {
+			return false
+		}
+	}
+	if this.MaxReportAge != that1.MaxReportAge {
+		return false
+	}
+	if this.FailMissingPrices != that1.FailMissingPrices {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailMissingPrices {
+		i--
+		if m.FailMissingPrices {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxReportAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxReportAge):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if len(m.AllowedTcbStatuses) > 0 {
+		for iNdEx := len(m.AllowedTcbStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedTcbStatuses[iNdEx])
+			copy(dAtA[i:], m.AllowedTcbStatuses[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedTcbStatuses[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxProviderPriceAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxProviderPriceAge):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if len(m.SequencerPublicKey) > 0 {
+		i -= len(m.SequencerPublicKey)
+		copy(dAtA[i:], m.SequencerPublicKey)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SequencerPublicKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AttestationRecordRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AttestationRecordRetention))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MaxPriceFailureRatio.Size()
+		i -= size
+		if _, err := m.MaxPriceFailureRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.RequireApprovedConfig {
+		i--
+		if m.RequireApprovedConfig {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ApprovedConfigDigests) > 0 {
+		for iNdEx := len(m.ApprovedConfigDigests) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ApprovedConfigDigests[iNdEx])
+			copy(dAtA[i:], m.ApprovedConfigDigests[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ApprovedConfigDigests[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ApprovedConfigDigests) > 0 {
+		for _, s := range m.ApprovedConfigDigests {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.RequireApprovedConfig {
+		n += 2
+	}
+	l = m.MaxPriceFailureRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.AttestationRecordRetention != 0 {
+		n += 1 + sovParams(uint64(m.AttestationRecordRetention))
+	}
+	l = len(m.SequencerPublicKey)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxProviderPriceAge)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.AllowedTcbStatuses) > 0 {
+		for _, s := range m.AllowedTcbStatuses {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxReportAge)
+	n += 1 + l + sovParams(uint64(l))
+	if m.FailMissingPrices {
+		n += 2
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedConfigDigests", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovedConfigDigests = append(m.ApprovedConfigDigests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireApprovedConfig", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireApprovedConfig = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceFailureRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceFailureRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationRecordRetention", wireType)
+			}
+			m.AttestationRecordRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationRecordRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequencerPublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SequencerPublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProviderPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxProviderPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedTcbStatuses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedTcbStatuses = append(m.AllowedTcbStatuses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReportAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxReportAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailMissingPrices", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailMissingPrices = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
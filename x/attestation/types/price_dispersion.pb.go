@@ -0,0 +1,593 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollinky/attestation/price_dispersion.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PriceDispersion records how far apart the attested provider prices were
+// when a currency pair's price was last recomputed from them. It serves as a
+// confidence metric for the price.
+type PriceDispersion struct {
+	// currency_pair is the currency pair, e.g. "BTC/USD".
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// block_height is the height at which the price was recomputed.
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// provider_count is the number of provider prices the price was computed from.
+	ProviderCount uint32 `protobuf:"varint,3,opt,name=provider_count,json=providerCount,proto3" json:"provider_count,omitempty"`
+	// min_price is the lowest provider price.
+	MinPrice string `protobuf:"bytes,4,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	// max_price is the highest provider price.
+	MaxPrice string `protobuf:"bytes,5,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	// median_price is the median of the provider prices, the price written to
+	// the oracle module.
+	MedianPrice string `protobuf:"bytes,6,opt,name=median_price,json=medianPrice,proto3" json:"median_price,omitempty"`
+	// spread_bps is (max_price - min_price) / median_price, in basis points.
+	SpreadBps uint64 `protobuf:"varint,7,opt,name=spread_bps,json=spreadBps,proto3" json:"spread_bps,omitempty"`
+}
+
+func (m *PriceDispersion) Reset()         { *m = PriceDispersion{} }
+func (m *PriceDispersion) String() string { return proto.CompactTextString(m) }
+func (*PriceDispersion) ProtoMessage()    {}
+func (*PriceDispersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b122a630be17f6b, []int{0}
+}
+func (m *PriceDispersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceDispersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceDispersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceDispersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceDispersion.Merge(m, src)
+}
+func (m *PriceDispersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceDispersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceDispersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceDispersion proto.InternalMessageInfo
+
+func (m *PriceDispersion) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *PriceDispersion) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PriceDispersion) GetProviderCount() uint32 {
+	if m != nil {
+		return m.ProviderCount
+	}
+	return 0
+}
+
+func (m *PriceDispersion) GetMinPrice() string {
+	if m != nil {
+		return m.MinPrice
+	}
+	return ""
+}
+
+func (m *PriceDispersion) GetMaxPrice() string {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return ""
+}
+
+func (m *PriceDispersion) GetMedianPrice() string {
+	if m != nil {
+		return m.MedianPrice
+	}
+	return ""
+}
+
+func (m *PriceDispersion) GetSpreadBps() uint64 {
+	if m != nil {
+		return m.SpreadBps
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PriceDispersion)(nil), "rollinky.attestation.PriceDispersion")
+}
+
+func init() {
+	proto.RegisterFile("rollinky/attestation/price_dispersion.proto", fileDescriptor_5b122a630be17f6b)
+}
+
+var fileDescriptor_5b122a630be17f6b = []byte{
+	// 287 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xc1, 0x4a, 0xf3, 0x40,
+	0x14, 0x85, 0x3b, 0xff, 0x5f, 0xab, 0x9d, 0x26, 0x0a, 0xc1, 0x45, 0x40, 0x1d, 0x52, 0x45, 0x08,
+	0x08, 0xed, 0x42, 0xf0, 0x01, 0xaa, 0x0b, 0x97, 0x25, 0x4b, 0x37, 0xc3, 0x24, 0x19, 0xec, 0xa5,
+	0xc9, 0xcc, 0x30, 0x33, 0x95, 0xe4, 0x2d, 0x7c, 0x2c, 0x97, 0x5d, 0xba, 0x94, 0xe4, 0x35, 0x5c,
+	0x48, 0x27, 0x4d, 0xd1, 0xed, 0xf7, 0x9d, 0xcb, 0x3d, 0x1c, 0x7c, 0xa7, 0x65, 0x51, 0x80, 0x58,
+	0xd7, 0x73, 0x66, 0x2d, 0x37, 0x96, 0x59, 0x90, 0x62, 0xae, 0x34, 0x64, 0x9c, 0xe6, 0x60, 0x14,
+	0xd7, 0x06, 0xa4, 0x98, 0x29, 0x2d, 0xad, 0x0c, 0xce, 0xfb, 0xf0, 0xec, 0x57, 0xf8, 0xfa, 0x1b,
+	0xe1, 0xb3, 0xe5, 0xee, 0xe0, 0xe9, 0x90, 0x0f, 0x6e, 0xb0, 0x9f, 0x6d, 0xb4, 0xe6, 0x22, 0xab,
+	0xa9, 0x62, 0xa0, 0x43, 0x14, 0xa1, 0x78, 0x9c, 0x78, 0x3d, 0x5c, 0x32, 0xd0, 0xc1, 0x14, 0x7b,
+	0x69, 0x21, 0xb3, 0x35, 0x5d, 0x71, 0x78, 0x5d, 0xd9, 0xf0, 0x5f, 0x84, 0xe2, 0x61, 0x32, 0x71,
+	0xec, 0xd9, 0xa1, 0xe0, 0x16, 0x9f, 0x2a, 0x2d, 0xdf, 0x20, 0xe7, 0x9a, 0x66, 0x72, 0x23, 0x6c,
+	0xf8, 0x3f, 0x42, 0xb1, 0x9f, 0xf8, 0x3d, 0x7d, 0xdc, 0xc1, 0xe0, 0x02, 0x8f, 0x4b, 0x10, 0xd4,
+	0xd5, 0x0e, 0x87, 0xee, 0xd5, 0x49, 0x09, 0xc2, 0xb5, 0x72, 0x92, 0x55, 0x7b, 0x79, 0xb4, 0x97,
+	0xac, 0xea, 0xe4, 0x14, 0x7b, 0x25, 0xcf, 0x81, 0xf5, 0xc7, 0x23, 0xe7, 0x27, 0x1d, 0xeb, 0x22,
+	0x57, 0x18, 0x1b, 0xa5, 0x39, 0xcb, 0x69, 0xaa, 0x4c, 0x78, 0xec, 0x4a, 0x8e, 0x3b, 0xb2, 0x50,
+	0x66, 0xf1, 0xf0, 0xd1, 0x10, 0xb4, 0x6d, 0x08, 0xfa, 0x6a, 0x08, 0x7a, 0x6f, 0xc9, 0x60, 0xdb,
+	0x92, 0xc1, 0x67, 0x4b, 0x06, 0x2f, 0x97, 0x87, 0x6d, 0xab, 0x3f, 0xeb, 0xda, 0x5a, 0x71, 0x93,
+	0x8e, 0xdc, 0xa6, 0xf7, 0x3f, 0x03, 0x00, 0xe8, 0x32, 0xaa, 0x32, 0x82, 0x01, 0x00, 0x00,
+}
+
+func (m *PriceDispersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceDispersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceDispersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpreadBps != 0 {
+		i = encodeVarintPriceDispersion(dAtA, i, uint64(m.SpreadBps))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MedianPrice) > 0 {
+		i -= len(m.MedianPrice)
+		copy(dAtA[i:], m.MedianPrice)
+		i = encodeVarintPriceDispersion(dAtA, i, uint64(len(m.MedianPrice)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MaxPrice) > 0 {
+		i -= len(m.MaxPrice)
+		copy(dAtA[i:], m.MaxPrice)
+		i = encodeVarintPriceDispersion(dAtA, i, uint64(len(m.MaxPrice)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MinPrice) > 0 {
+		i -= len(m.MinPrice)
+		copy(dAtA[i:], m.MinPrice)
+		i = encodeVarintPriceDispersion(dAtA, i, uint64(len(m.MinPrice)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProviderCount != 0 {
+		i = encodeVarintPriceDispersion(dAtA, i, uint64(m.ProviderCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintPriceDispersion(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintPriceDispersion(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPriceDispersion(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPriceDispersion(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PriceDispersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovPriceDispersion(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovPriceDispersion(uint64(m.BlockHeight))
+	}
+	if m.ProviderCount != 0 {
+		n += 1 + sovPriceDispersion(uint64(m.ProviderCount))
+	}
+	l = len(m.MinPrice)
+	if l > 0 {
+		n += 1 + l + sovPriceDispersion(uint64(l))
+	}
+	l = len(m.MaxPrice)
+	if l > 0 {
+		n += 1 + l + sovPriceDispersion(uint64(l))
+	}
+	l = len(m.MedianPrice)
+	if l > 0 {
+		n += 1 + l + sovPriceDispersion(uint64(l))
+	}
+	if m.SpreadBps != 0 {
+		n += 1 + sovPriceDispersion(uint64(m.SpreadBps))
+	}
+	return n
+}
+
+func sovPriceDispersion(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPriceDispersion(x uint64) (n int) {
+	return sovPriceDispersion(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PriceDispersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceDispersion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceDispersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceDispersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceDispersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceDispersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceDispersion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceDispersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderCount", wireType)
+			}
+			m.ProviderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceDispersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProviderCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceDispersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceDispersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceDispersion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceDispersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceDispersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceDispersion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceDispersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceDispersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceDispersion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MedianPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadBps", wireType)
+			}
+			m.SpreadBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceDispersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpreadBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceDispersion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceDispersion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPriceDispersion(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPriceDispersion
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceDispersion
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceDispersion
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPriceDispersion
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPriceDispersion
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPriceDispersion
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPriceDispersion        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPriceDispersion          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPriceDispersion = fmt.Errorf("proto: unexpected end of group")
+)
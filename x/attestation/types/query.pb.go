@@ -0,0 +1,2226 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollinky/attestation/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1757cd75043a12, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1757cd75043a12, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryGetPriceDispersionRequest struct {
+	// currency_pair is the currency pair, e.g. "BTC/USD".
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+}
+
+func (m *QueryGetPriceDispersionRequest) Reset()         { *m = QueryGetPriceDispersionRequest{} }
+func (m *QueryGetPriceDispersionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPriceDispersionRequest) ProtoMessage()    {}
+func (*QueryGetPriceDispersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1757cd75043a12, []int{2}
+}
+func (m *QueryGetPriceDispersionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPriceDispersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPriceDispersionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPriceDispersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPriceDispersionRequest.Merge(m, src)
+}
+func (m *QueryGetPriceDispersionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPriceDispersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPriceDispersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPriceDispersionRequest proto.InternalMessageInfo
+
+func (m *QueryGetPriceDispersionRequest) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+type QueryGetPriceDispersionResponse struct {
+	PriceDispersion PriceDispersion `protobuf:"bytes,1,opt,name=price_dispersion,json=priceDispersion,proto3" json:"price_dispersion"`
+}
+
+func (m *QueryGetPriceDispersionResponse) Reset()         { *m = QueryGetPriceDispersionResponse{} }
+func (m *QueryGetPriceDispersionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPriceDispersionResponse) ProtoMessage()    {}
+func (*QueryGetPriceDispersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1757cd75043a12, []int{3}
+}
+func (m *QueryGetPriceDispersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPriceDispersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPriceDispersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPriceDispersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPriceDispersionResponse.Merge(m, src)
+}
+func (m *QueryGetPriceDispersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPriceDispersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPriceDispersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPriceDispersionResponse proto.InternalMessageInfo
+
+func (m *QueryGetPriceDispersionResponse) GetPriceDispersion() PriceDispersion {
+	if m != nil {
+		return m.PriceDispersion
+	}
+	return PriceDispersion{}
+}
+
+type QueryAllPriceDispersionRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPriceDispersionRequest) Reset()         { *m = QueryAllPriceDispersionRequest{} }
+func (m *QueryAllPriceDispersionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPriceDispersionRequest) ProtoMessage()    {}
+func (*QueryAllPriceDispersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1757cd75043a12, []int{4}
+}
+func (m *QueryAllPriceDispersionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPriceDispersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPriceDispersionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPriceDispersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPriceDispersionRequest.Merge(m, src)
+}
+func (m *QueryAllPriceDispersionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPriceDispersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPriceDispersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPriceDispersionRequest proto.InternalMessageInfo
+
+func (m *QueryAllPriceDispersionRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllPriceDispersionResponse struct {
+	PriceDispersion []PriceDispersion   `protobuf:"bytes,1,rep,name=price_dispersion,json=priceDispersion,proto3" json:"price_dispersion"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPriceDispersionResponse) Reset()         { *m = QueryAllPriceDispersionResponse{} }
+func (m *QueryAllPriceDispersionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPriceDispersionResponse) ProtoMessage()    {}
+func (*QueryAllPriceDispersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1757cd75043a12, []int{5}
+}
+func (m *QueryAllPriceDispersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPriceDispersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPriceDispersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPriceDispersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPriceDispersionResponse.Merge(m, src)
+}
+func (m *QueryAllPriceDispersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPriceDispersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPriceDispersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPriceDispersionResponse proto.InternalMessageInfo
+
+func (m *QueryAllPriceDispersionResponse) GetPriceDispersion() []PriceDispersion {
+	if m != nil {
+		return m.PriceDispersion
+	}
+	return nil
+}
+
+func (m *QueryAllPriceDispersionResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAttestationAtHeightRequest struct {
+	// block_height is the height the prices were written at.
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *QueryAttestationAtHeightRequest) Reset()         { *m = QueryAttestationAtHeightRequest{} }
+func (m *QueryAttestationAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationAtHeightRequest) ProtoMessage()    {}
+func (*QueryAttestationAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1757cd75043a12, []int{6}
+}
+func (m *QueryAttestationAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationAtHeightRequest.Merge(m, src)
+}
+func (m *QueryAttestationAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationAtHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryAttestationAtHeightRequest) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+type QueryAttestationAtHeightResponse struct {
+	AttestationRecord AttestationRecord `protobuf:"bytes,1,opt,name=attestation_record,json=attestationRecord,proto3" json:"attestation_record"`
+}
+
+func (m *QueryAttestationAtHeightResponse) Reset()         { *m = QueryAttestationAtHeightResponse{} }
+func (m *QueryAttestationAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationAtHeightResponse) ProtoMessage()    {}
+func (*QueryAttestationAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1757cd75043a12, []int{7}
+}
+func (m *QueryAttestationAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationAtHeightResponse.Merge(m, src)
+}
+func (m *QueryAttestationAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationAtHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationAtHeightResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationAtHeightResponse) GetAttestationRecord() AttestationRecord {
+	if m != nil {
+		return m.AttestationRecord
+	}
+	return AttestationRecord{}
+}
+
+type QueryAllAttestationRecordRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAttestationRecordRequest) Reset()         { *m = QueryAllAttestationRecordRequest{} }
+func (m *QueryAllAttestationRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAttestationRecordRequest) ProtoMessage()    {}
+func (*QueryAllAttestationRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1757cd75043a12, []int{8}
+}
+func (m *QueryAllAttestationRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAttestationRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAttestationRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAttestationRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAttestationRecordRequest.Merge(m, src)
+}
+func (m *QueryAllAttestationRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAttestationRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAttestationRecordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAttestationRecordRequest proto.InternalMessageInfo
+
+func (m *QueryAllAttestationRecordRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllAttestationRecordResponse struct {
+	AttestationRecord []AttestationRecord `protobuf:"bytes,1,rep,name=attestation_record,json=attestationRecord,proto3" json:"attestation_record"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAttestationRecordResponse) Reset()         { *m = QueryAllAttestationRecordResponse{} }
+func (m *QueryAllAttestationRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAttestationRecordResponse) ProtoMessage()    {}
+func (*QueryAllAttestationRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1757cd75043a12, []int{9}
+}
+func (m *QueryAllAttestationRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAttestationRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAttestationRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAttestationRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAttestationRecordResponse.Merge(m, src)
+}
+func (m *QueryAllAttestationRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAttestationRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAttestationRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAttestationRecordResponse proto.InternalMessageInfo
+
+func (m *QueryAllAttestationRecordResponse) GetAttestationRecord() []AttestationRecord {
+	if m != nil {
+		return m.AttestationRecord
+	}
+	return nil
+}
+
+func (m *QueryAllAttestationRecordResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "rollinky.attestation.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "rollinky.attestation.QueryParamsResponse")
+	proto.RegisterType((*QueryGetPriceDispersionRequest)(nil), "rollinky.attestation.QueryGetPriceDispersionRequest")
+	proto.RegisterType((*QueryGetPriceDispersionResponse)(nil), "rollinky.attestation.QueryGetPriceDispersionResponse")
+	proto.RegisterType((*QueryAllPriceDispersionRequest)(nil), "rollinky.attestation.QueryAllPriceDispersionRequest")
+	proto.RegisterType((*QueryAllPriceDispersionResponse)(nil), "rollinky.attestation.QueryAllPriceDispersionResponse")
+	proto.RegisterType((*QueryAttestationAtHeightRequest)(nil), "rollinky.attestation.QueryAttestationAtHeightRequest")
+	proto.RegisterType((*QueryAttestationAtHeightResponse)(nil), "rollinky.attestation.QueryAttestationAtHeightResponse")
+	proto.RegisterType((*QueryAllAttestationRecordRequest)(nil), "rollinky.attestation.QueryAllAttestationRecordRequest")
+	proto.RegisterType((*QueryAllAttestationRecordResponse)(nil), "rollinky.attestation.QueryAllAttestationRecordResponse")
+}
+
+func init() { proto.RegisterFile("rollinky/attestation/query.proto", fileDescriptor_5e1757cd75043a12) }
+
+var fileDescriptor_5e1757cd75043a12 = []byte{
+	// 692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xde, 0x69, 0xeb, 0x42, 0xa7, 0x95, 0xda, 0xe9, 0x1e, 0x24, 0x2c, 0x69, 0x1b, 0xb5, 0x3f,
+	0x31, 0x63, 0xab, 0x5d, 0x8f, 0xd2, 0x52, 0xad, 0xc7, 0x35, 0x87, 0x1e, 0x44, 0x58, 0x66, 0xd3,
+	0x21, 0x8d, 0x4d, 0x33, 0xe9, 0x4c, 0x2a, 0x2e, 0x22, 0x88, 0xfe, 0x03, 0x82, 0xff, 0x84, 0x27,
+	0x11, 0x8f, 0xe2, 0xc9, 0x53, 0xf1, 0x54, 0xf0, 0xe2, 0x49, 0xa4, 0x15, 0x3c, 0xfa, 0x2f, 0x48,
+	0x26, 0x93, 0x76, 0xb3, 0x9b, 0xa4, 0xbb, 0xb8, 0x97, 0x65, 0x78, 0xf9, 0xde, 0xfb, 0xbe, 0xef,
+	0xe5, 0xcd, 0xdb, 0xc0, 0x19, 0xce, 0x3c, 0xcf, 0xf5, 0xf7, 0x5a, 0x98, 0x84, 0x21, 0x15, 0x21,
+	0x09, 0x5d, 0xe6, 0xe3, 0x83, 0x43, 0xca, 0x5b, 0x66, 0xc0, 0x59, 0xc8, 0x50, 0x25, 0x41, 0x98,
+	0x6d, 0x08, 0x6d, 0x92, 0xec, 0xbb, 0x3e, 0xc3, 0xf2, 0x37, 0x06, 0x6a, 0x15, 0x87, 0x39, 0x4c,
+	0x1e, 0x71, 0x74, 0x52, 0xd1, 0xaa, 0xc3, 0x98, 0xe3, 0x51, 0x4c, 0x02, 0x17, 0x13, 0xdf, 0x67,
+	0x71, 0xbe, 0x50, 0x4f, 0x97, 0x6c, 0x26, 0xf6, 0x99, 0xc0, 0x4d, 0x22, 0x68, 0xcc, 0x8a, 0x9f,
+	0xad, 0x34, 0x69, 0x48, 0x56, 0x70, 0x40, 0x1c, 0xd7, 0x97, 0x60, 0x85, 0xbd, 0x99, 0x29, 0xb5,
+	0xed, 0xdc, 0xe0, 0xd4, 0x66, 0x7c, 0x47, 0xc1, 0x67, 0x33, 0xe1, 0x01, 0xe1, 0x64, 0x3f, 0x61,
+	0x5f, 0xce, 0x86, 0x70, 0xd7, 0xa6, 0x8d, 0x1d, 0x57, 0x04, 0x94, 0x8b, 0x33, 0x7a, 0xa3, 0x02,
+	0xd1, 0xa3, 0x48, 0x60, 0x5d, 0x56, 0xb0, 0xe8, 0xc1, 0x21, 0x15, 0xa1, 0xb1, 0x0d, 0xa7, 0x52,
+	0x51, 0x11, 0x30, 0x5f, 0x50, 0x74, 0x0f, 0x96, 0x63, 0xa6, 0xab, 0x60, 0x06, 0x2c, 0x8c, 0xad,
+	0x56, 0xcd, 0xac, 0x2e, 0x9a, 0x71, 0xd6, 0xc6, 0xe8, 0xd1, 0xcf, 0xe9, 0xd2, 0xfb, 0x3f, 0x1f,
+	0x97, 0x80, 0xa5, 0xd2, 0x8c, 0xfb, 0x50, 0x97, 0x75, 0xb7, 0x68, 0x58, 0x8f, 0xf4, 0x6c, 0x9e,
+	0xc9, 0x51, 0xcc, 0xe8, 0x1a, 0xbc, 0x6c, 0x1f, 0x72, 0x4e, 0x7d, 0xbb, 0xd5, 0x08, 0x88, 0xcb,
+	0x25, 0xd3, 0xa8, 0x35, 0x9e, 0x04, 0xeb, 0xc4, 0xe5, 0x46, 0x0b, 0x4e, 0xe7, 0x96, 0x51, 0x52,
+	0xb7, 0xe1, 0x95, 0x4e, 0xc7, 0x4a, 0xf4, 0x8d, 0x1c, 0xd1, 0xe9, 0x42, 0x1b, 0x23, 0x91, 0x7a,
+	0x6b, 0x22, 0x48, 0x87, 0x8d, 0x5d, 0xe5, 0x60, 0xdd, 0xf3, 0x72, 0x1c, 0x3c, 0x80, 0xf0, 0xfc,
+	0x25, 0x2b, 0xce, 0x39, 0x33, 0x9e, 0x08, 0x33, 0x9a, 0x08, 0x33, 0x9e, 0x43, 0x35, 0x11, 0x66,
+	0x9d, 0x38, 0x54, 0xe5, 0x5a, 0x6d, 0x99, 0xc6, 0x57, 0x00, 0xa7, 0x73, 0xa9, 0x0a, 0x5d, 0x0e,
+	0xff, 0xaf, 0x4b, 0xb4, 0x95, 0xf2, 0x30, 0x24, 0x3d, 0xcc, 0x5f, 0xe8, 0x21, 0x16, 0x95, 0x32,
+	0xb1, 0x99, 0x78, 0x38, 0xd7, 0xb0, 0x1e, 0x3e, 0xa4, 0xae, 0xb3, 0x1b, 0x26, 0xfd, 0x9a, 0x85,
+	0xe3, 0x4d, 0x8f, 0xd9, 0x7b, 0x8d, 0x5d, 0x19, 0x96, 0x1d, 0x1b, 0xb1, 0xc6, 0x64, 0x2c, 0x46,
+	0x1a, 0xaf, 0x00, 0x9c, 0xc9, 0x2f, 0xa3, 0x7a, 0xf1, 0x04, 0xa2, 0xee, 0x5b, 0xa3, 0xfa, 0x3f,
+	0x9f, 0xdd, 0x8d, 0xb6, 0x72, 0x96, 0x84, 0xab, 0x7e, 0x4c, 0x92, 0xce, 0x07, 0xc6, 0xd3, 0x44,
+	0x81, 0xe7, 0x75, 0x65, 0x0d, 0xfa, 0xcd, 0x7f, 0x03, 0x70, 0xb6, 0x80, 0xec, 0x02, 0xbf, 0xc3,
+	0x83, 0xf0, 0x3b, 0xb0, 0x09, 0x58, 0xfd, 0x5b, 0x86, 0x97, 0xa4, 0x19, 0xf4, 0x06, 0xc0, 0x72,
+	0xbc, 0x1a, 0xd0, 0x42, 0xb6, 0xbe, 0xee, 0x4d, 0xa4, 0x2d, 0xf6, 0x80, 0x8c, 0x59, 0x8d, 0xeb,
+	0xaf, 0xbf, 0xff, 0x7e, 0x37, 0xa4, 0xa3, 0x2a, 0x2e, 0xd8, 0x91, 0xe8, 0x03, 0x80, 0x13, 0x1d,
+	0xb7, 0x00, 0xdd, 0x29, 0x20, 0xc9, 0x5d, 0x55, 0xda, 0x5a, 0x9f, 0x59, 0x4a, 0xa6, 0x29, 0x65,
+	0x2e, 0xa0, 0x39, 0xdc, 0xd3, 0x9e, 0x46, 0x9f, 0x00, 0x44, 0x1d, 0xb5, 0xd6, 0x3d, 0xaf, 0x50,
+	0x73, 0xee, 0x72, 0xd2, 0xd6, 0xfa, 0xcc, 0x52, 0x9a, 0xb1, 0xd4, 0xbc, 0x88, 0xe6, 0x7b, 0xd3,
+	0x2c, 0xd0, 0x17, 0x00, 0xa7, 0x32, 0x2e, 0x2b, 0x2a, 0xe4, 0xcf, 0xdd, 0x11, 0x5a, 0xad, 0xdf,
+	0x34, 0xa5, 0xbb, 0x26, 0x75, 0xdf, 0x42, 0x26, 0xce, 0xf9, 0x97, 0x55, 0x4b, 0x07, 0xbf, 0x68,
+	0x5f, 0x41, 0x2f, 0xd1, 0x67, 0x00, 0x2b, 0x5d, 0x97, 0x25, 0xea, 0x7a, 0xad, 0xb8, 0x7f, 0x79,
+	0xab, 0x41, 0xbb, 0xdb, 0x77, 0x9e, 0x72, 0xb0, 0x22, 0x1d, 0x2c, 0xa3, 0x45, 0xdc, 0xe3, 0x77,
+	0x82, 0xd8, 0xa8, 0x1d, 0x9d, 0xe8, 0xe0, 0xf8, 0x44, 0x07, 0xbf, 0x4e, 0x74, 0xf0, 0xf6, 0x54,
+	0x2f, 0x1d, 0x9f, 0xea, 0xa5, 0x1f, 0xa7, 0x7a, 0xe9, 0x71, 0xf5, 0xac, 0xc6, 0xf3, 0x54, 0x95,
+	0xb0, 0x15, 0x50, 0xd1, 0x2c, 0xcb, 0x2f, 0x82, 0xdb, 0xff, 0x06, 0x00, 0xfd, 0xa6, 0x9f, 0x8d,
+	0x3d, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a PriceDispersion by currency pair.
+	PriceDispersion(ctx context.Context, in *QueryGetPriceDispersionRequest, opts ...grpc.CallOption) (*QueryGetPriceDispersionResponse, error)
+	// Queries a list of PriceDispersion items.
+	PriceDispersionAll(ctx context.Context, in *QueryAllPriceDispersionRequest, opts ...grpc.CallOption) (*QueryAllPriceDispersionResponse, error)
+	// Queries the AttestationRecord of the prices written at a height.
+	AttestationAtHeight(ctx context.Context, in *QueryAttestationAtHeightRequest, opts ...grpc.CallOption) (*QueryAttestationAtHeightResponse, error)
+	// Queries a list of AttestationRecord items.
+	AttestationRecordAll(ctx context.Context, in *QueryAllAttestationRecordRequest, opts ...grpc.CallOption) (*QueryAllAttestationRecordResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/rollinky.attestation.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceDispersion(ctx context.Context, in *QueryGetPriceDispersionRequest, opts ...grpc.CallOption) (*QueryGetPriceDispersionResponse, error) {
+	out := new(QueryGetPriceDispersionResponse)
+	err := c.cc.Invoke(ctx, "/rollinky.attestation.Query/PriceDispersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceDispersionAll(ctx context.Context, in *QueryAllPriceDispersionRequest, opts ...grpc.CallOption) (*QueryAllPriceDispersionResponse, error) {
+	out := new(QueryAllPriceDispersionResponse)
+	err := c.cc.Invoke(ctx, "/rollinky.attestation.Query/PriceDispersionAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationAtHeight(ctx context.Context, in *QueryAttestationAtHeightRequest, opts ...grpc.CallOption) (*QueryAttestationAtHeightResponse, error) {
+	out := new(QueryAttestationAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/rollinky.attestation.Query/AttestationAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationRecordAll(ctx context.Context, in *QueryAllAttestationRecordRequest, opts ...grpc.CallOption) (*QueryAllAttestationRecordResponse, error) {
+	out := new(QueryAllAttestationRecordResponse)
+	err := c.cc.Invoke(ctx, "/rollinky.attestation.Query/AttestationRecordAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a PriceDispersion by currency pair.
+	PriceDispersion(context.Context, *QueryGetPriceDispersionRequest) (*QueryGetPriceDispersionResponse, error)
+	// Queries a list of PriceDispersion items.
+	PriceDispersionAll(context.Context, *QueryAllPriceDispersionRequest) (*QueryAllPriceDispersionResponse, error)
+	// Queries the AttestationRecord of the prices written at a height.
+	AttestationAtHeight(context.Context, *QueryAttestationAtHeightRequest) (*QueryAttestationAtHeightResponse, error)
+	// Queries a list of AttestationRecord items.
+	AttestationRecordAll(context.Context, *QueryAllAttestationRecordRequest) (*QueryAllAttestationRecordResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) PriceDispersion(ctx context.Context, req *QueryGetPriceDispersionRequest) (*QueryGetPriceDispersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceDispersion not implemented")
+}
+func (*UnimplementedQueryServer) PriceDispersionAll(ctx context.Context, req *QueryAllPriceDispersionRequest) (*QueryAllPriceDispersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceDispersionAll not implemented")
+}
+func (*UnimplementedQueryServer) AttestationAtHeight(ctx context.Context, req *QueryAttestationAtHeightRequest) (*QueryAttestationAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) AttestationRecordAll(ctx context.Context, req *QueryAllAttestationRecordRequest) (*QueryAllAttestationRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationRecordAll not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollinky.attestation.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceDispersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPriceDispersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceDispersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollinky.attestation.Query/PriceDispersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceDispersion(ctx, req.(*QueryGetPriceDispersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceDispersionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPriceDispersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceDispersionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollinky.attestation.Query/PriceDispersionAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceDispersionAll(ctx, req.(*QueryAllPriceDispersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollinky.attestation.Query/AttestationAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationAtHeight(ctx, req.(*QueryAttestationAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationRecordAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAttestationRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationRecordAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollinky.attestation.Query/AttestationRecordAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationRecordAll(ctx, req.(*QueryAllAttestationRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rollinky.attestation.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PriceDispersion",
+			Handler:    _Query_PriceDispersion_Handler,
+		},
+		{
+			MethodName: "PriceDispersionAll",
+			Handler:    _Query_PriceDispersionAll_Handler,
+		},
+		{
+			MethodName: "AttestationAtHeight",
+			Handler:    _Query_AttestationAtHeight_Handler,
+		},
+		{
+			MethodName: "AttestationRecordAll",
+			Handler:    _Query_AttestationRecordAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rollinky/attestation/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPriceDispersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPriceDispersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPriceDispersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPriceDispersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPriceDispersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPriceDispersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PriceDispersion.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPriceDispersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPriceDispersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPriceDispersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPriceDispersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPriceDispersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPriceDispersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PriceDispersion) > 0 {
+		for iNdEx := len(m.PriceDispersion) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceDispersion[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AttestationRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAttestationRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAttestationRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAttestationRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAttestationRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAttestationRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAttestationRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AttestationRecord) > 0 {
+		for iNdEx := len(m.AttestationRecord) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttestationRecord[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPriceDispersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPriceDispersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PriceDispersion.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPriceDispersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPriceDispersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceDispersion) > 0 {
+		for _, e := range m.PriceDispersion {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QueryAttestationAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AttestationRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAttestationRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAttestationRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AttestationRecord) > 0 {
+		for _, e := range m.AttestationRecord {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPriceDispersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPriceDispersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPriceDispersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPriceDispersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPriceDispersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPriceDispersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDispersion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceDispersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPriceDispersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPriceDispersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPriceDispersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPriceDispersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPriceDispersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPriceDispersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDispersion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDispersion = append(m.PriceDispersion, PriceDispersion{})
+			if err := m.PriceDispersion[len(m.PriceDispersion)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AttestationRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAttestationRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAttestationRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAttestationRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAttestationRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAttestationRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAttestationRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationRecord = append(m.AttestationRecord, AttestationRecord{})
+			if err := m.AttestationRecord[len(m.AttestationRecord)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	attestationtypes "rollinky/x/attestation/types"
+	"rollinky/x/oraclecircuit/types"
+)
+
+// EvaluateCircuit trips the circuit if the oracle went stale and resets it
+// once prices are fresh again, unless governance overrides it. A trip or reset
+// takes effect for the transactions of the next block. It never fails, so that
+// the circuit can't halt the chain, errors from the circuit breaker are only
+// logged.
+func (k Keeper) EvaluateCircuit(ctx context.Context) {
+	params := k.GetParams(ctx)
+	state, _ := k.GetCircuitState(ctx)
+
+	var (
+		trip         bool
+		reason, pair string
+	)
+	switch state.Override {
+	case types.OVERRIDE_TRIPPED:
+		trip, reason = true, types.ReasonGovernanceOverride
+	case types.OVERRIDE_RESET:
+		trip, reason = false, types.ReasonGovernanceOverride
+	default:
+		reason, pair = k.staleness(ctx, params, state)
+		trip = reason != ""
+		if !trip {
+			reason = types.ReasonPricesResumed
+		}
+	}
+
+	switch {
+	case trip && !state.Tripped:
+		k.trip(ctx, params, state, reason, pair)
+	case !trip && state.Tripped:
+		k.reset(ctx, state, reason)
+	}
+}
+
+// staleness returns why the oracle is stale, and the stale currency pair if
+// it is a price that is, or an empty reason if it is healthy.
+func (k Keeper) staleness(ctx context.Context, params types.Params, state types.CircuitState) (reason, pair string) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	height := uint64(sdkCtx.BlockHeight()) //nolint:gosec
+	if params.MaxMissedBlocks > 0 && height >= state.LastUpdateHeight+params.MaxMissedBlocks {
+		return types.ReasonMissedUpdates, ""
+	}
+
+	if params.MaxPriceAge == 0 {
+		return "", ""
+	}
+
+	pairs := params.CurrencyPairs
+	if len(pairs) == 0 {
+		for _, cp := range k.oracleKeeper.GetAllCurrencyPairs(ctx) {
+			pairs = append(pairs, cp.String())
+		}
+	}
+
+	for _, pair := range pairs {
+		// a monitored pair the oracle has never priced is stale too.
+		status, found := k.GetPairStatus(ctx, pair)
+		if !found || sdkCtx.BlockTime().Sub(status.LastUpdateTime) > params.MaxPriceAge {
+			return types.ReasonStalePrice, pair
+		}
+	}
+
+	return "", ""
+}
+
+// trip disables the configured messages in the circuit breaker. Messages that
+// are already disabled are left alone, so that resetting the circuit doesn't
+// re-enable what someone else disabled.
+func (k Keeper) trip(ctx context.Context, params types.Params, state types.CircuitState, reason, pair string) {
+	var disabled []string
+	for _, msgURL := range params.MsgTypeUrls {
+		allowed, err := k.circuitKeeper.IsAllowed(ctx, msgURL)
+		if err != nil {
+			k.Logger().Error("failed to check circuit breaker", "msg_type_url", msgURL, "err", err)
+			continue
+		}
+		if !allowed {
+			continue
+		}
+
+		if err := k.circuitKeeper.DisableMsg(ctx, msgURL); err != nil {
+			k.Logger().Error("failed to disable message", "msg_type_url", msgURL, "err", err)
+			continue
+		}
+		disabled = append(disabled, msgURL)
+	}
+
+	state.Tripped = true
+	state.Reason = reason
+	state.CurrencyPair = pair
+	state.DisabledMsgTypeUrls = disabled
+	k.SetCircuitState(ctx, state)
+
+	id := k.appendRecord(ctx, true, reason, pair, disabled)
+	k.Logger().Info("oracle circuit tripped", "reason", reason, "currency_pair", pair, "msg_type_urls", disabled)
+	attestationtypes.EmitEvent(ctx, k.Logger(), &types.EventCircuitTripped{
+		Reason:       reason,
+		CurrencyPair: pair,
+		MsgTypeUrls:  disabled,
+		RecordId:     id,
+	})
+}
+
+// reset re-enables the messages the circuit disabled.
+func (k Keeper) reset(ctx context.Context, state types.CircuitState, reason string) {
+	var enabled []string
+	for _, msgURL := range state.DisabledMsgTypeUrls {
+		if err := k.circuitKeeper.EnableMsg(ctx, msgURL); err != nil {
+			k.Logger().Error("failed to enable message", "msg_type_url", msgURL, "err", err)
+			continue
+		}
+		enabled = append(enabled, msgURL)
+	}
+
+	state.Tripped = false
+	state.Reason = ""
+	state.CurrencyPair = ""
+	state.DisabledMsgTypeUrls = nil
+	k.SetCircuitState(ctx, state)
+
+	id := k.appendRecord(ctx, false, reason, "", enabled)
+	k.Logger().Info("oracle circuit reset", "reason", reason, "msg_type_urls", enabled)
+	attestationtypes.EmitEvent(ctx, k.Logger(), &types.EventCircuitReset{
+		Reason:      reason,
+		MsgTypeUrls: enabled,
+		RecordId:    id,
+	})
+}
+
+func (k Keeper) appendRecord(ctx context.Context, tripped bool, reason, pair string, msgTypeUrls []string) uint64 {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return k.AppendCircuitRecord(ctx, types.CircuitRecord{
+		Tripped:      tripped,
+		Reason:       reason,
+		CurrencyPair: pair,
+		MsgTypeUrls:  msgTypeUrls,
+		BlockHeight:  uint64(sdkCtx.BlockHeight()), //nolint:gosec
+		BlockTime:    sdkCtx.BlockTime(),
+	})
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"rollinky/x/oraclecircuit/types"
+)
+
+// GetCircuitRecordCount get the total number of circuitRecord
+func (k Keeper) GetCircuitRecordCount(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.CircuitRecordCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetCircuitRecordCount set the total number of circuitRecord
+func (k Keeper) SetCircuitRecordCount(ctx context.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.CircuitRecordCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendCircuitRecord appends a circuitRecord in the store with a new id and update the count
+func (k Keeper) AppendCircuitRecord(
+	ctx context.Context,
+	circuitRecord types.CircuitRecord,
+) uint64 {
+	// Create the circuitRecord
+	count := k.GetCircuitRecordCount(ctx)
+
+	// Set the ID of the appended value
+	circuitRecord.Id = count
+
+	k.SetCircuitRecord(ctx, circuitRecord)
+
+	// Update circuitRecord count
+	k.SetCircuitRecordCount(ctx, count+1)
+
+	return count
+}
+
+// SetCircuitRecord set a specific circuitRecord in the store
+func (k Keeper) SetCircuitRecord(ctx context.Context, circuitRecord types.CircuitRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.CircuitRecordKey))
+	b := k.cdc.MustMarshal(&circuitRecord)
+	store.Set(types.GetCircuitRecordIDBytes(circuitRecord.Id), b)
+}
+
+// GetCircuitRecord returns a circuitRecord from its id
+func (k Keeper) GetCircuitRecord(ctx context.Context, id uint64) (val types.CircuitRecord, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.CircuitRecordKey))
+	b := store.Get(types.GetCircuitRecordIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveCircuitRecord removes a circuitRecord from the store
+func (k Keeper) RemoveCircuitRecord(ctx context.Context, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.CircuitRecordKey))
+	store.Delete(types.GetCircuitRecordIDBytes(id))
+}
+
+// GetAllCircuitRecord returns all circuitRecord
+func (k Keeper) GetAllCircuitRecord(ctx context.Context) (list []types.CircuitRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.CircuitRecordKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CircuitRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"rollinky/x/oraclecircuit/types"
+)
+
+// SetCircuitState set circuitState in the store
+func (k Keeper) SetCircuitState(ctx context.Context, circuitState types.CircuitState) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.CircuitStateKey))
+	b := k.cdc.MustMarshal(&circuitState)
+	store.Set([]byte{0}, b)
+}
+
+// GetCircuitState returns circuitState
+func (k Keeper) GetCircuitState(ctx context.Context) (val types.CircuitState, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.CircuitStateKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
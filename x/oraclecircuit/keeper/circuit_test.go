@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/x/oraclecircuit/keeper"
+	"rollinky/x/oraclecircuit/types"
+)
+
+const (
+	sendURL  = "/cosmos.bank.v1beta1.MsgSend"
+	tradeURL = "/rollinky.trade.MsgTrade"
+)
+
+var (
+	genesisTime = time.Unix(1700000000, 0).UTC()
+	btcUSD      = connecttypes.NewCurrencyPair("BTC", "USD")
+)
+
+func setupCircuit(t *testing.T, params types.Params) (keeper.Keeper, sdk.Context, *keepertest.MockCircuitKeeper) {
+	t.Helper()
+
+	circuit := keepertest.NewMockCircuitKeeper()
+	k, ctx := keepertest.OraclecircuitKeeperWithMocks(t, circuit, &keepertest.MockOracleKeeper{
+		CurrencyPairs: []connecttypes.CurrencyPair{btcUSD},
+	})
+	require.NoError(t, k.SetParams(ctx, params))
+
+	return k, ctx.WithBlockHeight(1).WithBlockTime(genesisTime), circuit
+}
+
+func writePrice(t *testing.T, k keeper.Keeper, ctx sdk.Context) {
+	t.Helper()
+
+	require.NoError(t, k.Hooks().AfterPricesUpdated(ctx, map[connecttypes.CurrencyPair]oracletypes.QuotePrice{
+		btcUSD: {Price: math.NewInt(100), BlockTimestamp: ctx.BlockTime()},
+	}))
+}
+
+func TestEvaluateCircuitMissedUpdates(t *testing.T) {
+	k, ctx, circuit := setupCircuit(t, types.NewParams(3, 0, nil, []string{sendURL, tradeURL}))
+
+	// a message disabled by someone else is left alone by the circuit.
+	require.NoError(t, circuit.DisableMsg(ctx, tradeURL))
+
+	writePrice(t, k, ctx)
+	k.EvaluateCircuit(ctx)
+	state, _ := k.GetCircuitState(ctx)
+	require.False(t, state.Tripped)
+
+	ctx = ctx.WithBlockHeight(3)
+	k.EvaluateCircuit(ctx)
+	state, _ = k.GetCircuitState(ctx)
+	require.False(t, state.Tripped)
+
+	ctx = ctx.WithBlockHeight(4)
+	k.EvaluateCircuit(ctx)
+	state, _ = k.GetCircuitState(ctx)
+	require.True(t, state.Tripped)
+	require.Equal(t, types.ReasonMissedUpdates, state.Reason)
+	require.Equal(t, []string{sendURL}, state.DisabledMsgTypeUrls)
+	require.True(t, circuit.Disabled[sendURL])
+
+	// the circuit stays tripped without another record.
+	ctx = ctx.WithBlockHeight(5)
+	k.EvaluateCircuit(ctx)
+	require.Equal(t, uint64(1), k.GetCircuitRecordCount(ctx))
+
+	writePrice(t, k, ctx)
+	k.EvaluateCircuit(ctx)
+	state, _ = k.GetCircuitState(ctx)
+	require.False(t, state.Tripped)
+	require.Empty(t, state.DisabledMsgTypeUrls)
+	require.False(t, circuit.Disabled[sendURL])
+	require.True(t, circuit.Disabled[tradeURL])
+
+	records := k.GetAllCircuitRecord(ctx)
+	require.Len(t, records, 2)
+	require.Equal(t, types.CircuitRecord{
+		Id:          0,
+		Tripped:     true,
+		Reason:      types.ReasonMissedUpdates,
+		MsgTypeUrls: []string{sendURL},
+		BlockHeight: 4,
+		BlockTime:   genesisTime,
+	}, records[0])
+	require.Equal(t, types.CircuitRecord{
+		Id:          1,
+		Tripped:     false,
+		Reason:      types.ReasonPricesResumed,
+		MsgTypeUrls: []string{sendURL},
+		BlockHeight: 5,
+		BlockTime:   genesisTime,
+	}, records[1])
+}
+
+func TestEvaluateCircuitStalePrice(t *testing.T) {
+	for _, tc := range []struct {
+		desc  string
+		pairs []string
+		pair  string
+	}{
+		{
+			desc: "every oracle pair",
+			pair: "BTC/USD",
+		},
+		{
+			desc:  "configured pairs",
+			pairs: []string{"BTC/USD", "ETH/USD"},
+			pair:  "ETH/USD",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx, circuit := setupCircuit(t, types.NewParams(0, time.Minute, tc.pairs, []string{sendURL}))
+
+			writePrice(t, k, ctx)
+			require.NoError(t, k.Hooks().AfterPriceMissed(ctx, connecttypes.NewCurrencyPair("ETH", "USD")))
+			k.EvaluateCircuit(ctx)
+			state, _ := k.GetCircuitState(ctx)
+			require.False(t, state.Tripped)
+
+			ctx = ctx.WithBlockHeight(2).WithBlockTime(genesisTime.Add(time.Minute + time.Second))
+			if tc.pairs == nil {
+				// a missed pair keeps the time it was first seen at.
+				require.NoError(t, k.Hooks().AfterPriceMissed(ctx, connecttypes.NewCurrencyPair("ETH", "USD")))
+			} else {
+				writePrice(t, k, ctx)
+			}
+			k.EvaluateCircuit(ctx)
+			state, _ = k.GetCircuitState(ctx)
+			require.True(t, state.Tripped)
+			require.Equal(t, types.ReasonStalePrice, state.Reason)
+			require.Equal(t, tc.pair, state.CurrencyPair)
+			require.True(t, circuit.Disabled[sendURL])
+		})
+	}
+}
+
+func TestEvaluateCircuitOverride(t *testing.T) {
+	k, ctx, circuit := setupCircuit(t, types.NewParams(1, 0, nil, []string{sendURL}))
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	_, err := msgServer.SetOverride(ctx, &types.MsgSetOverride{Authority: "invalid", Override: types.OVERRIDE_TRIPPED})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	writePrice(t, k, ctx)
+	_, err = msgServer.SetOverride(ctx, &types.MsgSetOverride{Authority: k.GetAuthority(), Override: types.OVERRIDE_TRIPPED})
+	require.NoError(t, err)
+	k.EvaluateCircuit(ctx)
+	state, _ := k.GetCircuitState(ctx)
+	require.True(t, state.Tripped)
+	require.Equal(t, types.ReasonGovernanceOverride, state.Reason)
+	require.True(t, circuit.Disabled[sendURL])
+
+	// prices going stale doesn't reset a forced reset.
+	_, err = msgServer.SetOverride(ctx, &types.MsgSetOverride{Authority: k.GetAuthority(), Override: types.OVERRIDE_RESET})
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(10)
+	k.EvaluateCircuit(ctx)
+	state, _ = k.GetCircuitState(ctx)
+	require.False(t, state.Tripped)
+	require.False(t, circuit.Disabled[sendURL])
+
+	_, err = msgServer.SetOverride(ctx, &types.MsgSetOverride{Authority: k.GetAuthority(), Override: types.OVERRIDE_UNSPECIFIED})
+	require.NoError(t, err)
+	k.EvaluateCircuit(ctx)
+	state, _ = k.GetCircuitState(ctx)
+	require.True(t, state.Tripped)
+	require.Equal(t, types.ReasonMissedUpdates, state.Reason)
+}
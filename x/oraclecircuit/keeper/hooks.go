@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+
+	attestationtypes "rollinky/x/attestation/types"
+	"rollinky/x/oraclecircuit/types"
+)
+
+var _ attestationtypes.OraclePriceHooks = Hooks{}
+
+// Hooks tracks when prices were last written, for the circuit to know how
+// stale they are.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the oracle price hooks of the keeper.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterPricesUpdated records the block as the last one with prices, and the
+// time of every written price.
+func (h Hooks) AfterPricesUpdated(ctx context.Context, prices map[connecttypes.CurrencyPair]oracletypes.QuotePrice) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	state, _ := h.k.GetCircuitState(ctx)
+	state.LastUpdateHeight = uint64(sdkCtx.BlockHeight()) //nolint:gosec
+	h.k.SetCircuitState(ctx, state)
+
+	for _, cp := range attestationtypes.SortedCurrencyPairs(prices) {
+		h.k.SetPairStatus(ctx, types.PairStatus{
+			CurrencyPair:   cp.String(),
+			LastUpdateTime: sdkCtx.BlockTime(),
+		})
+	}
+
+	return nil
+}
+
+// AfterPriceMissed starts tracking a pair that never had a price from the
+// first block it is seen in.
+func (h Hooks) AfterPriceMissed(ctx context.Context, cp connecttypes.CurrencyPair) error {
+	if _, found := h.k.GetPairStatus(ctx, cp.String()); found {
+		return nil
+	}
+
+	h.k.SetPairStatus(ctx, types.PairStatus{
+		CurrencyPair:   cp.String(),
+		LastUpdateTime: sdk.UnwrapSDKContext(ctx).BlockTime(),
+	})
+
+	return nil
+}
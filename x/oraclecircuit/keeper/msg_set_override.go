@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/oraclecircuit/types"
+)
+
+func (k msgServer) SetOverride(goCtx context.Context, req *types.MsgSetOverride) (*types.MsgSetOverrideResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	state, _ := k.GetCircuitState(ctx)
+	state.Override = req.Override
+	k.SetCircuitState(ctx, state)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOverrideSet{Override: req.Override}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetOverrideResponse{}, nil
+}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/x/oraclecircuit/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	params := types.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, params))
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	update := func(authority string, params types.Params) error {
+		_, err := ms.UpdateParams(wctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+		return err
+	}
+	get := func() types.Params { return k.GetParams(wctx) }
+
+	pairs := []string{"ETH/USD"}
+	msgs := []string{"/cosmos.bank.v1beta1.MsgSend"}
+
+	keepertest.CheckMsgUpdateParams(t, k.GetAuthority(), update, get, []keepertest.UpdateParamsCase[types.Params]{
+		{
+			Name:      "negative max price age",
+			Params:    types.NewParams(10, -time.Minute, pairs, msgs),
+			ExpErrMsg: "max price age must not be negative",
+		},
+		{
+			Name:      "invalid currency pair",
+			Params:    types.NewParams(10, time.Minute, []string{"ETHUSD"}, msgs),
+			ExpErrMsg: "invalid currency pair",
+		},
+		{
+			Name:      "duplicated currency pair",
+			Params:    types.NewParams(10, time.Minute, []string{"ETH/USD", "ETH/USD"}, msgs),
+			ExpErrMsg: "duplicated currency pair",
+		},
+		{
+			Name:      "invalid msg type url",
+			Params:    types.NewParams(10, time.Minute, pairs, []string{"cosmos.bank.v1beta1.MsgSend"}),
+			ExpErrMsg: "invalid msg type url",
+		},
+		{
+			Name:      "duplicated msg type url",
+			Params:    types.NewParams(10, time.Minute, pairs, append(msgs, msgs...)),
+			ExpErrMsg: "duplicated msg type url",
+		},
+		{
+			Name:   "breaker",
+			Params: types.NewParams(10, time.Minute, pairs, msgs),
+		},
+		{
+			Name:   "all good",
+			Params: params,
+		},
+	})
+}
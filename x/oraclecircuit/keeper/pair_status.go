@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"rollinky/x/oraclecircuit/types"
+)
+
+// SetPairStatus set a specific pairStatus in the store from its index
+func (k Keeper) SetPairStatus(ctx context.Context, pairStatus types.PairStatus) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PairStatusKeyPrefix))
+	b := k.cdc.MustMarshal(&pairStatus)
+	store.Set(types.PairStatusKey(
+		pairStatus.CurrencyPair,
+	), b)
+}
+
+// GetPairStatus returns a pairStatus from its index
+func (k Keeper) GetPairStatus(
+	ctx context.Context,
+	currencyPair string,
+) (val types.PairStatus, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PairStatusKeyPrefix))
+
+	b := store.Get(types.PairStatusKey(
+		currencyPair,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePairStatus removes a pairStatus from the store
+func (k Keeper) RemovePairStatus(
+	ctx context.Context,
+	currencyPair string,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PairStatusKeyPrefix))
+	store.Delete(types.PairStatusKey(
+		currencyPair,
+	))
+}
+
+// GetAllPairStatus returns all pairStatus
+func (k Keeper) GetAllPairStatus(ctx context.Context) (list []types.PairStatus) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PairStatusKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PairStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rollinky/x/oraclecircuit/types"
+)
+
+func (k Keeper) CircuitState(ctx context.Context, req *types.QueryGetCircuitStateRequest) (*types.QueryGetCircuitStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// the circuit starts reset, before anything was stored.
+	val, _ := k.GetCircuitState(ctx)
+
+	return &types.QueryGetCircuitStateResponse{CircuitState: val}, nil
+}
+
+func (k Keeper) PairStatusAll(ctx context.Context, req *types.QueryAllPairStatusRequest) (*types.QueryAllPairStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var pairStatuses []types.PairStatus
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	pairStatusStore := prefix.NewStore(store, types.KeyPrefix(types.PairStatusKeyPrefix))
+
+	pageRes, err := query.Paginate(pairStatusStore, req.Pagination, func(key []byte, value []byte) error {
+		var pairStatus types.PairStatus
+		if err := k.cdc.Unmarshal(value, &pairStatus); err != nil {
+			return err
+		}
+
+		pairStatuses = append(pairStatuses, pairStatus)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPairStatusResponse{PairStatus: pairStatuses, Pagination: pageRes}, nil
+}
+
+func (k Keeper) CircuitRecordAll(ctx context.Context, req *types.QueryAllCircuitRecordRequest) (*types.QueryAllCircuitRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var circuitRecords []types.CircuitRecord
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	circuitRecordStore := prefix.NewStore(store, types.KeyPrefix(types.CircuitRecordKey))
+
+	pageRes, err := query.Paginate(circuitRecordStore, req.Pagination, func(key []byte, value []byte) error {
+		var circuitRecord types.CircuitRecord
+		if err := k.cdc.Unmarshal(value, &circuitRecord); err != nil {
+			return err
+		}
+
+		circuitRecords = append(circuitRecords, circuitRecord)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllCircuitRecordResponse{CircuitRecord: circuitRecords, Pagination: pageRes}, nil
+}
+
+func (k Keeper) CircuitRecord(ctx context.Context, req *types.QueryGetCircuitRecordRequest) (*types.QueryGetCircuitRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	circuitRecord, found := k.GetCircuitRecord(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetCircuitRecordResponse{CircuitRecord: circuitRecord}, nil
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/x/oraclecircuit/types"
+)
+
+func TestCircuitQueries(t *testing.T) {
+	k, ctx, _ := setupCircuit(t, types.NewParams(1, 0, nil, []string{sendURL}))
+
+	_, err := k.CircuitState(ctx, nil)
+	require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+
+	res, err := k.CircuitState(ctx, &types.QueryGetCircuitStateRequest{})
+	require.NoError(t, err)
+	require.False(t, res.CircuitState.Tripped)
+
+	writePrice(t, k, ctx)
+	ctx = ctx.WithBlockHeight(2)
+	k.EvaluateCircuit(ctx)
+
+	res, err = k.CircuitState(ctx, &types.QueryGetCircuitStateRequest{})
+	require.NoError(t, err)
+	require.True(t, res.CircuitState.Tripped)
+
+	pairs, err := k.PairStatusAll(ctx, &types.QueryAllPairStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PairStatus{{CurrencyPair: "BTC/USD", LastUpdateTime: genesisTime}}, pairs.PairStatus)
+
+	records, err := k.CircuitRecordAll(ctx, &types.QueryAllCircuitRecordRequest{})
+	require.NoError(t, err)
+	require.Len(t, records.CircuitRecord, 1)
+
+	record, err := k.CircuitRecord(ctx, &types.QueryGetCircuitRecordRequest{Id: 0})
+	require.NoError(t, err)
+	require.Equal(t, records.CircuitRecord[0], record.CircuitRecord)
+
+	_, err = k.CircuitRecord(ctx, &types.QueryGetCircuitRecordRequest{Id: 1})
+	require.Equal(t, status.Error(codes.NotFound, "not found"), err)
+}
+
+func TestPairStatusAllInvalidRequest(t *testing.T) {
+	k, ctx := keepertest.OraclecircuitKeeper(t)
+	_, err := k.PairStatusAll(ctx, nil)
+	require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+}
@@ -0,0 +1,60 @@
+package oraclecircuit
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "rollinky/api/rollinky/oraclecircuit"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "CircuitState",
+					Use:       "circuit-state",
+					Short:     "Shows whether the oracle circuit is tripped",
+				},
+				{
+					RpcMethod: "PairStatusAll",
+					Use:       "list-pair-status",
+					Short:     "List when each currency pair last had a price",
+				},
+				{
+					RpcMethod: "CircuitRecordAll",
+					Use:       "list-circuit-record",
+					Short:     "List all the trips and resets of the circuit",
+				},
+				{
+					RpcMethod:      "CircuitRecord",
+					Use:            "show-circuit-record [id]",
+					Short:          "Shows a trip or reset of the circuit by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SetOverride",
+					Skip:      true, // skipped because authority gated
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package oraclecircuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/oraclecircuit/keeper"
+	"rollinky/x/oraclecircuit/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set circuitState
+	k.SetCircuitState(ctx, genState.CircuitState)
+	// Set all the pairStatus
+	for _, elem := range genState.PairStatusList {
+		k.SetPairStatus(ctx, elem)
+	}
+	// Set all the circuitRecord
+	for _, elem := range genState.CircuitRecordList {
+		k.SetCircuitRecord(ctx, elem)
+	}
+
+	// Set circuitRecord count
+	k.SetCircuitRecordCount(ctx, genState.CircuitRecordCount)
+	// this line is used by starport scaffolding # genesis/module/init
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	// Get circuitState
+	circuitState, found := k.GetCircuitState(ctx)
+	if found {
+		genesis.CircuitState = circuitState
+	}
+	genesis.PairStatusList = k.GetAllPairStatus(ctx)
+	genesis.CircuitRecordList = k.GetAllCircuitRecord(ctx)
+	genesis.CircuitRecordCount = k.GetCircuitRecordCount(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
@@ -0,0 +1,49 @@
+package oraclecircuit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/testutil/nullify"
+	oraclecircuit "rollinky/x/oraclecircuit/module"
+	"rollinky/x/oraclecircuit/types"
+)
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+
+		CircuitState: types.CircuitState{
+			Tripped:             true,
+			Reason:              types.ReasonMissedUpdates,
+			DisabledMsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"},
+			LastUpdateHeight:    2,
+		},
+		PairStatusList: []types.PairStatus{
+			{CurrencyPair: "BTC/USD", LastUpdateTime: time.Unix(1700000000, 0).UTC()},
+			{CurrencyPair: "ETH/USD", LastUpdateTime: time.Unix(1700000001, 0).UTC()},
+		},
+		CircuitRecordList: []types.CircuitRecord{
+			{Id: 0, Tripped: true, Reason: types.ReasonMissedUpdates, BlockHeight: 5},
+			{Id: 1, Reason: types.ReasonPricesResumed, BlockHeight: 6},
+		},
+		CircuitRecordCount: 2,
+		// this line is used by starport scaffolding # genesis/test/state
+	}
+
+	k, ctx := keepertest.OraclecircuitKeeper(t)
+	oraclecircuit.InitGenesis(ctx, k, genesisState)
+	got := oraclecircuit.ExportGenesis(ctx, k)
+	require.NotNil(t, got)
+
+	nullify.Fill(&genesisState)
+	nullify.Fill(got)
+
+	require.Equal(t, genesisState.CircuitState, got.CircuitState)
+	require.ElementsMatch(t, genesisState.PairStatusList, got.PairStatusList)
+	require.ElementsMatch(t, genesisState.CircuitRecordList, got.CircuitRecordList)
+	require.Equal(t, genesisState.CircuitRecordCount, got.CircuitRecordCount)
+	// this line is used by starport scaffolding # genesis/test/assert
+}
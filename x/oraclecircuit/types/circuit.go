@@ -0,0 +1,16 @@
+package types
+
+// The reasons the circuit is tripped or reset with.
+const (
+	// ReasonMissedUpdates trips the circuit when max_missed_blocks blocks in a
+	// row wrote no prices.
+	ReasonMissedUpdates = "missed_updates"
+	// ReasonStalePrice trips the circuit when a monitored pair has had no
+	// price for longer than max_price_age.
+	ReasonStalePrice = "stale_price"
+	// ReasonPricesResumed resets the circuit once prices are fresh again.
+	ReasonPricesResumed = "prices_resumed"
+	// ReasonGovernanceOverride trips or resets the circuit on a governance
+	// override.
+	ReasonGovernanceOverride = "governance_override"
+)
@@ -0,0 +1,1274 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollinky/oraclecircuit/circuit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Override is a governance override of the circuit.
+type Override int32
+
+const (
+	// OVERRIDE_UNSPECIFIED trips and resets the circuit from the oracle's health.
+	OVERRIDE_UNSPECIFIED Override = 0
+	// OVERRIDE_TRIPPED keeps the circuit tripped, whatever the oracle's health.
+	OVERRIDE_TRIPPED Override = 1
+	// OVERRIDE_RESET keeps the circuit reset, whatever the oracle's health.
+	OVERRIDE_RESET Override = 2
+)
+
+var Override_name = map[int32]string{
+	0: "OVERRIDE_UNSPECIFIED",
+	1: "OVERRIDE_TRIPPED",
+	2: "OVERRIDE_RESET",
+}
+
+var Override_value = map[string]int32{
+	"OVERRIDE_UNSPECIFIED": 0,
+	"OVERRIDE_TRIPPED":     1,
+	"OVERRIDE_RESET":       2,
+}
+
+func (x Override) String() string {
+	return proto.EnumName(Override_name, int32(x))
+}
+
+func (Override) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4ce83300268ee285, []int{0}
+}
+
+// CircuitState is the current state of the circuit.
+type CircuitState struct {
+	// tripped is whether the circuit is tripped.
+	Tripped bool `protobuf:"varint,1,opt,name=tripped,proto3" json:"tripped,omitempty"`
+	// reason is why the circuit was tripped.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// currency_pair is the stale currency pair that tripped the circuit, if it
+	// was tripped by a stale price.
+	CurrencyPair string `protobuf:"bytes,3,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// disabled_msg_type_urls are the messages the circuit disabled when it was
+	// tripped, re-enabled when it is reset. Messages that were already disabled
+	// in the circuit breaker are left alone.
+	DisabledMsgTypeUrls []string `protobuf:"bytes,4,rep,name=disabled_msg_type_urls,json=disabledMsgTypeUrls,proto3" json:"disabled_msg_type_urls,omitempty"`
+	// override is the governance override.
+	Override Override `protobuf:"varint,5,opt,name=override,proto3,enum=rollinky.oraclecircuit.Override" json:"override,omitempty"`
+	// last_update_height is the last height prices were written at.
+	LastUpdateHeight uint64 `protobuf:"varint,6,opt,name=last_update_height,json=lastUpdateHeight,proto3" json:"last_update_height,omitempty"`
+}
+
+func (m *CircuitState) Reset()         { *m = CircuitState{} }
+func (m *CircuitState) String() string { return proto.CompactTextString(m) }
+func (*CircuitState) ProtoMessage()    {}
+func (*CircuitState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ce83300268ee285, []int{0}
+}
+func (m *CircuitState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitState.Merge(m, src)
+}
+func (m *CircuitState) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitState) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitState proto.InternalMessageInfo
+
+func (m *CircuitState) GetTripped() bool {
+	if m != nil {
+		return m.Tripped
+	}
+	return false
+}
+
+func (m *CircuitState) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CircuitState) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *CircuitState) GetDisabledMsgTypeUrls() []string {
+	if m != nil {
+		return m.DisabledMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *CircuitState) GetOverride() Override {
+	if m != nil {
+		return m.Override
+	}
+	return OVERRIDE_UNSPECIFIED
+}
+
+func (m *CircuitState) GetLastUpdateHeight() uint64 {
+	if m != nil {
+		return m.LastUpdateHeight
+	}
+	return 0
+}
+
+// PairStatus is when the price of a currency pair was last written.
+type PairStatus struct {
+	// currency_pair is the currency pair, e.g. "BTC/USD".
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// last_update_time is the time of the block the price was last written in,
+	// or of the first block the pair was seen in if it never had a price.
+	LastUpdateTime time.Time `protobuf:"bytes,2,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time"`
+}
+
+func (m *PairStatus) Reset()         { *m = PairStatus{} }
+func (m *PairStatus) String() string { return proto.CompactTextString(m) }
+func (*PairStatus) ProtoMessage()    {}
+func (*PairStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ce83300268ee285, []int{1}
+}
+func (m *PairStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairStatus.Merge(m, src)
+}
+func (m *PairStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairStatus proto.InternalMessageInfo
+
+func (m *PairStatus) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *PairStatus) GetLastUpdateTime() time.Time {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return time.Time{}
+}
+
+// CircuitRecord records a trip or a reset of the circuit.
+type CircuitRecord struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// tripped is true for a trip, false for a reset.
+	Tripped bool `protobuf:"varint,2,opt,name=tripped,proto3" json:"tripped,omitempty"`
+	// reason is why the circuit was tripped or reset.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// currency_pair is the stale currency pair that tripped the circuit, if any.
+	CurrencyPair string `protobuf:"bytes,4,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// msg_type_urls are the messages disabled or re-enabled.
+	MsgTypeUrls []string `protobuf:"bytes,5,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// block_height is the height of the trip or reset.
+	BlockHeight uint64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_time is the time of the block of the trip or reset.
+	BlockTime time.Time `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+}
+
+func (m *CircuitRecord) Reset()         { *m = CircuitRecord{} }
+func (m *CircuitRecord) String() string { return proto.CompactTextString(m) }
+func (*CircuitRecord) ProtoMessage()    {}
+func (*CircuitRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ce83300268ee285, []int{2}
+}
+func (m *CircuitRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitRecord.Merge(m, src)
+}
+func (m *CircuitRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitRecord proto.InternalMessageInfo
+
+func (m *CircuitRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CircuitRecord) GetTripped() bool {
+	if m != nil {
+		return m.Tripped
+	}
+	return false
+}
+
+func (m *CircuitRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CircuitRecord) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *CircuitRecord) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *CircuitRecord) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *CircuitRecord) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("rollinky.oraclecircuit.Override", Override_name, Override_value)
+	proto.RegisterType((*CircuitState)(nil), "rollinky.oraclecircuit.CircuitState")
+	proto.RegisterType((*PairStatus)(nil), "rollinky.oraclecircuit.PairStatus")
+	proto.RegisterType((*CircuitRecord)(nil), "rollinky.oraclecircuit.CircuitRecord")
+}
+
+func init() {
+	proto.RegisterFile("rollinky/oraclecircuit/circuit.proto", fileDescriptor_4ce83300268ee285)
+}
+
+var fileDescriptor_4ce83300268ee285 = []byte{
+	// 521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x8f, 0xd2, 0x40,
+	0x18, 0xc6, 0x3b, 0x85, 0x65, 0x61, 0xf8, 0x93, 0x66, 0x24, 0xa4, 0xe1, 0x50, 0x2a, 0x7a, 0x20,
+	0xc6, 0x94, 0x64, 0xf7, 0xe2, 0xc1, 0xd3, 0x42, 0x8d, 0x1c, 0xdc, 0x25, 0x03, 0x78, 0xf0, 0xd2,
+	0x94, 0x76, 0xec, 0x4e, 0xb6, 0x30, 0xcd, 0x74, 0x6a, 0xe4, 0xa8, 0x07, 0xe3, 0x71, 0xbf, 0x83,
+	0x5f, 0x66, 0x8f, 0x7b, 0xf4, 0xa4, 0x06, 0xbe, 0x88, 0xe9, 0x94, 0x22, 0x28, 0x9a, 0x78, 0x6a,
+	0xdf, 0xf7, 0x79, 0xa6, 0x7d, 0xdf, 0xdf, 0x93, 0x81, 0x8f, 0x39, 0x0b, 0x43, 0xba, 0xbc, 0x59,
+	0xf5, 0x19, 0x77, 0xbd, 0x90, 0x78, 0x94, 0x7b, 0x09, 0x15, 0xfd, 0xed, 0xd3, 0x8a, 0x38, 0x13,
+	0x0c, 0xb5, 0x72, 0x97, 0x75, 0xe0, 0x6a, 0x37, 0x03, 0x16, 0x30, 0x69, 0xe9, 0xa7, 0x6f, 0x99,
+	0xbb, 0xdd, 0x09, 0x18, 0x0b, 0x42, 0xd2, 0x97, 0xd5, 0x3c, 0x79, 0xdb, 0x17, 0x74, 0x41, 0x62,
+	0xe1, 0x2e, 0xa2, 0xcc, 0xd0, 0xfd, 0xa4, 0xc2, 0xda, 0x20, 0xfb, 0xc4, 0x44, 0xb8, 0x82, 0x20,
+	0x1d, 0x9e, 0x0a, 0x4e, 0xa3, 0x88, 0xf8, 0x3a, 0x30, 0x41, 0xaf, 0x8c, 0xf3, 0x12, 0xb5, 0x60,
+	0x89, 0x13, 0x37, 0x66, 0x4b, 0x5d, 0x35, 0x41, 0xaf, 0x82, 0xb7, 0x15, 0x7a, 0x04, 0xeb, 0x5e,
+	0xc2, 0x39, 0x59, 0x7a, 0x2b, 0x27, 0x72, 0x29, 0xd7, 0x0b, 0x52, 0xae, 0xe5, 0xcd, 0xb1, 0x4b,
+	0x39, 0x3a, 0x87, 0x2d, 0x9f, 0xc6, 0xee, 0x3c, 0x24, 0xbe, 0xb3, 0x88, 0x03, 0x47, 0xac, 0x22,
+	0xe2, 0x24, 0x3c, 0x8c, 0xf5, 0xa2, 0x59, 0xe8, 0x55, 0xf0, 0x83, 0x5c, 0x7d, 0x15, 0x07, 0xd3,
+	0x55, 0x44, 0x66, 0x3c, 0x8c, 0xd1, 0x73, 0x58, 0x66, 0xef, 0x08, 0xe7, 0xd4, 0x27, 0xfa, 0x89,
+	0x09, 0x7a, 0x8d, 0x33, 0xd3, 0x3a, 0xbe, 0xbe, 0x75, 0xb5, 0xf5, 0xe1, 0xdd, 0x09, 0xf4, 0x14,
+	0xa2, 0xd0, 0x8d, 0x85, 0x93, 0x44, 0xbe, 0x2b, 0x88, 0x73, 0x4d, 0x68, 0x70, 0x2d, 0xf4, 0x92,
+	0x09, 0x7a, 0x45, 0xac, 0xa5, 0xca, 0x4c, 0x0a, 0x2f, 0x65, 0xbf, 0xfb, 0x01, 0x40, 0x98, 0x4e,
+	0x9a, 0x52, 0x48, 0xe2, 0x3f, 0x97, 0x02, 0x47, 0x96, 0xba, 0x84, 0xda, 0xfe, 0x1f, 0x52, 0xb6,
+	0x92, 0x4d, 0xf5, 0xac, 0x6d, 0x65, 0xe0, 0xad, 0x1c, 0xbc, 0x35, 0xcd, 0xc1, 0x5f, 0x94, 0xef,
+	0xbe, 0x75, 0x94, 0xdb, 0xef, 0x1d, 0x80, 0x1b, 0xbf, 0xa6, 0x48, 0xe5, 0xee, 0x47, 0x15, 0xd6,
+	0xb7, 0x61, 0x60, 0xe2, 0x31, 0xee, 0xa3, 0x06, 0x54, 0x69, 0x16, 0x44, 0x11, 0xab, 0xd4, 0xdf,
+	0x4f, 0x47, 0xfd, 0x5b, 0x3a, 0x85, 0x7f, 0xa7, 0x53, 0x3c, 0xb2, 0x48, 0x17, 0xd6, 0x0f, 0x43,
+	0x39, 0x91, 0xa1, 0x54, 0x17, 0x7b, 0x61, 0x3c, 0x84, 0xb5, 0x79, 0xc8, 0xbc, 0x9b, 0x43, 0x90,
+	0x55, 0xd9, 0xcb, 0x18, 0xa2, 0x01, 0x84, 0x99, 0x45, 0x92, 0x38, 0xfd, 0x0f, 0x12, 0x15, 0x79,
+	0x2e, 0x55, 0x9e, 0x4c, 0x61, 0x39, 0x0f, 0x13, 0xe9, 0xb0, 0x79, 0xf5, 0xda, 0xc6, 0x78, 0x34,
+	0xb4, 0x9d, 0xd9, 0xe5, 0x64, 0x6c, 0x0f, 0x46, 0x2f, 0x46, 0xf6, 0x50, 0x53, 0x50, 0x13, 0x6a,
+	0x3b, 0x65, 0x8a, 0x47, 0xe3, 0xb1, 0x3d, 0xd4, 0x00, 0x42, 0xb0, 0xb1, 0xeb, 0x62, 0x7b, 0x62,
+	0x4f, 0x35, 0xb5, 0x5d, 0xfc, 0xfc, 0xc5, 0x50, 0x2e, 0x9e, 0xdd, 0xad, 0x0d, 0x70, 0xbf, 0x36,
+	0xc0, 0x8f, 0xb5, 0x01, 0x6e, 0x37, 0x86, 0x72, 0xbf, 0x31, 0x94, 0xaf, 0x1b, 0x43, 0x79, 0x63,
+	0xec, 0xae, 0xdd, 0xfb, 0xdf, 0x2e, 0x5e, 0x8a, 0x23, 0x9e, 0x97, 0xe4, 0xe0, 0xe7, 0x3f, 0x07,
+	0x00, 0x44, 0x21, 0x10, 0x18, 0x9f, 0x03, 0x00, 0x00,
+}
+
+func (m *CircuitState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdateHeight != 0 {
+		i = encodeVarintCircuit(dAtA, i, uint64(m.LastUpdateHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Override != 0 {
+		i = encodeVarintCircuit(dAtA, i, uint64(m.Override))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DisabledMsgTypeUrls) > 0 {
+		for iNdEx := len(m.DisabledMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.DisabledMsgTypeUrls[iNdEx])
+			i = encodeVarintCircuit(dAtA, i, uint64(len(m.DisabledMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintCircuit(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCircuit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tripped {
+		i--
+		if m.Tripped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastUpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastUpdateTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCircuit(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintCircuit(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintCircuit(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if m.BlockHeight != 0 {
+		i = encodeVarintCircuit(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintCircuit(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintCircuit(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCircuit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Tripped {
+		i--
+		if m.Tripped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintCircuit(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCircuit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCircuit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CircuitState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tripped {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCircuit(uint64(l))
+	}
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovCircuit(uint64(l))
+	}
+	if len(m.DisabledMsgTypeUrls) > 0 {
+		for _, s := range m.DisabledMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovCircuit(uint64(l))
+		}
+	}
+	if m.Override != 0 {
+		n += 1 + sovCircuit(uint64(m.Override))
+	}
+	if m.LastUpdateHeight != 0 {
+		n += 1 + sovCircuit(uint64(m.LastUpdateHeight))
+	}
+	return n
+}
+
+func (m *PairStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovCircuit(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastUpdateTime)
+	n += 1 + l + sovCircuit(uint64(l))
+	return n
+}
+
+func (m *CircuitRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCircuit(uint64(m.Id))
+	}
+	if m.Tripped {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCircuit(uint64(l))
+	}
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovCircuit(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovCircuit(uint64(l))
+		}
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovCircuit(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovCircuit(uint64(l))
+	return n
+}
+
+func sovCircuit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCircuit(x uint64) (n int) {
+	return sovCircuit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CircuitState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tripped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tripped = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledMsgTypeUrls = append(m.DisabledMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Override", wireType)
+			}
+			m.Override = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Override |= Override(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateHeight", wireType)
+			}
+			m.LastUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tripped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tripped = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCircuit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCircuit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCircuit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCircuit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCircuit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCircuit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCircuit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCircuit = fmt.Errorf("proto: unexpected end of group")
+)
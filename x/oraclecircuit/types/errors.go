@@ -0,0 +1,13 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/oraclecircuit module sentinel errors
+var (
+	ErrInvalidSigner   = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidOverride = sdkerrors.Register(ModuleName, 1101, "invalid override")
+)
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// AccountKeeper defines the expected interface for the Account module.
+type AccountKeeper interface {
+	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI // only used for simulation
+	// Methods imported from account should be defined here
+}
+
+// BankKeeper defines the expected interface for the Bank module.
+type BankKeeper interface {
+	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
+	// Methods imported from bank should be defined here
+}
+
+// CircuitKeeper defines the expected interface for the circuit breaker.
+type CircuitKeeper interface {
+	IsAllowed(ctx context.Context, msgURL string) (bool, error)
+	DisableMsg(ctx context.Context, msgURL string) error
+	EnableMsg(ctx context.Context, msgURL string) error
+}
+
+// OracleKeeper defines the expected interface for the oracle module.
+type OracleKeeper interface {
+	GetAllCurrencyPairs(ctx context.Context) []connecttypes.CurrencyPair
+}
+
+// ParamSubspace defines the expected Subspace interface for parameters.
+type ParamSubspace interface {
+	Get(context.Context, []byte, interface{})
+	Set(context.Context, []byte, interface{})
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	// this line is used by starport scaffolding # genesis/types/import
+)
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		CircuitState:      CircuitState{},
+		PairStatusList:    []PairStatus{},
+		CircuitRecordList: []CircuitRecord{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if _, ok := Override_name[int32(gs.CircuitState.Override)]; !ok {
+		return fmt.Errorf("invalid circuit override %d", gs.CircuitState.Override)
+	}
+	// Check for duplicated index in pairStatus
+	pairStatusIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PairStatusList {
+		index := string(PairStatusKey(elem.CurrencyPair))
+		if _, ok := pairStatusIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for pairStatus")
+		}
+		pairStatusIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated ID in circuitRecord
+	circuitRecordIdMap := make(map[uint64]bool)
+	circuitRecordCount := gs.GetCircuitRecordCount()
+	for _, elem := range gs.CircuitRecordList {
+		if _, ok := circuitRecordIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for circuitRecord")
+		}
+		if elem.Id >= circuitRecordCount {
+			return fmt.Errorf("circuitRecord id should be lower or equal than the last id")
+		}
+		circuitRecordIdMap[elem.Id] = true
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
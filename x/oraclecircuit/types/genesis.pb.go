@@ -0,0 +1,545 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollinky/oraclecircuit/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the oraclecircuit module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params             Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	CircuitState       CircuitState    `protobuf:"bytes,2,opt,name=circuit_state,json=circuitState,proto3" json:"circuit_state"`
+	PairStatusList     []PairStatus    `protobuf:"bytes,3,rep,name=pair_status_list,json=pairStatusList,proto3" json:"pair_status_list"`
+	CircuitRecordList  []CircuitRecord `protobuf:"bytes,4,rep,name=circuit_record_list,json=circuitRecordList,proto3" json:"circuit_record_list"`
+	CircuitRecordCount uint64          `protobuf:"varint,5,opt,name=circuit_record_count,json=circuitRecordCount,proto3" json:"circuit_record_count,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14e0aefc3444fa76, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetCircuitState() CircuitState {
+	if m != nil {
+		return m.CircuitState
+	}
+	return CircuitState{}
+}
+
+func (m *GenesisState) GetPairStatusList() []PairStatus {
+	if m != nil {
+		return m.PairStatusList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitRecordList() []CircuitRecord {
+	if m != nil {
+		return m.CircuitRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitRecordCount() uint64 {
+	if m != nil {
+		return m.CircuitRecordCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "rollinky.oraclecircuit.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("rollinky/oraclecircuit/genesis.proto", fileDescriptor_14e0aefc3444fa76)
+}
+
+var fileDescriptor_14e0aefc3444fa76 = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xb1, 0x4a, 0x3b, 0x31,
+	0x1c, 0xc7, 0x2f, 0xff, 0xf6, 0x5f, 0x30, 0xad, 0x62, 0x63, 0x91, 0xa3, 0x43, 0x2c, 0xb5, 0x42,
+	0x71, 0xb8, 0x93, 0xba, 0xb8, 0xda, 0x0e, 0x2e, 0x82, 0x72, 0xdd, 0x74, 0x28, 0x31, 0x86, 0x12,
+	0xbc, 0x5e, 0x8e, 0x24, 0x05, 0xfb, 0x00, 0xee, 0x3e, 0x86, 0xa3, 0x8f, 0xd1, 0xb1, 0xa3, 0x93,
+	0xc8, 0xdd, 0xe0, 0x6b, 0xc8, 0xe5, 0xd2, 0xd2, 0x16, 0x0f, 0x97, 0x4b, 0xf8, 0xde, 0xe7, 0xf7,
+	0xc9, 0x17, 0x7e, 0xb0, 0x23, 0x45, 0x18, 0xf2, 0xe8, 0x69, 0xe6, 0x0b, 0x49, 0x68, 0xc8, 0x28,
+	0x97, 0x74, 0xca, 0xb5, 0x3f, 0x66, 0x11, 0x53, 0x5c, 0x79, 0xb1, 0x14, 0x5a, 0xa0, 0xc3, 0x25,
+	0xe5, 0x6d, 0x50, 0xcd, 0x3a, 0x99, 0xf0, 0x48, 0xf8, 0xe6, 0x9b, 0xa3, 0xcd, 0xc6, 0x58, 0x8c,
+	0x85, 0xb9, 0xfa, 0xd9, 0xcd, 0xa6, 0x45, 0xcf, 0xd8, 0xd3, 0x52, 0xc7, 0x05, 0x54, 0x4c, 0x24,
+	0x99, 0xd8, 0x2e, 0xed, 0x97, 0x12, 0xac, 0x5d, 0xe5, 0xed, 0x86, 0x9a, 0x68, 0x86, 0x2e, 0x61,
+	0x25, 0x07, 0x5c, 0xd0, 0x02, 0xdd, 0x6a, 0x0f, 0x7b, 0xbf, 0xb7, 0xf5, 0x6e, 0x0d, 0xd5, 0xdf,
+	0x99, 0x7f, 0x1e, 0x39, 0x6f, 0xdf, 0xef, 0xa7, 0x20, 0xb0, 0x83, 0xe8, 0x06, 0xee, 0x5a, 0x68,
+	0xa4, 0x32, 0xa7, 0xfb, 0xcf, 0x98, 0x3a, 0x45, 0xa6, 0x41, 0x7e, 0x9a, 0xf7, 0xfb, 0xe5, 0xcc,
+	0x17, 0xd4, 0xe8, 0x5a, 0x86, 0x02, 0xb8, 0x1f, 0x13, 0x2e, 0x8d, 0x6d, 0xaa, 0x46, 0x21, 0x57,
+	0xda, 0x2d, 0xb5, 0x4a, 0xdd, 0x6a, 0xaf, 0x5d, 0xdc, 0x8e, 0xcb, 0xa1, 0xc1, 0xad, 0x71, 0x2f,
+	0x5e, 0x25, 0xd7, 0x5c, 0x69, 0x74, 0x0f, 0x0f, 0x96, 0x25, 0x25, 0xa3, 0x42, 0x3e, 0xe6, 0xda,
+	0xb2, 0xd1, 0x9e, 0xfc, 0x51, 0x35, 0x30, 0x13, 0xd6, 0x5c, 0xa7, 0xeb, 0xa1, 0x91, 0x9f, 0xc1,
+	0xc6, 0x96, 0x9c, 0x8a, 0x69, 0xa4, 0xdd, 0xff, 0x2d, 0xd0, 0x2d, 0x07, 0x68, 0x63, 0x60, 0x90,
+	0xfd, 0xe9, 0x5f, 0xcc, 0x13, 0x0c, 0x16, 0x09, 0x06, 0x5f, 0x09, 0x06, 0xaf, 0x29, 0x76, 0x16,
+	0x29, 0x76, 0x3e, 0x52, 0xec, 0xdc, 0xe1, 0xd5, 0x1a, 0x9f, 0xb7, 0x16, 0xa9, 0x67, 0x31, 0x53,
+	0x0f, 0x15, 0xb3, 0xc8, 0xf3, 0x9f, 0x01, 0x00, 0x53, 0x28, 0x4a, 0xd1, 0x7c, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CircuitRecordCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CircuitRecordCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CircuitRecordList) > 0 {
+		for iNdEx := len(m.CircuitRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PairStatusList) > 0 {
+		for iNdEx := len(m.PairStatusList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairStatusList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.CircuitState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CircuitState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PairStatusList) > 0 {
+		for _, e := range m.PairStatusList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CircuitRecordList) > 0 {
+		for _, e := range m.CircuitRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CircuitRecordCount != 0 {
+		n += 1 + sovGenesis(uint64(m.CircuitRecordCount))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairStatusList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairStatusList = append(m.PairStatusList, PairStatus{})
+			if err := m.PairStatusList[len(m.PairStatusList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitRecordList = append(m.CircuitRecordList, CircuitRecord{})
+			if err := m.CircuitRecordList[len(m.CircuitRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitRecordCount", wireType)
+			}
+			m.CircuitRecordCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitRecordCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"rollinky/x/oraclecircuit/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc:     "valid genesis state",
+			genState: &types.GenesisState{
+				PairStatusList: []types.PairStatus{
+					{CurrencyPair: "BTC/USD"},
+					{CurrencyPair: "ETH/USD"},
+				},
+				CircuitRecordList: []types.CircuitRecord{
+					{Id: 0},
+					{Id: 1},
+				},
+				CircuitRecordCount: 2,
+				// this line is used by starport scaffolding # types/genesis/validField
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated pairStatus",
+			genState: &types.GenesisState{
+				PairStatusList: []types.PairStatus{
+					{CurrencyPair: "BTC/USD"},
+					{CurrencyPair: "BTC/USD"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated circuitRecord",
+			genState: &types.GenesisState{
+				CircuitRecordList: []types.CircuitRecord{
+					{Id: 0},
+					{Id: 0},
+				},
+				CircuitRecordCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid circuitRecord count",
+			genState: &types.GenesisState{
+				CircuitRecordList: []types.CircuitRecord{
+					{Id: 1},
+				},
+				CircuitRecordCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid override",
+			genState: &types.GenesisState{
+				CircuitState: types.CircuitState{Override: 3},
+			},
+			valid: false,
+		},
+		// this line is used by starport scaffolding # types/genesis/testcase
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
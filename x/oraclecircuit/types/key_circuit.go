@@ -0,0 +1,36 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// CircuitStateKey is the key of the CircuitState
+	CircuitStateKey = "CircuitState/value/"
+
+	// PairStatusKeyPrefix is the prefix to retrieve all PairStatus
+	PairStatusKeyPrefix = "PairStatus/value/"
+
+	// CircuitRecordKey is the prefix to retrieve all CircuitRecord
+	CircuitRecordKey = "CircuitRecord/value/"
+	// CircuitRecordCountKey is the key of the number of CircuitRecord
+	CircuitRecordCountKey = "CircuitRecord/count/"
+)
+
+// PairStatusKey returns the store key to retrieve a PairStatus from the index fields
+func PairStatusKey(
+	currencyPair string,
+) []byte {
+	var key []byte
+
+	currencyPairBytes := []byte(currencyPair)
+	key = append(key, currencyPairBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// GetCircuitRecordIDBytes returns the byte representation of the ID
+func GetCircuitRecordIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSetOverride{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetOverride) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if _, ok := Override_name[int32(m.Override)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidOverride, "%d", m.Override)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyMaxMissedBlocks = []byte("MaxMissedBlocks")
+	// DefaultMaxMissedBlocks disables the missed updates check.
+	DefaultMaxMissedBlocks uint64 = 0
+)
+
+var (
+	KeyMaxPriceAge = []byte("MaxPriceAge")
+	// DefaultMaxPriceAge disables the price age check.
+	DefaultMaxPriceAge time.Duration = 0
+)
+
+var (
+	KeyCurrencyPairs = []byte("CurrencyPairs")
+	// DefaultCurrencyPairs monitors every currency pair.
+	DefaultCurrencyPairs []string
+)
+
+var (
+	KeyMsgTypeUrls = []byte("MsgTypeUrls")
+	// DefaultMsgTypeUrls disables no messages.
+	DefaultMsgTypeUrls []string
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	maxMissedBlocks uint64,
+	maxPriceAge time.Duration,
+	currencyPairs []string,
+	msgTypeUrls []string,
+) Params {
+	return Params{
+		MaxMissedBlocks: maxMissedBlocks,
+		MaxPriceAge:     maxPriceAge,
+		CurrencyPairs:   currencyPairs,
+		MsgTypeUrls:     msgTypeUrls,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultMaxMissedBlocks,
+		DefaultMaxPriceAge,
+		DefaultCurrencyPairs,
+		DefaultMsgTypeUrls,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxMissedBlocks, &p.MaxMissedBlocks, validateMaxMissedBlocks),
+		paramtypes.NewParamSetPair(KeyMaxPriceAge, &p.MaxPriceAge, validateMaxPriceAge),
+		paramtypes.NewParamSetPair(KeyCurrencyPairs, &p.CurrencyPairs, validateCurrencyPairs),
+		paramtypes.NewParamSetPair(KeyMsgTypeUrls, &p.MsgTypeUrls, validateMsgTypeUrls),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateMaxMissedBlocks(p.MaxMissedBlocks); err != nil {
+		return err
+	}
+
+	if err := validateMaxPriceAge(p.MaxPriceAge); err != nil {
+		return err
+	}
+
+	if err := validateCurrencyPairs(p.CurrencyPairs); err != nil {
+		return err
+	}
+
+	return validateMsgTypeUrls(p.MsgTypeUrls)
+}
+
+// validateMaxMissedBlocks validates the MaxMissedBlocks param
+func validateMaxMissedBlocks(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxPriceAge validates the MaxPriceAge param
+func validateMaxPriceAge(v interface{}) error {
+	maxPriceAge, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxPriceAge < 0 {
+		return fmt.Errorf("max price age must not be negative: %s", maxPriceAge)
+	}
+
+	return nil
+}
+
+// validateCurrencyPairs validates the CurrencyPairs param
+func validateCurrencyPairs(v interface{}) error {
+	currencyPairs, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]struct{}, len(currencyPairs))
+	for _, pair := range currencyPairs {
+		cp, err := connecttypes.CurrencyPairFromString(pair)
+		if err != nil {
+			return fmt.Errorf("invalid currency pair %q: %w", pair, err)
+		}
+		if cp.String() != pair {
+			return fmt.Errorf("currency pair %q must be written as %q", pair, cp.String())
+		}
+		if _, ok := seen[pair]; ok {
+			return fmt.Errorf("duplicated currency pair: %s", pair)
+		}
+		seen[pair] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateMsgTypeUrls validates the MsgTypeUrls param
+func validateMsgTypeUrls(v interface{}) error {
+	msgTypeUrls, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]struct{}, len(msgTypeUrls))
+	for _, url := range msgTypeUrls {
+		if !strings.HasPrefix(url, "/") || len(url) == 1 {
+			return fmt.Errorf("invalid msg type url %q, must look like /cosmos.bank.v1beta1.MsgSend", url)
+		}
+		if _, ok := seen[url]; ok {
+			return fmt.Errorf("duplicated msg type url: %s", url)
+		}
+		seen[url] = struct{}{}
+	}
+
+	return nil
+}
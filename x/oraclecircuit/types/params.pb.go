@@ -0,0 +1,530 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollinky/oraclecircuit/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// max_missed_blocks trips the circuit once this many blocks in a row wrote
+	// no prices. Zero disables the check.
+	MaxMissedBlocks uint64 `protobuf:"varint,1,opt,name=max_missed_blocks,json=maxMissedBlocks,proto3" json:"max_missed_blocks,omitempty"`
+	// max_price_age trips the circuit once a monitored currency pair has had
+	// no price for longer than this. Zero disables the check.
+	MaxPriceAge time.Duration `protobuf:"bytes,2,opt,name=max_price_age,json=maxPriceAge,proto3,stdduration" json:"max_price_age"`
+	// currency_pairs are the currency pairs whose price age is monitored, e.g.
+	// "BTC/USD". Empty monitors every pair of the oracle module.
+	CurrencyPairs []string `protobuf:"bytes,3,rep,name=currency_pairs,json=currencyPairs,proto3" json:"currency_pairs,omitempty"`
+	// msg_type_urls are the messages disabled in the circuit breaker while the
+	// circuit is tripped, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeUrls []string `protobuf:"bytes,4,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb3cc4c67ab833f0, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxMissedBlocks() uint64 {
+	if m != nil {
+		return m.MaxMissedBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPriceAge() time.Duration {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *Params) GetCurrencyPairs() []string {
+	if m != nil {
+		return m.CurrencyPairs
+	}
+	return nil
+}
+
+func (m *Params) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "rollinky.oraclecircuit.Params")
+}
+
+func init() {
+	proto.RegisterFile("rollinky/oraclecircuit/params.proto", fileDescriptor_fb3cc4c67ab833f0)
+}
+
+var fileDescriptor_fb3cc4c67ab833f0 = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x31, 0x4b, 0xf3, 0x40,
+	0x18, 0xc7, 0x73, 0x6f, 0x4b, 0x79, 0x4d, 0xa9, 0xd2, 0x20, 0x12, 0x3b, 0x5c, 0x4a, 0x45, 0x08,
+	0x1d, 0x12, 0xd0, 0x45, 0xdc, 0x2c, 0x82, 0x93, 0x50, 0x8a, 0x2e, 0x2e, 0xe1, 0x7a, 0x3d, 0x8f,
+	0xa3, 0x77, 0xb9, 0x70, 0x97, 0x40, 0xf2, 0x15, 0x9c, 0x1c, 0x1d, 0xfd, 0x08, 0x7e, 0x8c, 0x8e,
+	0x1d, 0x9d, 0x54, 0xda, 0x41, 0x67, 0x3f, 0x81, 0xe4, 0xd2, 0x08, 0x0a, 0x2e, 0xc7, 0x73, 0xff,
+	0xe7, 0xf7, 0xf0, 0xe3, 0x79, 0xec, 0x03, 0x25, 0x39, 0x67, 0xf1, 0xbc, 0x08, 0xa5, 0x42, 0x98,
+	0x13, 0xcc, 0x14, 0xce, 0x58, 0x1a, 0x26, 0x48, 0x21, 0xa1, 0x83, 0x44, 0xc9, 0x54, 0x3a, 0x7b,
+	0x35, 0x14, 0xfc, 0x80, 0x7a, 0x5d, 0x24, 0x58, 0x2c, 0x43, 0xf3, 0x56, 0x68, 0x6f, 0x97, 0x4a,
+	0x2a, 0x4d, 0x19, 0x96, 0xd5, 0x26, 0x85, 0x54, 0x4a, 0xca, 0x49, 0x68, 0x7e, 0xd3, 0xec, 0x36,
+	0x9c, 0x65, 0x0a, 0xa5, 0x4c, 0xc6, 0x55, 0x7f, 0xf0, 0x09, 0xec, 0xd6, 0xd8, 0x18, 0x9d, 0xa1,
+	0xdd, 0x15, 0x28, 0x8f, 0x04, 0xd3, 0x9a, 0xcc, 0xa2, 0x29, 0x97, 0x78, 0xae, 0x5d, 0xd0, 0x07,
+	0x7e, 0x73, 0xb2, 0x23, 0x50, 0x7e, 0x69, 0xf2, 0x91, 0x89, 0x9d, 0x0b, 0xbb, 0x53, 0xb2, 0x89,
+	0x62, 0x98, 0x44, 0x88, 0x12, 0xf7, 0x5f, 0x1f, 0xf8, 0xed, 0xa3, 0xfd, 0xa0, 0xd2, 0x05, 0xb5,
+	0x2e, 0x38, 0xdf, 0xe8, 0x46, 0xff, 0x17, 0x2f, 0x9e, 0xf5, 0xf0, 0xea, 0x81, 0x49, 0x5b, 0xa0,
+	0x7c, 0x5c, 0x0e, 0x9e, 0x51, 0xe2, 0x1c, 0xda, 0xdb, 0x38, 0x53, 0x8a, 0xc4, 0xb8, 0x88, 0x12,
+	0xc4, 0x94, 0x76, 0x1b, 0xfd, 0x86, 0xbf, 0x35, 0xe9, 0xd4, 0xe9, 0xb8, 0x0c, 0x9d, 0x81, 0xdd,
+	0x11, 0x9a, 0x46, 0x69, 0x91, 0x90, 0x28, 0x53, 0x5c, 0xbb, 0x4d, 0x43, 0xb5, 0x85, 0xa6, 0x57,
+	0x45, 0x42, 0xae, 0x15, 0xd7, 0xa7, 0xfe, 0xc7, 0xa3, 0x07, 0xee, 0xde, 0x9f, 0x86, 0xde, 0xf7,
+	0x65, 0xf3, 0x5f, 0xb7, 0xad, 0x36, 0x1d, 0x9d, 0x2c, 0x56, 0x10, 0x2c, 0x57, 0x10, 0xbc, 0xad,
+	0x20, 0xb8, 0x5f, 0x43, 0x6b, 0xb9, 0x86, 0xd6, 0xf3, 0x1a, 0x5a, 0x37, 0xf0, 0xcf, 0xd1, 0x52,
+	0xad, 0xa7, 0x2d, 0xb3, 0xd8, 0xf1, 0xd7, 0x00, 0xae, 0xd0, 0x27, 0x1a, 0xbd, 0x01, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxMissedBlocks != that1.MaxMissedBlocks {
+		return false
+	}
+	if this.MaxPriceAge != that1.MaxPriceAge {
+		return false
+	}
+	if len(this.CurrencyPairs) != len(that1.CurrencyPairs) {
+		return false
+	}
+	for i := range this.CurrencyPairs {
+		if this.CurrencyPairs[i] != that1.CurrencyPairs[i] {
+			return false
+		}
+	}
+	if len(this.MsgTypeUrls) != len(that1.MsgTypeUrls) {
+		return false
+	}
+	for i := range this.MsgTypeUrls {
+		if this.MsgTypeUrls[i] != that1.MsgTypeUrls[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CurrencyPairs) > 0 {
+		for iNdEx := len(m.CurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrencyPairs[iNdEx])
+			copy(dAtA[i:], m.CurrencyPairs[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.CurrencyPairs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxPriceAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxMissedBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMissedBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMissedBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MaxMissedBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.CurrencyPairs) > 0 {
+		for _, s := range m.CurrencyPairs {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedBlocks", wireType)
+			}
+			m.MaxMissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairs = append(m.CurrencyPairs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
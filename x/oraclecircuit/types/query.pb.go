@@ -0,0 +1,2173 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollinky/oraclecircuit/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd343f258cdc941, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd343f258cdc941, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryGetCircuitStateRequest struct {
+}
+
+func (m *QueryGetCircuitStateRequest) Reset()         { *m = QueryGetCircuitStateRequest{} }
+func (m *QueryGetCircuitStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCircuitStateRequest) ProtoMessage()    {}
+func (*QueryGetCircuitStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd343f258cdc941, []int{2}
+}
+func (m *QueryGetCircuitStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCircuitStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCircuitStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCircuitStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCircuitStateRequest.Merge(m, src)
+}
+func (m *QueryGetCircuitStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCircuitStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCircuitStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCircuitStateRequest proto.InternalMessageInfo
+
+type QueryGetCircuitStateResponse struct {
+	CircuitState CircuitState `protobuf:"bytes,1,opt,name=circuit_state,json=circuitState,proto3" json:"circuit_state"`
+}
+
+func (m *QueryGetCircuitStateResponse) Reset()         { *m = QueryGetCircuitStateResponse{} }
+func (m *QueryGetCircuitStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCircuitStateResponse) ProtoMessage()    {}
+func (*QueryGetCircuitStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd343f258cdc941, []int{3}
+}
+func (m *QueryGetCircuitStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCircuitStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCircuitStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCircuitStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCircuitStateResponse.Merge(m, src)
+}
+func (m *QueryGetCircuitStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCircuitStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCircuitStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCircuitStateResponse proto.InternalMessageInfo
+
+func (m *QueryGetCircuitStateResponse) GetCircuitState() CircuitState {
+	if m != nil {
+		return m.CircuitState
+	}
+	return CircuitState{}
+}
+
+type QueryAllPairStatusRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPairStatusRequest) Reset()         { *m = QueryAllPairStatusRequest{} }
+func (m *QueryAllPairStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPairStatusRequest) ProtoMessage()    {}
+func (*QueryAllPairStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd343f258cdc941, []int{4}
+}
+func (m *QueryAllPairStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPairStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPairStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPairStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPairStatusRequest.Merge(m, src)
+}
+func (m *QueryAllPairStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPairStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPairStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPairStatusRequest proto.InternalMessageInfo
+
+func (m *QueryAllPairStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllPairStatusResponse struct {
+	PairStatus []PairStatus        `protobuf:"bytes,1,rep,name=pair_status,json=pairStatus,proto3" json:"pair_status"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPairStatusResponse) Reset()         { *m = QueryAllPairStatusResponse{} }
+func (m *QueryAllPairStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPairStatusResponse) ProtoMessage()    {}
+func (*QueryAllPairStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd343f258cdc941, []int{5}
+}
+func (m *QueryAllPairStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPairStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPairStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPairStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPairStatusResponse.Merge(m, src)
+}
+func (m *QueryAllPairStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPairStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPairStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPairStatusResponse proto.InternalMessageInfo
+
+func (m *QueryAllPairStatusResponse) GetPairStatus() []PairStatus {
+	if m != nil {
+		return m.PairStatus
+	}
+	return nil
+}
+
+func (m *QueryAllPairStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetCircuitRecordRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetCircuitRecordRequest) Reset()         { *m = QueryGetCircuitRecordRequest{} }
+func (m *QueryGetCircuitRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCircuitRecordRequest) ProtoMessage()    {}
+func (*QueryGetCircuitRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd343f258cdc941, []int{6}
+}
+func (m *QueryGetCircuitRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCircuitRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCircuitRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCircuitRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCircuitRecordRequest.Merge(m, src)
+}
+func (m *QueryGetCircuitRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCircuitRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCircuitRecordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCircuitRecordRequest proto.InternalMessageInfo
+
+func (m *QueryGetCircuitRecordRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryGetCircuitRecordResponse struct {
+	CircuitRecord CircuitRecord `protobuf:"bytes,1,opt,name=circuit_record,json=circuitRecord,proto3" json:"circuit_record"`
+}
+
+func (m *QueryGetCircuitRecordResponse) Reset()         { *m = QueryGetCircuitRecordResponse{} }
+func (m *QueryGetCircuitRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCircuitRecordResponse) ProtoMessage()    {}
+func (*QueryGetCircuitRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd343f258cdc941, []int{7}
+}
+func (m *QueryGetCircuitRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCircuitRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCircuitRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCircuitRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCircuitRecordResponse.Merge(m, src)
+}
+func (m *QueryGetCircuitRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCircuitRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCircuitRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCircuitRecordResponse proto.InternalMessageInfo
+
+func (m *QueryGetCircuitRecordResponse) GetCircuitRecord() CircuitRecord {
+	if m != nil {
+		return m.CircuitRecord
+	}
+	return CircuitRecord{}
+}
+
+type QueryAllCircuitRecordRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllCircuitRecordRequest) Reset()         { *m = QueryAllCircuitRecordRequest{} }
+func (m *QueryAllCircuitRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCircuitRecordRequest) ProtoMessage()    {}
+func (*QueryAllCircuitRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd343f258cdc941, []int{8}
+}
+func (m *QueryAllCircuitRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllCircuitRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllCircuitRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllCircuitRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCircuitRecordRequest.Merge(m, src)
+}
+func (m *QueryAllCircuitRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllCircuitRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCircuitRecordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllCircuitRecordRequest proto.InternalMessageInfo
+
+func (m *QueryAllCircuitRecordRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllCircuitRecordResponse struct {
+	CircuitRecord []CircuitRecord     `protobuf:"bytes,1,rep,name=circuit_record,json=circuitRecord,proto3" json:"circuit_record"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllCircuitRecordResponse) Reset()         { *m = QueryAllCircuitRecordResponse{} }
+func (m *QueryAllCircuitRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCircuitRecordResponse) ProtoMessage()    {}
+func (*QueryAllCircuitRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd343f258cdc941, []int{9}
+}
+func (m *QueryAllCircuitRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllCircuitRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllCircuitRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllCircuitRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCircuitRecordResponse.Merge(m, src)
+}
+func (m *QueryAllCircuitRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllCircuitRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCircuitRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllCircuitRecordResponse proto.InternalMessageInfo
+
+func (m *QueryAllCircuitRecordResponse) GetCircuitRecord() []CircuitRecord {
+	if m != nil {
+		return m.CircuitRecord
+	}
+	return nil
+}
+
+func (m *QueryAllCircuitRecordResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "rollinky.oraclecircuit.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "rollinky.oraclecircuit.QueryParamsResponse")
+	proto.RegisterType((*QueryGetCircuitStateRequest)(nil), "rollinky.oraclecircuit.QueryGetCircuitStateRequest")
+	proto.RegisterType((*QueryGetCircuitStateResponse)(nil), "rollinky.oraclecircuit.QueryGetCircuitStateResponse")
+	proto.RegisterType((*QueryAllPairStatusRequest)(nil), "rollinky.oraclecircuit.QueryAllPairStatusRequest")
+	proto.RegisterType((*QueryAllPairStatusResponse)(nil), "rollinky.oraclecircuit.QueryAllPairStatusResponse")
+	proto.RegisterType((*QueryGetCircuitRecordRequest)(nil), "rollinky.oraclecircuit.QueryGetCircuitRecordRequest")
+	proto.RegisterType((*QueryGetCircuitRecordResponse)(nil), "rollinky.oraclecircuit.QueryGetCircuitRecordResponse")
+	proto.RegisterType((*QueryAllCircuitRecordRequest)(nil), "rollinky.oraclecircuit.QueryAllCircuitRecordRequest")
+	proto.RegisterType((*QueryAllCircuitRecordResponse)(nil), "rollinky.oraclecircuit.QueryAllCircuitRecordResponse")
+}
+
+func init() {
+	proto.RegisterFile("rollinky/oraclecircuit/query.proto", fileDescriptor_7dd343f258cdc941)
+}
+
+var fileDescriptor_7dd343f258cdc941 = []byte{
+	// 665 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0x73, 0x69, 0x89, 0xc4, 0xb5, 0xa9, 0xe0, 0xa8, 0x10, 0x98, 0xd6, 0x54, 0xee, 0xab,
+	0x5a, 0xd5, 0xa7, 0x26, 0x45, 0x62, 0x4d, 0x90, 0xa8, 0x98, 0x08, 0x66, 0x41, 0x2c, 0xe8, 0xe2,
+	0x1c, 0xd6, 0x09, 0xc7, 0xe7, 0xda, 0x0e, 0x22, 0x42, 0x2c, 0x6c, 0x6c, 0x48, 0x7c, 0x04, 0x96,
+	0x6e, 0x94, 0x9d, 0x0f, 0x50, 0x31, 0x55, 0x62, 0x61, 0x42, 0x28, 0x41, 0xe2, 0x6b, 0x20, 0xdf,
+	0x9d, 0xd3, 0x98, 0xda, 0x79, 0x81, 0x2e, 0x89, 0xf5, 0xdc, 0xf3, 0xf2, 0x7b, 0xfe, 0x8f, 0xef,
+	0x31, 0x34, 0x02, 0xee, 0xba, 0xcc, 0x7b, 0xd1, 0xc5, 0x3c, 0x20, 0xb6, 0x4b, 0x6d, 0x16, 0xd8,
+	0x1d, 0x16, 0xe1, 0xc3, 0x0e, 0x0d, 0xba, 0xa6, 0x1f, 0xf0, 0x88, 0xa3, 0xeb, 0x89, 0x8f, 0x99,
+	0xf2, 0xd1, 0xae, 0x92, 0x36, 0xf3, 0x38, 0x16, 0xbf, 0xd2, 0x55, 0x5b, 0x74, 0xb8, 0xc3, 0xc5,
+	0x23, 0x8e, 0x9f, 0x94, 0x75, 0xc9, 0xe1, 0xdc, 0x71, 0x29, 0x26, 0x3e, 0xc3, 0xc4, 0xf3, 0x78,
+	0x44, 0x22, 0xc6, 0xbd, 0x50, 0x9d, 0x6e, 0xdb, 0x3c, 0x6c, 0xf3, 0x10, 0x37, 0x49, 0x48, 0x65,
+	0x5d, 0xfc, 0x72, 0xaf, 0x49, 0x23, 0xb2, 0x87, 0x7d, 0xe2, 0x30, 0x4f, 0x38, 0x2b, 0xdf, 0xb5,
+	0x1c, 0x5c, 0xf5, 0xaf, 0xbc, 0x56, 0x73, 0xbc, 0x7c, 0x12, 0x90, 0xb6, 0x2a, 0x6b, 0x2c, 0x42,
+	0xf4, 0x28, 0x2e, 0xd6, 0x10, 0x46, 0x8b, 0x1e, 0x76, 0x68, 0x18, 0x19, 0x4f, 0xe0, 0xb5, 0x94,
+	0x35, 0xf4, 0xb9, 0x17, 0x52, 0x54, 0x83, 0x25, 0x19, 0x7c, 0x03, 0xac, 0x80, 0xad, 0xb9, 0x8a,
+	0x6e, 0x66, 0x6b, 0x62, 0xca, 0xb8, 0xfa, 0xe5, 0x93, 0x1f, 0xb7, 0x0b, 0x47, 0xbf, 0x8f, 0xb7,
+	0x81, 0xa5, 0x02, 0x8d, 0x65, 0x78, 0x4b, 0x64, 0x3e, 0xa0, 0xd1, 0x3d, 0xe9, 0xfc, 0x38, 0x22,
+	0x11, 0x4d, 0x0a, 0x73, 0xb8, 0x94, 0x7d, 0xac, 0x08, 0x1e, 0xc2, 0xb2, 0xaa, 0xf1, 0x2c, 0x8c,
+	0x0f, 0x14, 0xc8, 0x5a, 0x1e, 0xc8, 0x70, 0x92, 0xfa, 0x6c, 0x8c, 0x63, 0xcd, 0xdb, 0x43, 0x36,
+	0xc3, 0x86, 0x37, 0x45, 0xc1, 0x9a, 0xeb, 0x36, 0x08, 0x0b, 0x62, 0x63, 0x27, 0x91, 0x01, 0xdd,
+	0x87, 0xf0, 0x4c, 0x7b, 0x55, 0x6a, 0xc3, 0x94, 0x83, 0x32, 0xe3, 0x41, 0x99, 0xf2, 0x05, 0x51,
+	0x83, 0x32, 0x1b, 0xc4, 0x49, 0x3a, 0xb1, 0x86, 0x22, 0x8d, 0x63, 0x00, 0xb5, 0xac, 0x2a, 0xaa,
+	0xa9, 0x07, 0x70, 0xce, 0x27, 0x2c, 0x10, 0x1d, 0x75, 0x62, 0x6d, 0x67, 0xb6, 0xe6, 0x2a, 0x46,
+	0xbe, 0xb6, 0x49, 0x02, 0xd5, 0x10, 0xf4, 0x07, 0x16, 0x74, 0x90, 0x22, 0x2e, 0x0a, 0xe2, 0xcd,
+	0xb1, 0xc4, 0x92, 0x23, 0x85, 0x6c, 0x9e, 0x1b, 0x84, 0x45, 0x6d, 0x1e, 0xb4, 0x12, 0x69, 0x16,
+	0x60, 0x91, 0xb5, 0x84, 0x24, 0xb3, 0x56, 0x91, 0xb5, 0x8c, 0x10, 0x2e, 0xe7, 0xf8, 0xab, 0x26,
+	0x2d, 0xb8, 0x90, 0x4c, 0x2e, 0x10, 0x27, 0x4a, 0xcf, 0xf5, 0x31, 0xa3, 0x93, 0x69, 0x54, 0xab,
+	0x65, 0x7b, 0xd8, 0x68, 0x3c, 0x57, 0x90, 0x35, 0xd7, 0xcd, 0x84, 0xbc, 0xa8, 0xf9, 0x7d, 0x01,
+	0x70, 0x39, 0xa7, 0xd0, 0x88, 0xee, 0x66, 0xfe, 0xaf, 0xbb, 0x0b, 0x9b, 0x65, 0xe5, 0x6b, 0x09,
+	0x5e, 0x12, 0xf8, 0xe8, 0x1d, 0x80, 0x25, 0x79, 0x37, 0xd1, 0x76, 0x1e, 0xd9, 0xf9, 0x75, 0xa0,
+	0xed, 0x4c, 0xe4, 0x2b, 0x2b, 0x1b, 0x1b, 0x6f, 0xbf, 0xfd, 0xfa, 0x50, 0x5c, 0x41, 0x3a, 0x1e,
+	0xb9, 0x7f, 0xd0, 0x11, 0x80, 0xf3, 0xc3, 0xd7, 0x13, 0x55, 0x47, 0x56, 0xc9, 0x5e, 0x18, 0xda,
+	0xfe, 0x74, 0x41, 0x8a, 0x71, 0x57, 0x30, 0x6e, 0xa2, 0x75, 0x3c, 0x7a, 0x93, 0xca, 0x25, 0x83,
+	0x3e, 0x02, 0x58, 0x3e, 0xbb, 0x76, 0x35, 0xd7, 0x45, 0x7b, 0x23, 0xcb, 0x66, 0x2d, 0x13, 0xad,
+	0x32, 0x4d, 0x88, 0xe2, 0xdc, 0x11, 0x9c, 0xeb, 0x68, 0x35, 0x5f, 0xcb, 0xc1, 0xde, 0x40, 0x9f,
+	0x01, 0x2c, 0xa7, 0x5e, 0x2b, 0x34, 0xa9, 0x38, 0xa9, 0x5b, 0xa3, 0xdd, 0x99, 0x32, 0x4a, 0xb1,
+	0x56, 0x05, 0xeb, 0x2e, 0xda, 0x19, 0xa7, 0xa9, 0xbc, 0x20, 0xf8, 0x35, 0x6b, 0xbd, 0x41, 0x9f,
+	0x00, 0xbc, 0x92, 0x4a, 0x17, 0x8b, 0xbb, 0x3f, 0x4e, 0xa9, 0x7f, 0xc0, 0xce, 0xbb, 0xb9, 0x86,
+	0x29, 0xb0, 0xb7, 0xd0, 0xc6, 0x64, 0xd8, 0xf5, 0xbb, 0x27, 0x3d, 0x1d, 0x9c, 0xf6, 0x74, 0xf0,
+	0xb3, 0xa7, 0x83, 0xf7, 0x7d, 0xbd, 0x70, 0xda, 0xd7, 0x0b, 0xdf, 0xfb, 0x7a, 0xe1, 0xa9, 0x3e,
+	0x48, 0xf0, 0xea, 0xaf, 0x14, 0x51, 0xd7, 0xa7, 0x61, 0xb3, 0x24, 0xbe, 0xb8, 0xd5, 0x3f, 0x03,
+	0x00, 0x23, 0x2b, 0xb7, 0xb8, 0x6d, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// CircuitState queries the current state of the circuit.
+	CircuitState(ctx context.Context, in *QueryGetCircuitStateRequest, opts ...grpc.CallOption) (*QueryGetCircuitStateResponse, error)
+	// Queries a list of PairStatus items.
+	PairStatusAll(ctx context.Context, in *QueryAllPairStatusRequest, opts ...grpc.CallOption) (*QueryAllPairStatusResponse, error)
+	// Queries a CircuitRecord by id.
+	CircuitRecord(ctx context.Context, in *QueryGetCircuitRecordRequest, opts ...grpc.CallOption) (*QueryGetCircuitRecordResponse, error)
+	// Queries a list of CircuitRecord items.
+	CircuitRecordAll(ctx context.Context, in *QueryAllCircuitRecordRequest, opts ...grpc.CallOption) (*QueryAllCircuitRecordResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/rollinky.oraclecircuit.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitState(ctx context.Context, in *QueryGetCircuitStateRequest, opts ...grpc.CallOption) (*QueryGetCircuitStateResponse, error) {
+	out := new(QueryGetCircuitStateResponse)
+	err := c.cc.Invoke(ctx, "/rollinky.oraclecircuit.Query/CircuitState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PairStatusAll(ctx context.Context, in *QueryAllPairStatusRequest, opts ...grpc.CallOption) (*QueryAllPairStatusResponse, error) {
+	out := new(QueryAllPairStatusResponse)
+	err := c.cc.Invoke(ctx, "/rollinky.oraclecircuit.Query/PairStatusAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitRecord(ctx context.Context, in *QueryGetCircuitRecordRequest, opts ...grpc.CallOption) (*QueryGetCircuitRecordResponse, error) {
+	out := new(QueryGetCircuitRecordResponse)
+	err := c.cc.Invoke(ctx, "/rollinky.oraclecircuit.Query/CircuitRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitRecordAll(ctx context.Context, in *QueryAllCircuitRecordRequest, opts ...grpc.CallOption) (*QueryAllCircuitRecordResponse, error) {
+	out := new(QueryAllCircuitRecordResponse)
+	err := c.cc.Invoke(ctx, "/rollinky.oraclecircuit.Query/CircuitRecordAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// CircuitState queries the current state of the circuit.
+	CircuitState(context.Context, *QueryGetCircuitStateRequest) (*QueryGetCircuitStateResponse, error)
+	// Queries a list of PairStatus items.
+	PairStatusAll(context.Context, *QueryAllPairStatusRequest) (*QueryAllPairStatusResponse, error)
+	// Queries a CircuitRecord by id.
+	CircuitRecord(context.Context, *QueryGetCircuitRecordRequest) (*QueryGetCircuitRecordResponse, error)
+	// Queries a list of CircuitRecord items.
+	CircuitRecordAll(context.Context, *QueryAllCircuitRecordRequest) (*QueryAllCircuitRecordResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) CircuitState(ctx context.Context, req *QueryGetCircuitStateRequest) (*QueryGetCircuitStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitState not implemented")
+}
+func (*UnimplementedQueryServer) PairStatusAll(ctx context.Context, req *QueryAllPairStatusRequest) (*QueryAllPairStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PairStatusAll not implemented")
+}
+func (*UnimplementedQueryServer) CircuitRecord(ctx context.Context, req *QueryGetCircuitRecordRequest) (*QueryGetCircuitRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitRecord not implemented")
+}
+func (*UnimplementedQueryServer) CircuitRecordAll(ctx context.Context, req *QueryAllCircuitRecordRequest) (*QueryAllCircuitRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitRecordAll not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollinky.oraclecircuit.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCircuitStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollinky.oraclecircuit.Query/CircuitState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitState(ctx, req.(*QueryGetCircuitStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PairStatusAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPairStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PairStatusAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollinky.oraclecircuit.Query/PairStatusAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PairStatusAll(ctx, req.(*QueryAllPairStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCircuitRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollinky.oraclecircuit.Query/CircuitRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitRecord(ctx, req.(*QueryGetCircuitRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitRecordAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCircuitRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitRecordAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollinky.oraclecircuit.Query/CircuitRecordAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitRecordAll(ctx, req.(*QueryAllCircuitRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rollinky.oraclecircuit.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "CircuitState",
+			Handler:    _Query_CircuitState_Handler,
+		},
+		{
+			MethodName: "PairStatusAll",
+			Handler:    _Query_PairStatusAll_Handler,
+		},
+		{
+			MethodName: "CircuitRecord",
+			Handler:    _Query_CircuitRecord_Handler,
+		},
+		{
+			MethodName: "CircuitRecordAll",
+			Handler:    _Query_CircuitRecordAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rollinky/oraclecircuit/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCircuitStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCircuitStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCircuitStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCircuitStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCircuitStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCircuitStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CircuitState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPairStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPairStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPairStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPairStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPairStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPairStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PairStatus) > 0 {
+		for iNdEx := len(m.PairStatus) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairStatus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCircuitRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCircuitRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCircuitRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCircuitRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCircuitRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCircuitRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CircuitRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllCircuitRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCircuitRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCircuitRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllCircuitRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCircuitRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCircuitRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CircuitRecord) > 0 {
+		for iNdEx := len(m.CircuitRecord) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitRecord[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetCircuitStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetCircuitStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CircuitState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPairStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPairStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PairStatus) > 0 {
+		for _, e := range m.PairStatus {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCircuitRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetCircuitRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CircuitRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllCircuitRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCircuitRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CircuitRecord) > 0 {
+		for _, e := range m.CircuitRecord {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCircuitStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCircuitStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCircuitStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCircuitStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCircuitStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCircuitStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPairStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPairStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPairStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPairStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPairStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPairStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairStatus = append(m.PairStatus, PairStatus{})
+			if err := m.PairStatus[len(m.PairStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCircuitRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCircuitRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCircuitRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCircuitRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCircuitRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCircuitRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCircuitRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCircuitRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCircuitRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCircuitRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCircuitRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCircuitRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitRecord = append(m.CircuitRecord, CircuitRecord{})
+			if err := m.CircuitRecord[len(m.CircuitRecord)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
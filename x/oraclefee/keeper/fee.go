@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+
+	"rollinky/x/oraclefee/types"
+)
+
+// CheckFee checks that fee is worth at least gas at the min gas price. Every
+// denom of the fee must be accepted and have a price written at most
+// max_price_age before the block. A fee that can't be valued this way must
+// pay the fallback min gas prices instead. It checks nothing while the min gas
+// price is zero.
+func (k Keeper) CheckFee(ctx context.Context, fee sdk.Coins, gas uint64) error {
+	params := k.GetParams(ctx)
+	if !params.MinGasPrice.IsPositive() {
+		return nil
+	}
+
+	// values are summed in 10^-18 USD, the precision of the min gas price, so
+	// that a fee of many denoms isn't rounded down more than one of one.
+	total := new(big.Int)
+	for _, coin := range fee {
+		value, err := k.feeValue(ctx, params, coin)
+		if err != nil {
+			return checkFallbackFee(params, fee, gas, err)
+		}
+		total.Add(total, value)
+	}
+
+	required := params.MinGasPrice.MulInt(math.NewIntFromUint64(gas))
+	if total.Cmp(required.BigInt()) < 0 {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientFee,
+			"fee of %s is worth %s %s, %d gas needs %s %s",
+			fee, math.LegacyNewDecFromBigIntWithPrec(total, math.LegacyPrecision), types.QuoteDenom,
+			gas, required, types.QuoteDenom,
+		)
+	}
+
+	return nil
+}
+
+// checkFallbackFee checks that fee pays gas at one of the fallback min gas
+// prices, like the node's minimum gas prices are checked. err is why the fee
+// couldn't be valued at the oracle prices, it is returned if the fee doesn't.
+func checkFallbackFee(params types.Params, fee sdk.Coins, gas uint64, err error) error {
+	required := make(sdk.Coins, 0, len(params.FallbackMinGasPrices))
+	for _, gp := range params.FallbackMinGasPrices {
+		required = append(required, sdk.NewCoin(gp.Denom, gp.Amount.MulInt(math.NewIntFromUint64(gas)).Ceil().RoundInt()))
+	}
+
+	if len(required) == 0 || !fee.IsAnyGTE(required) {
+		return errorsmod.Wrapf(err, "fee of %s can't be valued at the oracle prices and doesn't pay the fallback min gas prices, %d gas needs one of %s", fee, gas, required)
+	}
+
+	return nil
+}
+
+// feeValue returns what coin is worth in 10^-18 USD at the latest price of
+// its currency pair.
+func (k Keeper) feeValue(ctx context.Context, params types.Params, coin sdk.Coin) (*big.Int, error) {
+	feeDenom, found := k.GetFeeDenom(ctx, coin.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrFeeDenomNotFound, "%s", coin.Denom)
+	}
+
+	cp, err := connecttypes.CurrencyPairFromString(feeDenom.CurrencyPair)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := k.oracleKeeper.GetPriceForCurrencyPair(ctx, cp)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrStaleFeePrice, "%s has no price of %s", coin.Denom, cp)
+	}
+	if age := sdk.UnwrapSDKContext(ctx).BlockTime().Sub(price.BlockTimestamp); age > params.MaxPriceAge {
+		return nil, errorsmod.Wrapf(
+			types.ErrStaleFeePrice,
+			"%s needs a price of %s at most %s old, the latest is %s old",
+			coin.Denom, cp, params.MaxPriceAge, age,
+		)
+	}
+
+	market, err := k.marketMapKeeper.GetMarket(ctx, feeDenom.CurrencyPair)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrCurrencyPairUnknown, "%s: %s", feeDenom.CurrencyPair, err)
+	}
+
+	// amount * price * 10^18 / 10^(decimals of the denom + decimals of the price)
+	value := new(big.Int).Mul(coin.Amount.BigInt(), price.Price.BigInt())
+	value.Mul(value, pow10(math.LegacyPrecision))
+	return value.Quo(value, pow10(feeDenom.Decimals+market.Ticker.Decimals)), nil
+}
+
+func pow10(n uint64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), new(big.Int).SetUint64(n), nil)
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"rollinky/x/oraclefee/types"
+)
+
+// SetFeeDenom set a specific feeDenom in the store from its index
+func (k Keeper) SetFeeDenom(ctx context.Context, feeDenom types.FeeDenom) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeeDenomKeyPrefix))
+	b := k.cdc.MustMarshal(&feeDenom)
+	store.Set(types.FeeDenomKey(
+		feeDenom.Denom,
+	), b)
+}
+
+// GetFeeDenom returns a feeDenom from its index
+func (k Keeper) GetFeeDenom(
+	ctx context.Context,
+	denom string,
+) (val types.FeeDenom, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeeDenomKeyPrefix))
+
+	b := store.Get(types.FeeDenomKey(
+		denom,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveFeeDenom removes a feeDenom from the store
+func (k Keeper) RemoveFeeDenom(
+	ctx context.Context,
+	denom string,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeeDenomKeyPrefix))
+	store.Delete(types.FeeDenomKey(
+		denom,
+	))
+}
+
+// GetAllFeeDenom returns all feeDenom
+func (k Keeper) GetAllFeeDenom(ctx context.Context) (list []types.FeeDenom) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeeDenomKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FeeDenom
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/x/oraclefee/keeper"
+	"rollinky/x/oraclefee/types"
+)
+
+var (
+	blockTime = time.Unix(1700000000, 0).UTC()
+	atomUSD   = connecttypes.NewCurrencyPair("ATOM", "USD")
+	usdcUSD   = connecttypes.NewCurrencyPair("USDC", "USD")
+)
+
+// fallbackMinGasPrices are 0.01stake per gas.
+var fallbackMinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(1, 2)))
+
+func market(cp connecttypes.CurrencyPair, decimals uint64) mmtypes.Market {
+	return mmtypes.Market{Ticker: mmtypes.Ticker{CurrencyPair: cp, Decimals: decimals}}
+}
+
+func setupFees(t *testing.T) (keeper.Keeper, sdk.Context, *keepertest.MockOracleKeeper) {
+	t.Helper()
+
+	ok := &keepertest.MockOracleKeeper{Prices: map[connecttypes.CurrencyPair]oracletypes.QuotePrice{
+		// 10 USD with 8 decimals, 1 USD with 6 decimals
+		atomUSD: {Price: math.NewInt(1_000_000_000), BlockTimestamp: blockTime},
+		usdcUSD: {Price: math.NewInt(1_000_000), BlockTimestamp: blockTime.Add(-time.Minute)},
+	}}
+	k, ctx := keepertest.OraclefeeKeeperWithMocks(t, ok, keepertest.MockMarketMapKeeper{
+		"ATOM/USD": market(atomUSD, 8),
+		"USDC/USD": market(usdcUSD, 6),
+	})
+	require.NoError(t, k.SetParams(ctx, types.NewParams(math.LegacyNewDecWithPrec(1, 6), time.Minute, fallbackMinGasPrices)))
+	k.SetFeeDenom(ctx, types.FeeDenom{Denom: "uatom", CurrencyPair: "ATOM/USD", Decimals: 6})
+	k.SetFeeDenom(ctx, types.FeeDenom{Denom: "uusdc", CurrencyPair: "USDC/USD", Decimals: 6})
+
+	return k, ctx.WithBlockHeader(cmtproto.Header{Height: 2, Time: blockTime}), ok
+}
+
+func TestCheckFee(t *testing.T) {
+	k, ctx, ok := setupFees(t)
+
+	for _, tc := range []struct {
+		desc   string
+		fee    string
+		gas    uint64
+		expErr error
+		errMsg string
+	}{
+		{
+			desc: "fee worth the gas",
+			fee:  "20000uatom",
+			gas:  200_000,
+		},
+		{
+			desc: "fee worth the gas in two denoms",
+			fee:  "10000uatom,100000uusdc",
+			gas:  200_000,
+		},
+		{
+			desc:   "insufficient fee",
+			fee:    "19999uatom",
+			gas:    200_000,
+			expErr: types.ErrInsufficientFee,
+			errMsg: "fee of 19999uatom is worth 0.199990000000000000 USD, 200000 gas needs 0.200000000000000000 USD",
+		},
+		{
+			desc:   "no fee",
+			gas:    1,
+			expErr: types.ErrInsufficientFee,
+		},
+		{
+			desc:   "denom not accepted",
+			fee:    "1000000uosmo",
+			gas:    1,
+			expErr: types.ErrFeeDenomNotFound,
+		},
+		{
+			desc: "fee paying the fallback min gas prices",
+			fee:  "2000stake",
+			gas:  200_000,
+		},
+		{
+			desc:   "fee not paying the fallback min gas prices",
+			fee:    "1999stake",
+			gas:    200_000,
+			expErr: types.ErrFeeDenomNotFound,
+			errMsg: "fee of 1999stake can't be valued at the oracle prices and doesn't pay the fallback min gas prices, 200000 gas needs one of 2000stake",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			fee, err := sdk.ParseCoinsNormalized(tc.fee)
+			require.NoError(t, err)
+
+			err = k.CheckFee(ctx, fee, tc.gas)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.expErr)
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+
+	// the price of uusdc is a minute old, one more second makes it stale.
+	err := k.CheckFee(ctx.WithBlockTime(blockTime.Add(time.Second)), sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000)), 1)
+	require.ErrorIs(t, err, types.ErrStaleFeePrice)
+	require.ErrorContains(t, err, "uusdc needs a price of USDC/USD at most 1m0s old, the latest is 1m1s old")
+
+	delete(ok.Prices, usdcUSD)
+	err = k.CheckFee(ctx, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000)), 1)
+	require.ErrorIs(t, err, types.ErrStaleFeePrice)
+
+	// while the oracle is stale, a fee paying the fallback min gas prices is
+	// accepted, so that governance can still fix it.
+	stale := ctx.WithBlockTime(blockTime.Add(time.Hour))
+	err = k.CheckFee(stale, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000)), 200_000)
+	require.ErrorIs(t, err, types.ErrStaleFeePrice)
+	require.NoError(t, k.CheckFee(stale, sdk.NewCoins(sdk.NewInt64Coin("stake", 2000), sdk.NewInt64Coin("uatom", 1)), 200_000))
+
+	// a zero min gas price accepts any fee.
+	require.NoError(t, k.SetParams(ctx, types.NewParams(math.LegacyZeroDec(), time.Minute, nil)))
+	require.NoError(t, k.CheckFee(ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), 200_000))
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/oraclefee/types"
+)
+
+func (k msgServer) SetFeeDenom(goCtx context.Context, req *types.MsgSetFeeDenom) (*types.MsgSetFeeDenomResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if err := req.FeeDenom.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.marketMapKeeper.GetMarket(ctx, req.FeeDenom.CurrencyPair); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrCurrencyPairUnknown, "%s: %s", req.FeeDenom.CurrencyPair, err)
+	}
+
+	k.Keeper.SetFeeDenom(ctx, req.FeeDenom)
+
+	return &types.MsgSetFeeDenomResponse{}, nil
+}
+
+func (k msgServer) RemoveFeeDenom(goCtx context.Context, req *types.MsgRemoveFeeDenom) (*types.MsgRemoveFeeDenomResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetFeeDenom(ctx, req.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrFeeDenomNotFound, "%s", req.Denom)
+	}
+
+	k.Keeper.RemoveFeeDenom(ctx, req.Denom)
+
+	return &types.MsgRemoveFeeDenomResponse{}, nil
+}
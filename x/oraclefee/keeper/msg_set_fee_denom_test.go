@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"rollinky/x/oraclefee/keeper"
+	"rollinky/x/oraclefee/types"
+)
+
+func TestMsgSetFeeDenom(t *testing.T) {
+	k, ctx, _ := setupFees(t)
+	ms := keeper.NewMsgServerImpl(k)
+	feeDenom := types.FeeDenom{Denom: "uatom", CurrencyPair: "ATOM/USD", Decimals: 3}
+
+	_, err := ms.SetFeeDenom(ctx, &types.MsgSetFeeDenom{Authority: "invalid", FeeDenom: feeDenom})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = ms.SetFeeDenom(ctx, &types.MsgSetFeeDenom{
+		Authority: k.GetAuthority(),
+		FeeDenom:  types.FeeDenom{Denom: "uatom", CurrencyPair: "ATOM/EUR"},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidFeeDenom)
+
+	_, err = ms.SetFeeDenom(ctx, &types.MsgSetFeeDenom{
+		Authority: k.GetAuthority(),
+		FeeDenom:  types.FeeDenom{Denom: "uosmo", CurrencyPair: "OSMO/USD"},
+	})
+	require.ErrorIs(t, err, types.ErrCurrencyPairUnknown)
+
+	_, err = ms.SetFeeDenom(ctx, &types.MsgSetFeeDenom{Authority: k.GetAuthority(), FeeDenom: feeDenom})
+	require.NoError(t, err)
+	got, found := k.GetFeeDenom(ctx, "uatom")
+	require.True(t, found)
+	require.Equal(t, feeDenom, got)
+
+	_, err = ms.RemoveFeeDenom(ctx, &types.MsgRemoveFeeDenom{Authority: "invalid", Denom: "uatom"})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = ms.RemoveFeeDenom(ctx, &types.MsgRemoveFeeDenom{Authority: k.GetAuthority(), Denom: "uatom"})
+	require.NoError(t, err)
+	_, found = k.GetFeeDenom(ctx, "uatom")
+	require.False(t, found)
+
+	_, err = ms.RemoveFeeDenom(ctx, &types.MsgRemoveFeeDenom{Authority: k.GetAuthority(), Denom: "uatom"})
+	require.ErrorIs(t, err, types.ErrFeeDenomNotFound)
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/x/oraclefee/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	params := types.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, params))
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	update := func(authority string, params types.Params) error {
+		_, err := ms.UpdateParams(wctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+		return err
+	}
+	get := func() types.Params { return k.GetParams(wctx) }
+
+	minGasPrice := math.LegacyNewDecWithPrec(1, 3)
+
+	keepertest.CheckMsgUpdateParams(t, k.GetAuthority(), update, get, []keepertest.UpdateParamsCase[types.Params]{
+		{
+			Name:      "negative min gas price",
+			Params:    types.NewParams(minGasPrice.Neg(), time.Minute, fallbackMinGasPrices),
+			ExpErrMsg: "min gas price must not be negative",
+		},
+		{
+			Name:      "zero max price age",
+			Params:    types.NewParams(minGasPrice, 0, fallbackMinGasPrices),
+			ExpErrMsg: "max price age must be positive",
+		},
+		{
+			Name:      "invalid fallback min gas prices",
+			Params:    types.NewParams(minGasPrice, time.Minute, sdk.DecCoins{{Denom: "stake", Amount: math.LegacyNewDec(-1)}}),
+			ExpErrMsg: "invalid fallback min gas prices",
+		},
+		{
+			Name:      "min gas price without fallback min gas prices",
+			Params:    types.NewParams(minGasPrice, time.Minute, nil),
+			ExpErrMsg: "fallback min gas prices are required",
+		},
+		{
+			Name:   "min gas price",
+			Params: types.NewParams(minGasPrice, time.Minute, fallbackMinGasPrices),
+		},
+		{
+			Name:   "all good",
+			Params: params,
+		},
+	})
+}
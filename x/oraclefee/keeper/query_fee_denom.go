@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rollinky/x/oraclefee/types"
+)
+
+func (k Keeper) FeeDenomAll(ctx context.Context, req *types.QueryAllFeeDenomRequest) (*types.QueryAllFeeDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var feeDenoms []types.FeeDenom
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	feeDenomStore := prefix.NewStore(store, types.KeyPrefix(types.FeeDenomKeyPrefix))
+
+	pageRes, err := query.Paginate(feeDenomStore, req.Pagination, func(key []byte, value []byte) error {
+		var feeDenom types.FeeDenom
+		if err := k.cdc.Unmarshal(value, &feeDenom); err != nil {
+			return err
+		}
+
+		feeDenoms = append(feeDenoms, feeDenom)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllFeeDenomResponse{FeeDenom: feeDenoms, Pagination: pageRes}, nil
+}
+
+func (k Keeper) FeeDenom(ctx context.Context, req *types.QueryGetFeeDenomRequest) (*types.QueryGetFeeDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetFeeDenom(
+		ctx,
+		req.Denom,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetFeeDenomResponse{FeeDenom: val}, nil
+}
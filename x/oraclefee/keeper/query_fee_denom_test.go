@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rollinky/x/oraclefee/types"
+)
+
+func TestFeeDenomQueries(t *testing.T) {
+	k, ctx, _ := setupFees(t)
+
+	_, err := k.FeeDenom(ctx, nil)
+	require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+	_, err = k.FeeDenomAll(ctx, nil)
+	require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+
+	res, err := k.FeeDenom(ctx, &types.QueryGetFeeDenomRequest{Denom: "uatom"})
+	require.NoError(t, err)
+	require.Equal(t, types.FeeDenom{Denom: "uatom", CurrencyPair: "ATOM/USD", Decimals: 6}, res.FeeDenom)
+
+	_, err = k.FeeDenom(ctx, &types.QueryGetFeeDenomRequest{Denom: "stake"})
+	require.Equal(t, status.Error(codes.NotFound, "not found"), err)
+
+	all, err := k.FeeDenomAll(ctx, &types.QueryAllFeeDenomRequest{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, k.GetAllFeeDenom(ctx), all.FeeDenom)
+	require.Len(t, all.FeeDenom, 2)
+}
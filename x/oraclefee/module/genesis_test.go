@@ -0,0 +1,34 @@
+package oraclefee_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/testutil/nullify"
+	oraclefee "rollinky/x/oraclefee/module"
+	"rollinky/x/oraclefee/types"
+)
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+
+		FeeDenomList: []types.FeeDenom{
+			{Denom: "uatom", CurrencyPair: "ATOM/USD", Decimals: 6},
+			{Denom: "uusdc", CurrencyPair: "USDC/USD", Decimals: 6},
+		},
+		// this line is used by starport scaffolding # genesis/test/state
+	}
+
+	k, ctx := keepertest.OraclefeeKeeper(t)
+	oraclefee.InitGenesis(ctx, k, genesisState)
+	got := oraclefee.ExportGenesis(ctx, k)
+	require.NotNil(t, got)
+
+	nullify.Fill(&genesisState)
+	nullify.Fill(got)
+
+	require.ElementsMatch(t, genesisState.FeeDenomList, got.FeeDenomList)
+	// this line is used by starport scaffolding # genesis/test/assert
+}
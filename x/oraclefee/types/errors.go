@@ -0,0 +1,17 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/oraclefee module sentinel errors
+var (
+	ErrInvalidSigner       = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidFeeDenom     = sdkerrors.Register(ModuleName, 1101, "invalid fee denom")
+	ErrFeeDenomNotFound    = sdkerrors.Register(ModuleName, 1102, "fee denom not accepted")
+	ErrStaleFeePrice       = sdkerrors.Register(ModuleName, 1103, "price of fee denom is stale")
+	ErrInsufficientFee     = sdkerrors.Register(ModuleName, 1104, "insufficient fee")
+	ErrCurrencyPairUnknown = sdkerrors.Register(ModuleName, 1105, "currency pair not in the market map")
+)
@@ -0,0 +1,38 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// QuoteDenom is the quote every fee denom must be priced in, the min gas
+// price is in it too.
+const QuoteDenom = "USD"
+
+// MaxDecimals is the most decimals a fee denom may have.
+const MaxDecimals = 36
+
+// Validate checks the denom is valid and priced in USD.
+func (fd FeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(fd.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidFeeDenom, err.Error())
+	}
+
+	cp, err := connecttypes.CurrencyPairFromString(fd.CurrencyPair)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidFeeDenom, "invalid currency pair %q: %s", fd.CurrencyPair, err)
+	}
+	if cp.String() != fd.CurrencyPair {
+		return errorsmod.Wrapf(ErrInvalidFeeDenom, "currency pair %q must be written as %q", fd.CurrencyPair, cp.String())
+	}
+	if cp.Quote != QuoteDenom {
+		return errorsmod.Wrapf(ErrInvalidFeeDenom, "currency pair %s must be quoted in %s", fd.CurrencyPair, QuoteDenom)
+	}
+
+	if fd.Decimals > MaxDecimals {
+		return errorsmod.Wrapf(ErrInvalidFeeDenom, "decimals must be at most %d, got %d", MaxDecimals, fd.Decimals)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	// this line is used by starport scaffolding # genesis/types/import
+)
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		FeeDenomList: []FeeDenom{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in feeDenom
+	feeDenomIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.FeeDenomList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		index := string(FeeDenomKey(elem.Denom))
+		if _, ok := feeDenomIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for feeDenom")
+		}
+		feeDenomIndexMap[index] = struct{}{}
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
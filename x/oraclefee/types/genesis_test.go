@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"rollinky/x/oraclefee/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc:     "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FeeDenomList: []types.FeeDenom{
+					{Denom: "uatom", CurrencyPair: "ATOM/USD", Decimals: 6},
+					{Denom: "uusdc", CurrencyPair: "USDC/USD", Decimals: 6},
+				},
+				// this line is used by starport scaffolding # types/genesis/validField
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated feeDenom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FeeDenomList: []types.FeeDenom{
+					{Denom: "uatom", CurrencyPair: "ATOM/USD", Decimals: 6},
+					{Denom: "uatom", CurrencyPair: "ATOM/USD", Decimals: 6},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "feeDenom not quoted in USD",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FeeDenomList: []types.FeeDenom{
+					{Denom: "uatom", CurrencyPair: "ATOM/EUR", Decimals: 6},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "min gas price with fallback min gas prices",
+			genState: &types.GenesisState{
+				Params: types.NewParams(math.LegacyNewDecWithPrec(1, 6), types.DefaultMaxPriceAge, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(1, 2)))),
+			},
+			valid: true,
+		},
+		{
+			desc: "min gas price without fallback min gas prices",
+			genState: &types.GenesisState{
+				Params: types.NewParams(math.LegacyNewDecWithPrec(1, 6), types.DefaultMaxPriceAge, nil),
+			},
+			valid: false,
+		},
+		{
+			desc: "unsorted fallback min gas prices",
+			genState: &types.GenesisState{
+				Params: types.NewParams(math.LegacyZeroDec(), types.DefaultMaxPriceAge, sdk.DecCoins{
+					sdk.NewDecCoinFromDec("uatom", math.LegacyOneDec()),
+					sdk.NewDecCoinFromDec("stake", math.LegacyOneDec()),
+				}),
+			},
+			valid: false,
+		},
+		// this line is used by starport scaffolding # types/genesis/testcase
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
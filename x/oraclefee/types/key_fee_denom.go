@@ -0,0 +1,19 @@
+package types
+
+const (
+	// FeeDenomKeyPrefix is the prefix to retrieve all FeeDenom
+	FeeDenomKeyPrefix = "FeeDenom/value/"
+)
+
+// FeeDenomKey returns the store key to retrieve a FeeDenom from the index fields
+func FeeDenomKey(
+	denom string,
+) []byte {
+	var key []byte
+
+	denomBytes := []byte(denom)
+	key = append(key, denomBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgRemoveFeeDenom{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRemoveFeeDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidFeeDenom, err.Error())
+	}
+
+	return nil
+}
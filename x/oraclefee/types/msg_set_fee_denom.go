@@ -0,0 +1,17 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSetFeeDenom{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetFeeDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.FeeDenom.Validate()
+}
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyMinGasPrice = []byte("MinGasPrice")
+	// DefaultMinGasPrice turns fee checks off.
+	DefaultMinGasPrice = math.LegacyZeroDec()
+)
+
+var (
+	KeyMaxPriceAge = []byte("MaxPriceAge")
+	// DefaultMaxPriceAge is the default max age of the price of a fee denom.
+	DefaultMaxPriceAge = time.Minute
+)
+
+var (
+	KeyFallbackMinGasPrices = []byte("FallbackMinGasPrices")
+	// DefaultFallbackMinGasPrices is empty, fee checks are off by default.
+	DefaultFallbackMinGasPrices sdk.DecCoins = nil
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	minGasPrice math.LegacyDec,
+	maxPriceAge time.Duration,
+	fallbackMinGasPrices sdk.DecCoins,
+) Params {
+	return Params{
+		MinGasPrice:          minGasPrice,
+		MaxPriceAge:          maxPriceAge,
+		FallbackMinGasPrices: fallbackMinGasPrices,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultMinGasPrice,
+		DefaultMaxPriceAge,
+		DefaultFallbackMinGasPrices,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinGasPrice, &p.MinGasPrice, validateMinGasPrice),
+		paramtypes.NewParamSetPair(KeyMaxPriceAge, &p.MaxPriceAge, validateMaxPriceAge),
+		paramtypes.NewParamSetPair(KeyFallbackMinGasPrices, &p.FallbackMinGasPrices, validateFallbackMinGasPrices),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateMinGasPrice(p.MinGasPrice); err != nil {
+		return err
+	}
+
+	if err := validateMaxPriceAge(p.MaxPriceAge); err != nil {
+		return err
+	}
+
+	if err := validateFallbackMinGasPrices(p.FallbackMinGasPrices); err != nil {
+		return err
+	}
+
+	// without them, no tx could pay its fee while the oracle is down, not even
+	// the one fixing it.
+	if p.MinGasPrice.IsPositive() && p.FallbackMinGasPrices.IsZero() {
+		return fmt.Errorf("fallback min gas prices are required with a min gas price")
+	}
+
+	return nil
+}
+
+// validateMinGasPrice validates the MinGasPrice param
+func validateMinGasPrice(v interface{}) error {
+	minGasPrice, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if minGasPrice.IsNil() || minGasPrice.IsNegative() {
+		return fmt.Errorf("min gas price must not be negative: %s", minGasPrice)
+	}
+
+	return nil
+}
+
+// validateMaxPriceAge validates the MaxPriceAge param
+func validateMaxPriceAge(v interface{}) error {
+	maxPriceAge, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxPriceAge <= 0 {
+		return fmt.Errorf("max price age must be positive: %s", maxPriceAge)
+	}
+
+	return nil
+}
+
+// validateFallbackMinGasPrices validates the FallbackMinGasPrices param
+func validateFallbackMinGasPrices(v interface{}) error {
+	fallbackMinGasPrices, ok := v.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if err := fallbackMinGasPrices.Validate(); err != nil {
+		return fmt.Errorf("invalid fallback min gas prices: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,497 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollinky/oraclefee/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// min_gas_price is the minimum gas price in USD, the fee of a tx must be
+	// worth at least its gas limit times this. Zero turns fee checks off, so
+	// fees can be paid in any denom.
+	MinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price"`
+	// max_price_age is how long before the block the price of a fee denom may
+	// have been written.
+	MaxPriceAge time.Duration `protobuf:"bytes,2,opt,name=max_price_age,json=maxPriceAge,proto3,stdduration" json:"max_price_age"`
+	// fallback_min_gas_prices are the min gas prices a fee must pay in one of
+	// their denoms when it can't be valued at the oracle prices, e.g. because a
+	// price is missing or older than max_price_age, or its denom isn't a fee
+	// denom. They keep txs, including the governance ones fixing the oracle,
+	// possible while it is down. They are required once min_gas_price is above
+	// zero.
+	FallbackMinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=fallback_min_gas_prices,json=fallbackMinGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fallback_min_gas_prices"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13ccf53de801429e, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxPriceAge() time.Duration {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *Params) GetFallbackMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FallbackMinGasPrices
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "rollinky.oraclefee.Params")
+}
+
+func init() { proto.RegisterFile("rollinky/oraclefee/params.proto", fileDescriptor_13ccf53de801429e) }
+
+var fileDescriptor_13ccf53de801429e = []byte{
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0x5a, 0x28, 0x9a, 0xa5, 0x07, 0x43, 0xc1, 0xed, 0x56, 0x92, 0x45, 0x3c, 0x2c,
+	0x95, 0xce, 0xd0, 0x2a, 0x22, 0xde, 0x5c, 0x17, 0x7a, 0x51, 0x28, 0x1e, 0x7b, 0x09, 0x2f, 0xb3,
+	0xb3, 0xd3, 0x21, 0x99, 0xbc, 0x25, 0x93, 0x95, 0xdd, 0xaf, 0x20, 0x08, 0x1e, 0x3d, 0x7a, 0x14,
+	0x4f, 0x3d, 0xf8, 0x21, 0x7a, 0x2c, 0x9e, 0xc4, 0x43, 0x2b, 0x9b, 0x43, 0xfd, 0x18, 0x32, 0x99,
+	0xc9, 0xaa, 0xd0, 0x4b, 0x92, 0x97, 0xf7, 0xe6, 0xff, 0xff, 0xcd, 0x9f, 0x17, 0xc4, 0x25, 0xe6,
+	0xb9, 0x2a, 0xb2, 0x25, 0xc3, 0x12, 0x78, 0x2e, 0xa6, 0x42, 0xb0, 0x19, 0x94, 0xa0, 0x0d, 0x9d,
+	0x95, 0x58, 0x61, 0x18, 0xb6, 0x03, 0x74, 0x3d, 0xd0, 0xbf, 0x07, 0x5a, 0x15, 0xc8, 0x9a, 0xa7,
+	0x1b, 0xeb, 0x47, 0x1c, 0x8d, 0x46, 0xc3, 0x52, 0x30, 0x82, 0xbd, 0x3b, 0x4c, 0x45, 0x05, 0x87,
+	0x8c, 0xa3, 0x2a, 0x7c, 0x7f, 0xd7, 0xf5, 0x93, 0xa6, 0x62, 0xae, 0xf0, 0xad, 0x1d, 0x89, 0x12,
+	0xdd, 0x7f, 0xfb, 0xd5, 0x0a, 0x4a, 0x44, 0x99, 0x0b, 0xd6, 0x54, 0xe9, 0x7c, 0xca, 0x26, 0xf3,
+	0x12, 0x2a, 0x85, 0x5e, 0xf0, 0x61, 0xbd, 0x11, 0x6c, 0x9d, 0x34, 0xa0, 0xe1, 0x69, 0xb0, 0xad,
+	0x55, 0x91, 0x48, 0xb0, 0xf2, 0x8a, 0x8b, 0x1e, 0x19, 0x90, 0xe1, 0xdd, 0xd1, 0xb3, 0x8b, 0xab,
+	0xb8, 0xf3, 0xf3, 0x2a, 0xde, 0x73, 0x6e, 0x66, 0x92, 0x51, 0x85, 0x4c, 0x43, 0x75, 0x46, 0x5f,
+	0x0b, 0x09, 0x7c, 0x39, 0x16, 0xfc, 0xfb, 0xb7, 0x83, 0xc0, 0xc3, 0x8c, 0x05, 0xff, 0x72, 0x73,
+	0xbe, 0x4f, 0xde, 0x76, 0xb5, 0x2a, 0x8e, 0xc1, 0x9c, 0x58, 0xa9, 0xf0, 0x38, 0xd8, 0xd6, 0xb0,
+	0x70, 0xba, 0x09, 0x48, 0xd1, 0xdb, 0x18, 0x90, 0x61, 0xf7, 0x68, 0x97, 0x3a, 0x3c, 0xda, 0xe2,
+	0xd1, 0xb1, 0xc7, 0x1b, 0xdd, 0xb1, 0xb6, 0x9f, 0xae, 0x63, 0x2b, 0x04, 0x8b, 0x46, 0xe5, 0xa5,
+	0x14, 0xe1, 0x07, 0x12, 0xdc, 0x9f, 0x42, 0x9e, 0xa7, 0xc0, 0xb3, 0xe4, 0x3f, 0x5c, 0xd3, 0xdb,
+	0x1c, 0x6c, 0x0e, 0xbb, 0x47, 0x0f, 0xa8, 0x27, 0xb1, 0x19, 0x52, 0x9f, 0xa1, 0xc5, 0x7a, 0x85,
+	0xaa, 0x18, 0x3d, 0xb7, 0xb2, 0x5f, 0xaf, 0xe3, 0xc7, 0x52, 0x55, 0x67, 0xf3, 0x94, 0x72, 0xd4,
+	0x3e, 0x46, 0xff, 0x3a, 0x30, 0x93, 0x8c, 0x55, 0xcb, 0x99, 0x30, 0xed, 0x19, 0xe3, 0xee, 0xb3,
+	0xd3, 0xda, 0xbe, 0xf9, 0x7b, 0x2f, 0xf3, 0xe2, 0xd1, 0xef, 0xcf, 0x31, 0x79, 0x7f, 0x73, 0xbe,
+	0xbf, 0xb7, 0xde, 0x80, 0xc5, 0x3f, 0x3b, 0xe0, 0xa2, 0x1d, 0x3d, 0xbd, 0x58, 0x45, 0xe4, 0x72,
+	0x15, 0x91, 0x5f, 0xab, 0x88, 0x7c, 0xac, 0xa3, 0xce, 0x65, 0x1d, 0x75, 0x7e, 0xd4, 0x51, 0xe7,
+	0xb4, 0x7f, 0xeb, 0xb1, 0xc6, 0x3f, 0xdd, 0x6a, 0x52, 0x79, 0xf2, 0x67, 0x00, 0xa0, 0x7f, 0x20,
+	0x7e, 0x5d, 0x02, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MinGasPrice.Equal(that1.MinGasPrice) {
+		return false
+	}
+	if this.MaxPriceAge != that1.MaxPriceAge {
+		return false
+	}
+	if len(this.FallbackMinGasPrices) != len(that1.FallbackMinGasPrices) {
+		return false
+	}
+	for i := range this.FallbackMinGasPrices {
+		if !this.FallbackMinGasPrices[i].Equal(&that1.FallbackMinGasPrices[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FallbackMinGasPrices) > 0 {
+		for iNdEx := len(m.FallbackMinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FallbackMinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxPriceAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.FallbackMinGasPrices) > 0 {
+		for _, e := range m.FallbackMinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackMinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackMinGasPrices = append(m.FallbackMinGasPrices, types.DecCoin{})
+			if err := m.FallbackMinGasPrices[len(m.FallbackMinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
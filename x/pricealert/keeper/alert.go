@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"rollinky/x/pricealert/types"
+)
+
+// GetAlertCount get the total number of alert
+func (k Keeper) GetAlertCount(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.AlertCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetAlertCount set the total number of alert
+func (k Keeper) SetAlertCount(ctx context.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.AlertCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendAlert appends a alert in the store with a new id and update the count
+func (k Keeper) AppendAlert(
+	ctx context.Context,
+	alert types.Alert,
+) uint64 {
+	// Create the alert
+	count := k.GetAlertCount(ctx)
+
+	// Set the ID of the appended value
+	alert.Id = count
+
+	k.SetAlert(ctx, alert)
+
+	// Update alert count
+	k.SetAlertCount(ctx, count+1)
+
+	return count
+}
+
+// SetAlert set a specific alert in the store, along with its trigger, expiry
+// and owner indexes
+func (k Keeper) SetAlert(ctx context.Context, alert types.Alert) {
+	if old, found := k.GetAlert(ctx, alert.Id); found {
+		k.removeAlertIndexes(ctx, old)
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AlertKey))
+	b := k.cdc.MustMarshal(&alert)
+	store.Set(types.GetAlertIDBytes(alert.Id), b)
+
+	id := types.GetAlertIDBytes(alert.Id)
+	k.triggerStore(ctx, alert.CurrencyPair, alert.Comparator).Set(types.AlertTriggerKey(alert.Threshold, alert.Id), id)
+	k.indexStore(ctx, types.AlertExpiryKeyPrefix).Set(types.AlertExpiryKey(alert.Expiry, alert.Id), id)
+	k.indexStore(ctx, types.AlertOwnerKeyPrefix).Set(types.AlertOwnerKey(alert.Owner, alert.Id), id)
+}
+
+// GetAlert returns a alert from its id
+func (k Keeper) GetAlert(ctx context.Context, id uint64) (val types.Alert, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AlertKey))
+	b := store.Get(types.GetAlertIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAlert removes a alert from the store, along with its indexes
+func (k Keeper) RemoveAlert(ctx context.Context, id uint64) {
+	alert, found := k.GetAlert(ctx, id)
+	if !found {
+		return
+	}
+	k.removeAlertIndexes(ctx, alert)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AlertKey))
+	store.Delete(types.GetAlertIDBytes(id))
+}
+
+// GetAllAlert returns all alert
+func (k Keeper) GetAllAlert(ctx context.Context) (list []types.Alert) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AlertKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Alert
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) removeAlertIndexes(ctx context.Context, alert types.Alert) {
+	k.triggerStore(ctx, alert.CurrencyPair, alert.Comparator).Delete(types.AlertTriggerKey(alert.Threshold, alert.Id))
+	k.indexStore(ctx, types.AlertExpiryKeyPrefix).Delete(types.AlertExpiryKey(alert.Expiry, alert.Id))
+	k.indexStore(ctx, types.AlertOwnerKeyPrefix).Delete(types.AlertOwnerKey(alert.Owner, alert.Id))
+}
+
+func (k Keeper) indexStore(ctx context.Context, keyPrefix string) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.KeyPrefix(keyPrefix))
+}
+
+func (k Keeper) triggerStore(ctx context.Context, currencyPair string, comparator types.Comparator) prefix.Store {
+	return prefix.NewStore(
+		k.indexStore(ctx, types.AlertTriggerKeyPrefix),
+		types.AlertTriggerPrefix(currencyPair, comparator),
+	)
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+
+	attestationtypes "rollinky/x/attestation/types"
+)
+
+var _ attestationtypes.OraclePriceHooks = Hooks{}
+
+// Hooks triggers the alerts crossed by the prices written.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the oracle price hooks of the keeper.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterPricesUpdated triggers the alerts crossed by the written prices.
+func (h Hooks) AfterPricesUpdated(ctx context.Context, prices map[connecttypes.CurrencyPair]oracletypes.QuotePrice) error {
+	h.k.TriggerAlerts(ctx, prices)
+	return nil
+}
+
+// AfterPriceMissed does nothing, alerts only trigger on written prices.
+func (h Hooks) AfterPriceMissed(context.Context, connecttypes.CurrencyPair) error {
+	return nil
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"rollinky/x/pricealert/types"
+)
+
+type (
+	Keeper struct {
+		// cdc is a full codec, as it gets the signers of the messages of
+		// alerts.
+		cdc          codec.Codec
+		storeService store.KVStoreService
+		logger       log.Logger
+
+		// the address capable of executing a MsgUpdateParams message. Typically, this
+		// should be the x/gov module account.
+		authority string
+
+		bankKeeper  types.BankKeeper
+		authzKeeper types.AuthzKeeper
+	}
+)
+
+func NewKeeper(
+	cdc codec.Codec,
+	storeService store.KVStoreService,
+	logger log.Logger,
+	authority string,
+	bankKeeper types.BankKeeper,
+	authzKeeper types.AuthzKeeper,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", authority))
+	}
+
+	return Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		authority:    authority,
+		logger:       logger,
+
+		bankKeeper:  bankKeeper,
+		authzKeeper: authzKeeper,
+	}
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetModuleAddress returns the address of the module account, which holds the
+// deposits and is the grantee of the messages of alerts.
+func (k Keeper) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.ModuleName)
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger() log.Logger {
+	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
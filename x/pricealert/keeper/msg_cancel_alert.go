@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/pricealert/types"
+)
+
+func (k msgServer) CancelAlert(goCtx context.Context, msg *types.MsgCancelAlert) (*types.MsgCancelAlertResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	alert, found := k.GetAlert(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrAlertNotFound, "%d", msg.Id)
+	}
+	if alert.Owner != msg.Owner {
+		return nil, errorsmod.Wrapf(types.ErrNotAlertOwner, "alert %d is owned by %s", msg.Id, alert.Owner)
+	}
+
+	k.RemoveAlert(ctx, alert.Id)
+	if !alert.Deposit.IsZero() {
+		owner, err := sdk.AccAddressFromBech32(alert.Owner)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, alert.Deposit); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAlertCanceled{Id: alert.Id, Owner: alert.Owner}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelAlertResponse{}, nil
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/pricealert/types"
+)
+
+func (k msgServer) CreateAlert(goCtx context.Context, msg *types.MsgCreateAlert) (*types.MsgCreateAlertResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid owner address")
+	}
+
+	if !msg.Expiry.After(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAlert, "expiry %s must be after the block time", msg.Expiry)
+	}
+	if msg.Expiry.Sub(ctx.BlockTime()) > params.MaxDuration {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAlert, "expiry must be at most %s after the block time", params.MaxDuration)
+	}
+
+	if !msg.Deposit.IsAllGTE(params.MinDeposit) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientDeposit, "deposit %s must be at least %s", msg.Deposit, params.MinDeposit)
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAlert, "invalid msgs: %s", err)
+	}
+	if uint64(len(msgs)) > params.MaxMsgs {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAlert, "at most %d msgs, got %d", params.MaxMsgs, len(msgs))
+	}
+	if len(msgs) > 0 && !msg.Deposit.IsAllGTE(params.ExecutionFee) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientDeposit, "deposit %s of an alert with msgs must be at least the execution fee %s", msg.Deposit, params.ExecutionFee)
+	}
+	for i, m := range msgs {
+		// the messages run through authz on behalf of the owner only.
+		signers, _, err := k.cdc.GetMsgV1Signers(m)
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidAlert, "msg %d: %s", i, err)
+		}
+		if len(signers) != 1 || !bytes.Equal(signers[0], owner) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidAlert, "msg %d must be signed by the owner only", i)
+		}
+
+		if m, ok := m.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return nil, errorsmod.Wrapf(types.ErrInvalidAlert, "msg %d: %s", i, err)
+			}
+		}
+	}
+
+	if !msg.Deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, msg.Deposit); err != nil {
+			return nil, err
+		}
+	}
+
+	id := k.AppendAlert(ctx, types.Alert{
+		Owner:        msg.Owner,
+		CurrencyPair: msg.CurrencyPair,
+		Comparator:   msg.Comparator,
+		Threshold:    msg.Threshold,
+		Expiry:       msg.Expiry,
+		Deposit:      msg.Deposit,
+		Msgs:         msg.Msgs,
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAlertCreated{
+		Id:           id,
+		Owner:        msg.Owner,
+		CurrencyPair: msg.CurrencyPair,
+		Comparator:   msg.Comparator,
+		Threshold:    msg.Threshold,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateAlertResponse{Id: id}, nil
+}
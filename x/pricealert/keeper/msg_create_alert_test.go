@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/x/pricealert/keeper"
+	"rollinky/x/pricealert/types"
+)
+
+var (
+	genesisTime = time.Unix(1700000000, 0).UTC()
+	owner       = sdk.AccAddress("owner")
+	other       = sdk.AccAddress("other")
+)
+
+func setupAlerts(t *testing.T) (keeper.Keeper, sdk.Context, *keepertest.MockBankKeeper, *keepertest.MockAuthzKeeper) {
+	t.Helper()
+
+	bank := keepertest.NewMockBankKeeper()
+	bank.Balances[owner.String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	authz := &keepertest.MockAuthzKeeper{}
+	k, ctx := keepertest.PricealertKeeperWithMocks(t, bank, authz)
+
+	params := types.DefaultParams()
+	params.MinDeposit = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	require.NoError(t, k.SetParams(ctx, params))
+
+	return k, ctx.WithBlockHeight(1).WithBlockTime(genesisTime), bank, authz
+}
+
+func newMsgCreateAlert(
+	t *testing.T,
+	currencyPair string,
+	comparator types.Comparator,
+	threshold int64,
+	msgs ...sdk.Msg,
+) *types.MsgCreateAlert {
+	t.Helper()
+
+	msg, err := types.NewMsgCreateAlert(types.Alert{
+		Owner:        owner.String(),
+		CurrencyPair: currencyPair,
+		Comparator:   comparator,
+		Threshold:    math.NewInt(threshold),
+		Expiry:       genesisTime.Add(time.Hour),
+		Deposit:      sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	}, msgs...)
+	require.NoError(t, err)
+	return msg
+}
+
+func TestMsgCreateAlert(t *testing.T) {
+	k, ctx, bank, _ := setupAlerts(t)
+	ms := keeper.NewMsgServerImpl(k)
+	send := banktypes.NewMsgSend(owner, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	msg := newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, 100)
+	msg.Expiry = genesisTime
+	_, err := ms.CreateAlert(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidAlert)
+
+	msg = newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, 100)
+	msg.Expiry = genesisTime.Add(types.DefaultMaxDuration + time.Second)
+	_, err = ms.CreateAlert(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidAlert)
+
+	msg = newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, 100)
+	msg.Deposit = sdk.NewCoins(sdk.NewInt64Coin("stake", 5))
+	_, err = ms.CreateAlert(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInsufficientDeposit)
+
+	msg = newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, 100,
+		banktypes.NewMsgSend(other, owner, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+	_, err = ms.CreateAlert(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidAlert)
+
+	msg = newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, 100, send, send, send, send, send, send)
+	_, err = ms.CreateAlert(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidAlert)
+
+	res, err := ms.CreateAlert(ctx, newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, 100, send))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Id)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), bank.Balances[owner.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), bank.Balances[types.ModuleName])
+
+	alert, found := k.GetAlert(ctx, res.Id)
+	require.True(t, found)
+	msgs, err := alert.GetMessages()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{send}, msgs)
+}
+
+func TestMsgCreateAlertExecutionFee(t *testing.T) {
+	k, ctx, _, _ := setupAlerts(t)
+	ms := keeper.NewMsgServerImpl(k)
+	send := banktypes.NewMsgSend(owner, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	params := k.GetParams(ctx)
+	params.ExecutionFee = sdk.NewCoins(sdk.NewInt64Coin("stake", 20))
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// only an alert with messages must cover the execution fee.
+	_, err := ms.CreateAlert(ctx, newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, 100, send))
+	require.ErrorIs(t, err, types.ErrInsufficientDeposit)
+	_, err = ms.CreateAlert(ctx, newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, 100))
+	require.NoError(t, err)
+
+	msg := newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, 100, send)
+	msg.Deposit = sdk.NewCoins(sdk.NewInt64Coin("stake", 20))
+	_, err = ms.CreateAlert(ctx, msg)
+	require.NoError(t, err)
+}
+
+func TestMsgCancelAlert(t *testing.T) {
+	k, ctx, bank, _ := setupAlerts(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	res, err := ms.CreateAlert(ctx, newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, 100))
+	require.NoError(t, err)
+
+	_, err = ms.CancelAlert(ctx, &types.MsgCancelAlert{Owner: other.String(), Id: res.Id})
+	require.ErrorIs(t, err, types.ErrNotAlertOwner)
+
+	_, err = ms.CancelAlert(ctx, &types.MsgCancelAlert{Owner: owner.String(), Id: res.Id + 1})
+	require.ErrorIs(t, err, types.ErrAlertNotFound)
+
+	_, err = ms.CancelAlert(ctx, &types.MsgCancelAlert{Owner: owner.String(), Id: res.Id})
+	require.NoError(t, err)
+	_, found := k.GetAlert(ctx, res.Id)
+	require.False(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), bank.Balances[owner.String()])
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/x/pricealert/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	params := types.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, params))
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	update := func(authority string, params types.Params) error {
+		_, err := ms.UpdateParams(wctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+		return err
+	}
+	get := func() types.Params { return k.GetParams(wctx) }
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	negative := sdk.Coins{{Denom: "stake", Amount: math.NewInt(-1)}}
+
+	keepertest.CheckMsgUpdateParams(t, k.GetAuthority(), update, get, []keepertest.UpdateParamsCase[types.Params]{
+		{
+			Name:      "invalid min deposit",
+			Params:    types.NewParams(negative, time.Hour, 5, 100, 1_000_000, nil),
+			ExpErrMsg: "invalid min deposit",
+		},
+		{
+			Name:      "zero max duration",
+			Params:    types.NewParams(deposit, 0, 5, 100, 1_000_000, nil),
+			ExpErrMsg: "max duration must be positive",
+		},
+		{
+			Name:      "zero max triggers per block",
+			Params:    types.NewParams(deposit, time.Hour, 5, 0, 1_000_000, nil),
+			ExpErrMsg: "max triggers per block must be positive",
+		},
+		{
+			Name:      "zero max execution gas",
+			Params:    types.NewParams(deposit, time.Hour, 5, 100, 0, nil),
+			ExpErrMsg: "max execution gas must be positive",
+		},
+		{
+			Name:      "invalid execution fee",
+			Params:    types.NewParams(deposit, time.Hour, 5, 100, 1_000_000, negative),
+			ExpErrMsg: "invalid execution fee",
+		},
+		{
+			Name:   "deposit and execution fee",
+			Params: types.NewParams(deposit, time.Hour, 5, 100, 1_000_000, deposit),
+		},
+		{
+			Name:   "all good",
+			Params: params,
+		},
+	})
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rollinky/x/pricealert/types"
+)
+
+func (k Keeper) AlertAll(ctx context.Context, req *types.QueryAllAlertRequest) (*types.QueryAllAlertResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var alerts []types.Alert
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	alertStore := prefix.NewStore(store, types.KeyPrefix(types.AlertKey))
+
+	pageRes, err := query.Paginate(alertStore, req.Pagination, func(key []byte, value []byte) error {
+		var alert types.Alert
+		if err := k.cdc.Unmarshal(value, &alert); err != nil {
+			return err
+		}
+
+		alerts = append(alerts, alert)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAlertResponse{Alert: alerts, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Alert(ctx context.Context, req *types.QueryGetAlertRequest) (*types.QueryGetAlertResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	alert, found := k.GetAlert(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetAlertResponse{Alert: alert}, nil
+}
+
+func (k Keeper) AlertsByOwner(ctx context.Context, req *types.QueryAlertsByOwnerRequest) (*types.QueryAlertsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner address")
+	}
+
+	var alerts []types.Alert
+
+	ownerStore := prefix.NewStore(k.indexStore(ctx, types.AlertOwnerKeyPrefix), types.AlertOwnerPrefix(req.Owner))
+
+	pageRes, err := query.Paginate(ownerStore, req.Pagination, func(key []byte, value []byte) error {
+		alert, found := k.GetAlert(ctx, types.GetAlertIDFromBytes(value))
+		if !found {
+			return status.Errorf(codes.Internal, "alert %d of the owner index not found", types.GetAlertIDFromBytes(value))
+		}
+
+		alerts = append(alerts, alert)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAlertsByOwnerResponse{Alert: alerts, Pagination: pageRes}, nil
+}
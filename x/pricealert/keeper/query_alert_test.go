@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rollinky/x/pricealert/keeper"
+	"rollinky/x/pricealert/types"
+)
+
+func TestAlertQueries(t *testing.T) {
+	k, ctx, _, _ := setupAlerts(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	for _, threshold := range []int64{100, 200} {
+		_, err := ms.CreateAlert(ctx, newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, threshold))
+		require.NoError(t, err)
+	}
+
+	_, err := k.Alert(ctx, nil)
+	require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+	_, err = k.AlertAll(ctx, nil)
+	require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+	_, err = k.AlertsByOwner(ctx, nil)
+	require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+
+	res, err := k.Alert(ctx, &types.QueryGetAlertRequest{Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Alert.Id)
+
+	_, err = k.Alert(ctx, &types.QueryGetAlertRequest{Id: 2})
+	require.Equal(t, status.Error(codes.NotFound, "not found"), err)
+
+	all, err := k.AlertAll(ctx, &types.QueryAllAlertRequest{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, k.GetAllAlert(ctx), all.Alert)
+	require.Len(t, all.Alert, 2)
+
+	byOwner, err := k.AlertsByOwner(ctx, &types.QueryAlertsByOwnerRequest{Owner: owner.String()})
+	require.NoError(t, err)
+	require.ElementsMatch(t, all.Alert, byOwner.Alert)
+
+	byOwner, err = k.AlertsByOwner(ctx, &types.QueryAlertsByOwnerRequest{Owner: other.String()})
+	require.NoError(t, err)
+	require.Empty(t, byOwner.Alert)
+
+	_, err = ms.CancelAlert(ctx, &types.MsgCancelAlert{Owner: owner.String(), Id: 0})
+	require.NoError(t, err)
+	byOwner, err = k.AlertsByOwner(ctx, &types.QueryAlertsByOwnerRequest{Owner: owner.String()})
+	require.NoError(t, err)
+	require.Len(t, byOwner.Alert, 1)
+}
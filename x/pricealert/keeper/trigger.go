@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+
+	attestationtypes "rollinky/x/attestation/types"
+	"rollinky/x/pricealert/types"
+)
+
+// TriggerAlerts triggers the alerts crossed by the written prices, at most
+// max_triggers_per_block of them. The trigger index only holds alerts that
+// haven't triggered, so the rest trigger with the next prices written.
+func (k Keeper) TriggerAlerts(ctx context.Context, prices map[connecttypes.CurrencyPair]oracletypes.QuotePrice) {
+	params := k.GetParams(ctx)
+	remaining := params.MaxTriggersPerBlock
+
+	for _, cp := range attestationtypes.SortedCurrencyPairs(prices) {
+		price := prices[cp].Price
+		if price.IsNil() || price.IsNegative() {
+			continue
+		}
+
+		for _, comparator := range []types.Comparator{types.COMPARATOR_ABOVE, types.COMPARATOR_BELOW} {
+			for _, id := range k.triggeredAlerts(ctx, cp.String(), comparator, price, remaining) {
+				alert, found := k.GetAlert(ctx, id)
+				if !found {
+					continue
+				}
+				k.triggerAlert(ctx, params, alert, price)
+				remaining--
+			}
+
+			if remaining == 0 {
+				return
+			}
+		}
+	}
+}
+
+// triggeredAlerts returns the ids of up to limit alerts of the currency pair
+// and comparator that price triggers, the furthest crossed first.
+func (k Keeper) triggeredAlerts(
+	ctx context.Context,
+	currencyPair string,
+	comparator types.Comparator,
+	price math.Int,
+	limit uint64,
+) (ids []uint64) {
+	store := k.triggerStore(ctx, currencyPair, comparator)
+
+	var iterator storetypes.Iterator
+	switch comparator {
+	case types.COMPARATOR_ABOVE:
+		// thresholds at or below the price
+		iterator = store.Iterator(nil, storetypes.PrefixEndBytes(types.ThresholdBytes(price)))
+	case types.COMPARATOR_BELOW:
+		// thresholds at or above the price
+		iterator = store.ReverseIterator(types.ThresholdBytes(price), nil)
+	default:
+		return nil
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid() && uint64(len(ids)) < limit; iterator.Next() {
+		ids = append(ids, types.GetAlertIDFromBytes(iterator.Value()))
+	}
+
+	return ids
+}
+
+// triggerAlert removes the alert, executes its messages and refunds its
+// deposit, less the execution fee if it has messages. An alert that expired
+// in this block expires instead.
+func (k Keeper) triggerAlert(ctx context.Context, params types.Params, alert types.Alert, price math.Int) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !alert.Expiry.After(sdkCtx.BlockTime()) {
+		k.expireAlert(ctx, alert)
+		return
+	}
+
+	k.RemoveAlert(ctx, alert.Id)
+
+	event := &types.EventAlertTriggered{
+		Id:           alert.Id,
+		Owner:        alert.Owner,
+		CurrencyPair: alert.CurrencyPair,
+		Comparator:   alert.Comparator,
+		Threshold:    alert.Threshold,
+		Price:        price,
+	}
+	if len(alert.Msgs) > 0 {
+		gasUsed, err := k.executeMsgs(sdkCtx, alert, params.MaxExecutionGas)
+		if err != nil {
+			k.Logger().Info("failed to execute alert messages", "id", alert.Id, "err", err)
+			event.Error = err.Error()
+		} else {
+			event.Executed = true
+		}
+		event.GasUsed = gasUsed
+
+		// the fee is taken whether the messages succeed or not, so that
+		// alerts can't execute for free. Params may have raised the fee since
+		// the alert was created, it is capped by the deposit.
+		event.ExecutionFee = k.chargeExecutionFee(ctx, alert, params.ExecutionFee.Min(alert.Deposit))
+		alert.Deposit = alert.Deposit.Sub(event.ExecutionFee...)
+	}
+	k.refundDeposit(ctx, alert)
+
+	attestationtypes.EmitEvent(ctx, k.Logger(), event)
+}
+
+// executeMsgs executes the messages of the alert through authz, with the
+// module account as grantee, and returns the gas they consumed. They run on a
+// cache so that they are all discarded if one fails, and fail once they
+// consume more than maxGas.
+//
+// The messages don't go through the ante handler: they pay no tx fee, other
+// than the alert's execution fee, and aren't checked by the ante decorators,
+// including the freshness of the oracle prices they read. Authz only checks
+// the owner's grants.
+func (k Keeper) executeMsgs(ctx sdk.Context, alert types.Alert, maxGas uint64) (gasUsed uint64, err error) {
+	msgs, err := alert.GetMessages()
+	if err != nil {
+		return 0, err
+	}
+
+	gasMeter := storetypes.NewGasMeter(maxGas)
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+	defer func() {
+		if r := recover(); r != nil {
+			gasUsed = gasMeter.GasConsumedToLimit()
+			if _, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "alert messages exceed the max execution gas of %d", maxGas)
+				return
+			}
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	_, err = k.authzKeeper.DispatchActions(cacheCtx, k.GetModuleAddress(), msgs)
+	if err != nil {
+		return gasMeter.GasConsumed(), err
+	}
+
+	write()
+	return gasMeter.GasConsumed(), nil
+}
+
+// chargeExecutionFee pays fee out of the alert's deposit to the fee collector
+// and returns the fee paid. A failed payment is only logged, and nothing is
+// paid.
+func (k Keeper) chargeExecutionFee(ctx context.Context, alert types.Alert, fee sdk.Coins) sdk.Coins {
+	if fee.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, fee); err != nil {
+		k.Logger().Error("failed to charge alert execution fee", "id", alert.Id, "fee", fee, "err", err)
+		return nil
+	}
+
+	return fee
+}
+
+// ExpireAlerts removes the alerts that expired by the block time and refunds
+// their deposits.
+func (k Keeper) ExpireAlerts(ctx context.Context) {
+	store := k.indexStore(ctx, types.AlertExpiryKeyPrefix)
+	end := storetypes.PrefixEndBytes(sdk.FormatTimeBytes(sdk.UnwrapSDKContext(ctx).BlockTime()))
+
+	var ids []uint64
+	iterator := store.Iterator(nil, end)
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, types.GetAlertIDFromBytes(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		if alert, found := k.GetAlert(ctx, id); found {
+			k.expireAlert(ctx, alert)
+		}
+	}
+}
+
+func (k Keeper) expireAlert(ctx context.Context, alert types.Alert) {
+	k.RemoveAlert(ctx, alert.Id)
+	k.refundDeposit(ctx, alert)
+	attestationtypes.EmitEvent(ctx, k.Logger(), &types.EventAlertExpired{Id: alert.Id, Owner: alert.Owner})
+}
+
+// refundDeposit returns the deposit of the alert to its owner. A failed refund
+// is only logged, so that a bad alert can't fail the block.
+func (k Keeper) refundDeposit(ctx context.Context, alert types.Alert) {
+	if alert.Deposit.IsZero() {
+		return
+	}
+
+	owner, err := sdk.AccAddressFromBech32(alert.Owner)
+	if err == nil {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, alert.Deposit)
+	}
+	if err != nil {
+		k.Logger().Error("failed to refund alert deposit", "id", alert.Id, "deposit", alert.Deposit, "err", err)
+	}
+}
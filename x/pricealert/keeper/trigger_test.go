@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	"github.com/stretchr/testify/require"
+
+	"rollinky/x/pricealert/keeper"
+	"rollinky/x/pricealert/types"
+)
+
+var btcUSD = connecttypes.NewCurrencyPair("BTC", "USD")
+
+func btcPrice(price int64) map[connecttypes.CurrencyPair]oracletypes.QuotePrice {
+	return map[connecttypes.CurrencyPair]oracletypes.QuotePrice{btcUSD: {Price: math.NewInt(price)}}
+}
+
+// triggeredEvents returns the EventAlertTriggered emitted on ctx.
+func triggeredEvents(t *testing.T, ctx sdk.Context) (events []*types.EventAlertTriggered) {
+	t.Helper()
+
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "rollinky.pricealert.EventAlertTriggered" {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		events = append(events, msg.(*types.EventAlertTriggered))
+	}
+	return events
+}
+
+func TestTriggerAlerts(t *testing.T) {
+	k, ctx, bank, authz := setupAlerts(t)
+	ms := keeper.NewMsgServerImpl(k)
+	send := banktypes.NewMsgSend(owner, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	for _, msg := range []*types.MsgCreateAlert{
+		newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, 100, send),
+		newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, 200),
+		newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_BELOW, 50),
+		newMsgCreateAlert(t, "ETH/USD", types.COMPARATOR_ABOVE, 10),
+	} {
+		_, err := ms.CreateAlert(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	// 150 crosses the first alert only.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.Hooks().AfterPricesUpdated(ctx, btcPrice(150)))
+	events := triggeredEvents(t, ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, uint64(0), events[0].Id)
+	require.Equal(t, math.NewInt(150), events[0].Price)
+	require.True(t, events[0].Executed)
+	require.Equal(t, [][]sdk.Msg{{send}}, authz.Dispatched)
+	_, found := k.GetAlert(ctx, 0)
+	require.False(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 70)), bank.Balances[owner.String()])
+
+	// a triggered alert doesn't trigger again.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.Hooks().AfterPricesUpdated(ctx, btcPrice(150)))
+	require.Empty(t, triggeredEvents(t, ctx))
+
+	// 40 crosses the below alert only.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.Hooks().AfterPricesUpdated(ctx, btcPrice(40)))
+	events = triggeredEvents(t, ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, uint64(2), events[0].Id)
+	require.False(t, events[0].Executed)
+
+	// the thresholds are inclusive.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.Hooks().AfterPricesUpdated(ctx, btcPrice(200)))
+	events = triggeredEvents(t, ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, uint64(1), events[0].Id)
+
+	require.Len(t, k.GetAllAlert(ctx), 1)
+}
+
+func TestTriggerAlertsLimit(t *testing.T) {
+	k, ctx, _, _ := setupAlerts(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	params := k.GetParams(ctx)
+	params.MaxTriggersPerBlock = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	for _, threshold := range []int64{100, 110, 120} {
+		_, err := ms.CreateAlert(ctx, newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, threshold))
+		require.NoError(t, err)
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.Hooks().AfterPricesUpdated(ctx, btcPrice(150)))
+	require.Len(t, triggeredEvents(t, ctx), 2)
+	require.Len(t, k.GetAllAlert(ctx), 1)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.Hooks().AfterPricesUpdated(ctx, btcPrice(150)))
+	require.Len(t, triggeredEvents(t, ctx), 1)
+	require.Empty(t, k.GetAllAlert(ctx))
+}
+
+func TestTriggerAlertFailedMsgs(t *testing.T) {
+	k, ctx, bank, authz := setupAlerts(t)
+	ms := keeper.NewMsgServerImpl(k)
+	authz.Err = errors.New("authorization not found")
+
+	send := banktypes.NewMsgSend(owner, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	_, err := ms.CreateAlert(ctx, newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, 100, send))
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.Hooks().AfterPricesUpdated(ctx, btcPrice(100)))
+	events := triggeredEvents(t, ctx)
+	require.Len(t, events, 1)
+	require.False(t, events[0].Executed)
+	require.Equal(t, "authorization not found", events[0].Error)
+
+	// the alert is removed and refunded all the same.
+	require.Empty(t, k.GetAllAlert(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), bank.Balances[owner.String()])
+}
+
+func TestTriggerAlertExecutionLimits(t *testing.T) {
+	k, ctx, bank, authz := setupAlerts(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	params := k.GetParams(ctx)
+	params.MaxExecutionGas = 1000
+	params.ExecutionFee = sdk.NewCoins(sdk.NewInt64Coin("stake", 4))
+	require.NoError(t, k.SetParams(ctx, params))
+
+	send := banktypes.NewMsgSend(owner, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	for range 2 {
+		_, err := ms.CreateAlert(ctx, newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, 100, send))
+		require.NoError(t, err)
+	}
+
+	// messages that run out of gas fail, and are charged the fee all the
+	// same.
+	params.MaxTriggersPerBlock = 1
+	require.NoError(t, k.SetParams(ctx, params))
+	authz.Gas = 1001
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.Hooks().AfterPricesUpdated(ctx, btcPrice(100)))
+	events := triggeredEvents(t, ctx)
+	require.Len(t, events, 1)
+	require.False(t, events[0].Executed)
+	require.Contains(t, events[0].Error, "out of gas")
+	require.Equal(t, uint64(1000), events[0].GasUsed)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 4)), events[0].ExecutionFee)
+	require.Empty(t, authz.Dispatched)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 86)), bank.Balances[owner.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 4)), bank.Balances[authtypes.FeeCollectorName])
+
+	authz.Gas = 1000
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.Hooks().AfterPricesUpdated(ctx, btcPrice(100)))
+	events = triggeredEvents(t, ctx)
+	require.Len(t, events, 1)
+	require.True(t, events[0].Executed)
+	require.Equal(t, uint64(1000), events[0].GasUsed)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 92)), bank.Balances[owner.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 8)), bank.Balances[authtypes.FeeCollectorName])
+}
+
+func TestExpireAlerts(t *testing.T) {
+	k, ctx, bank, _ := setupAlerts(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	_, err := ms.CreateAlert(ctx, newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, 100))
+	require.NoError(t, err)
+	msg := newMsgCreateAlert(t, "BTC/USD", types.COMPARATOR_ABOVE, 100)
+	msg.Expiry = genesisTime.Add(2 * time.Hour)
+	_, err = ms.CreateAlert(ctx, msg)
+	require.NoError(t, err)
+
+	// an alert triggered at its expiry expires instead.
+	ctx = ctx.WithBlockTime(genesisTime.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.Hooks().AfterPricesUpdated(ctx, btcPrice(50)))
+	k.ExpireAlerts(ctx)
+	_, found := k.GetAlert(ctx, 0)
+	require.False(t, found)
+	_, found = k.GetAlert(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), bank.Balances[owner.String()])
+
+	require.NoError(t, k.Hooks().AfterPricesUpdated(ctx, btcPrice(150)))
+	require.Len(t, triggeredEvents(t, ctx), 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), bank.Balances[owner.String()])
+}
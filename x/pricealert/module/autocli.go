@@ -0,0 +1,70 @@
+package pricealert
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "rollinky/api/rollinky/pricealert"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "AlertAll",
+					Use:       "list-alert",
+					Short:     "List all alert",
+				},
+				{
+					RpcMethod:      "Alert",
+					Use:            "show-alert [id]",
+					Short:          "Shows an alert by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "AlertsByOwner",
+					Use:            "alerts-by-owner [owner]",
+					Short:          "List the alerts of an owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "CreateAlert",
+					Use:       "create-alert [currency-pair] [comparator] [threshold] [expiry]",
+					Short:     "Create an alert on the price of a currency pair",
+					Long:      "Create an alert that triggers once the price of the currency pair is at or above (above) or at or below (below) the threshold, before the expiry. The messages of --msgs, as JSON, are executed through authz on behalf of the owner when it triggers.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "currency_pair"},
+						{ProtoField: "comparator"},
+						{ProtoField: "threshold"},
+						{ProtoField: "expiry"},
+					},
+				},
+				{
+					RpcMethod:      "CancelAlert",
+					Use:            "cancel-alert [id]",
+					Short:          "Cancel an alert and refund its deposit",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package pricealert
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/pricealert/keeper"
+	"rollinky/x/pricealert/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the alert
+	for _, elem := range genState.AlertList {
+		k.SetAlert(ctx, elem)
+	}
+
+	// Set alert count
+	k.SetAlertCount(ctx, genState.AlertCount)
+	// this line is used by starport scaffolding # genesis/module/init
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	genesis.AlertList = k.GetAllAlert(ctx)
+	genesis.AlertCount = k.GetAlertCount(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
@@ -0,0 +1,55 @@
+package pricealert_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stretchr/testify/require"
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/testutil/nullify"
+	pricealert "rollinky/x/pricealert/module"
+	"rollinky/x/pricealert/types"
+)
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+
+		AlertList: []types.Alert{
+			{
+				Id:           0,
+				Owner:        sdk.AccAddress("owner").String(),
+				CurrencyPair: "BTC/USD",
+				Comparator:   types.COMPARATOR_ABOVE,
+				Threshold:    math.NewInt(100),
+				Expiry:       time.Unix(1700000000, 0).UTC(),
+				Deposit:      sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+			{
+				Id:           1,
+				Owner:        sdk.AccAddress("owner").String(),
+				CurrencyPair: "ETH/USD",
+				Comparator:   types.COMPARATOR_BELOW,
+				Threshold:    math.NewInt(50),
+				Expiry:       time.Unix(1700000001, 0).UTC(),
+			},
+		},
+		AlertCount: 2,
+		// this line is used by starport scaffolding # genesis/test/state
+	}
+
+	k, ctx := keepertest.PricealertKeeper(t)
+	pricealert.InitGenesis(ctx, k, genesisState)
+	got := pricealert.ExportGenesis(ctx, k)
+	require.NotNil(t, got)
+
+	nullify.Fill(&genesisState)
+	nullify.Fill(got)
+
+	require.ElementsMatch(t, genesisState.AlertList, got.AlertList)
+	require.Equal(t, genesisState.AlertCount, got.AlertCount)
+	// this line is used by starport scaffolding # genesis/test/assert
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+var (
+	_ cdctypes.UnpackInterfacesMessage = Alert{}
+	_ cdctypes.UnpackInterfacesMessage = MsgCreateAlert{}
+	_ cdctypes.UnpackInterfacesMessage = GenesisState{}
+)
+
+// GetMessages returns the messages the alert executes when it triggers.
+func (a Alert) GetMessages() ([]sdk.Msg, error) {
+	return tx.GetMsgs(a.Msgs, "alert")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a Alert) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return tx.UnpackInterfaces(unpacker, a.Msgs)
+}
+
+// Validate checks the fields of the alert that don't depend on the state.
+func (a Alert) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+
+	cp, err := connecttypes.CurrencyPairFromString(a.CurrencyPair)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAlert, "invalid currency pair %q: %s", a.CurrencyPair, err)
+	}
+	if cp.String() != a.CurrencyPair {
+		return errorsmod.Wrapf(ErrInvalidAlert, "currency pair %q must be written as %q", a.CurrencyPair, cp.String())
+	}
+
+	if a.Comparator != COMPARATOR_ABOVE && a.Comparator != COMPARATOR_BELOW {
+		return errorsmod.Wrapf(ErrInvalidAlert, "invalid comparator %s", a.Comparator)
+	}
+
+	if a.Threshold.IsNil() || a.Threshold.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidAlert, "threshold must not be negative")
+	}
+
+	if err := a.Deposit.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAlert, "invalid deposit: %s", err)
+	}
+
+	if _, err := a.GetMessages(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAlert, "invalid msgs: %s", err)
+	}
+
+	return nil
+}
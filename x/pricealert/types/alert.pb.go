@@ -0,0 +1,736 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollinky/pricealert/alert.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Comparator is how an alert compares the price to its threshold.
+type Comparator int32
+
+const (
+	COMPARATOR_UNSPECIFIED Comparator = 0
+	// COMPARATOR_ABOVE triggers once the price is at or above the threshold.
+	COMPARATOR_ABOVE Comparator = 1
+	// COMPARATOR_BELOW triggers once the price is at or below the threshold.
+	COMPARATOR_BELOW Comparator = 2
+)
+
+var Comparator_name = map[int32]string{
+	0: "COMPARATOR_UNSPECIFIED",
+	1: "COMPARATOR_ABOVE",
+	2: "COMPARATOR_BELOW",
+}
+
+var Comparator_value = map[string]int32{
+	"COMPARATOR_UNSPECIFIED": 0,
+	"COMPARATOR_ABOVE":       1,
+	"COMPARATOR_BELOW":       2,
+}
+
+func (x Comparator) String() string {
+	return proto.EnumName(Comparator_name, int32(x))
+}
+
+func (Comparator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_92ff8f1d7603cdc8, []int{0}
+}
+
+// Alert fires once the price of a currency pair crosses a threshold.
+type Alert struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner created the alert, gets its deposit back and is the signer of its
+	// messages.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// currency_pair is the currency pair of the price, e.g. "BTC/USD".
+	CurrencyPair string     `protobuf:"bytes,3,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	Comparator   Comparator `protobuf:"varint,4,opt,name=comparator,proto3,enum=rollinky.pricealert.Comparator" json:"comparator,omitempty"`
+	// threshold is compared with the oracle price, so it is in the decimals of
+	// the pair's market.
+	Threshold cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=threshold,proto3,customtype=cosmossdk.io/math.Int" json:"threshold"`
+	// expiry is when the alert expires without triggering.
+	Expiry time.Time `protobuf:"bytes,6,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	// deposit is held until the alert triggers, expires or is canceled.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// msgs are executed through authz when the alert triggers, the owner must
+	// have granted them to the module account.
+	Msgs []*types1.Any `protobuf:"bytes,8,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *Alert) Reset()         { *m = Alert{} }
+func (m *Alert) String() string { return proto.CompactTextString(m) }
+func (*Alert) ProtoMessage()    {}
+func (*Alert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92ff8f1d7603cdc8, []int{0}
+}
+func (m *Alert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Alert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Alert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Alert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Alert.Merge(m, src)
+}
+func (m *Alert) XXX_Size() int {
+	return m.Size()
+}
+func (m *Alert) XXX_DiscardUnknown() {
+	xxx_messageInfo_Alert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Alert proto.InternalMessageInfo
+
+func (m *Alert) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Alert) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Alert) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *Alert) GetComparator() Comparator {
+	if m != nil {
+		return m.Comparator
+	}
+	return COMPARATOR_UNSPECIFIED
+}
+
+func (m *Alert) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+func (m *Alert) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *Alert) GetMsgs() []*types1.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("rollinky.pricealert.Comparator", Comparator_name, Comparator_value)
+	proto.RegisterType((*Alert)(nil), "rollinky.pricealert.Alert")
+}
+
+func init() { proto.RegisterFile("rollinky/pricealert/alert.proto", fileDescriptor_92ff8f1d7603cdc8) }
+
+var fileDescriptor_92ff8f1d7603cdc8 = []byte{
+	// 562 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xb5, 0xf3, 0xd7, 0x76, 0xfa, 0x7d, 0x55, 0x30, 0x01, 0xdc, 0x54, 0xb2, 0x23, 0xd8, 0x58,
+	0x45, 0x19, 0xab, 0x41, 0xdd, 0x21, 0x21, 0x3b, 0x04, 0x29, 0x12, 0x25, 0x91, 0x5b, 0x40, 0xea,
+	0x26, 0x72, 0xec, 0xc1, 0x19, 0x12, 0x7b, 0xac, 0x99, 0x09, 0xd4, 0x6f, 0xc0, 0xb2, 0xef, 0xc0,
+	0x06, 0xb1, 0x62, 0x91, 0x87, 0xa8, 0x58, 0x55, 0x5d, 0x21, 0x16, 0x2d, 0x4a, 0x16, 0x88, 0xb7,
+	0x40, 0xfe, 0x6b, 0xa2, 0xd2, 0xcd, 0xd8, 0xf7, 0x9e, 0x73, 0x74, 0xcf, 0x9c, 0xab, 0x01, 0x2a,
+	0x25, 0x93, 0x09, 0x0e, 0xc6, 0x91, 0x1e, 0x52, 0xec, 0x20, 0x7b, 0x82, 0x28, 0xd7, 0x93, 0x13,
+	0x86, 0x94, 0x70, 0x22, 0xdd, 0xcd, 0x09, 0x70, 0x49, 0xa8, 0xdf, 0xb1, 0x7d, 0x1c, 0x10, 0x3d,
+	0x39, 0x53, 0x5e, 0x5d, 0x71, 0x08, 0xf3, 0x09, 0xd3, 0x87, 0x36, 0x43, 0xfa, 0x87, 0xbd, 0x21,
+	0xe2, 0xf6, 0x9e, 0xee, 0x10, 0x1c, 0x64, 0xf8, 0x76, 0x8a, 0x0f, 0x92, 0x4a, 0x4f, 0x8b, 0x0c,
+	0xaa, 0x79, 0xc4, 0x23, 0x69, 0x3f, 0xfe, 0xcb, 0x05, 0x1e, 0x21, 0xde, 0x04, 0xe9, 0x49, 0x35,
+	0x9c, 0xbe, 0xd3, 0xed, 0x20, 0xca, 0x20, 0xf5, 0x26, 0xc4, 0xb1, 0x8f, 0x18, 0xb7, 0xfd, 0x30,
+	0x25, 0x3c, 0xfc, 0x53, 0x04, 0x65, 0x23, 0x76, 0x2a, 0x6d, 0x81, 0x02, 0x76, 0x65, 0xb1, 0x21,
+	0x6a, 0x25, 0xab, 0x80, 0x5d, 0x09, 0x82, 0x32, 0xf9, 0x18, 0x20, 0x2a, 0x17, 0x1a, 0xa2, 0xb6,
+	0x61, 0xca, 0x17, 0xb3, 0x66, 0x2d, 0x33, 0x63, 0xb8, 0x2e, 0x45, 0x8c, 0x1d, 0x72, 0x8a, 0x03,
+	0xcf, 0x4a, 0x69, 0xd2, 0x23, 0xf0, 0xbf, 0x33, 0xa5, 0x14, 0x05, 0x4e, 0x34, 0x08, 0x6d, 0x4c,
+	0xe5, 0x62, 0xac, 0xb3, 0xfe, 0xcb, 0x9b, 0x7d, 0x1b, 0x53, 0xe9, 0x19, 0x00, 0x0e, 0xf1, 0x43,
+	0x9b, 0xda, 0x9c, 0x50, 0xb9, 0xd4, 0x10, 0xb5, 0xad, 0x96, 0x0a, 0x6f, 0x09, 0x0e, 0xb6, 0xaf,
+	0x69, 0xd6, 0x8a, 0x44, 0xea, 0x82, 0x0d, 0x3e, 0xa2, 0x88, 0x8d, 0xc8, 0xc4, 0x95, 0xcb, 0x89,
+	0xb3, 0xc7, 0x67, 0x97, 0xaa, 0xf0, 0xf3, 0x52, 0xbd, 0x97, 0xba, 0x63, 0xee, 0x18, 0x62, 0xa2,
+	0xfb, 0x36, 0x1f, 0xc1, 0x6e, 0xc0, 0x2f, 0x66, 0x4d, 0x90, 0xd9, 0xee, 0x06, 0xdc, 0x5a, 0xaa,
+	0xa5, 0xa7, 0xa0, 0x82, 0x4e, 0x42, 0x4c, 0x23, 0xb9, 0xd2, 0x10, 0xb5, 0xcd, 0x56, 0x1d, 0xa6,
+	0x61, 0xc1, 0x3c, 0x2c, 0x78, 0x94, 0x87, 0x65, 0xae, 0xc7, 0x33, 0x4e, 0xaf, 0x54, 0xd1, 0xca,
+	0x34, 0xd2, 0x7b, 0xb0, 0xe6, 0xa2, 0x90, 0x30, 0xcc, 0xe5, 0xb5, 0x46, 0x51, 0xdb, 0x6c, 0x6d,
+	0xc3, 0x6c, 0x4c, 0xbc, 0x57, 0x98, 0xed, 0x15, 0xb6, 0x09, 0x0e, 0xcc, 0xfd, 0x58, 0xfd, 0xf5,
+	0x4a, 0xd5, 0x3c, 0xcc, 0x47, 0xd3, 0x21, 0x74, 0x88, 0x9f, 0xed, 0x35, 0xfb, 0x34, 0x99, 0x3b,
+	0xd6, 0x79, 0x14, 0x22, 0x96, 0x08, 0xd8, 0x97, 0xdf, 0xdf, 0x76, 0x45, 0x2b, 0x1f, 0x20, 0x75,
+	0x40, 0xc9, 0x67, 0x1e, 0x93, 0xd7, 0x93, 0x41, 0xb5, 0x7f, 0x7c, 0x1a, 0x41, 0x64, 0xee, 0x7c,
+	0x9f, 0x35, 0x1f, 0xdc, 0xe6, 0xe0, 0x80, 0x79, 0x56, 0x22, 0xdf, 0x3d, 0x06, 0x60, 0x99, 0xaa,
+	0x54, 0x07, 0xf7, 0xdb, 0xbd, 0x83, 0xbe, 0x61, 0x19, 0x47, 0x3d, 0x6b, 0xf0, 0xfa, 0xd5, 0x61,
+	0xbf, 0xd3, 0xee, 0xbe, 0xe8, 0x76, 0x9e, 0x57, 0x05, 0xa9, 0x06, 0xaa, 0x2b, 0x98, 0x61, 0xf6,
+	0xde, 0x74, 0xaa, 0xe2, 0x8d, 0xae, 0xd9, 0x79, 0xd9, 0x7b, 0x5b, 0x2d, 0xd4, 0x4b, 0x9f, 0x3e,
+	0x2b, 0x82, 0xb9, 0x7f, 0x36, 0x57, 0xc4, 0xf3, 0xb9, 0x22, 0xfe, 0x9a, 0x2b, 0xe2, 0xe9, 0x42,
+	0x11, 0xce, 0x17, 0x8a, 0xf0, 0x63, 0xa1, 0x08, 0xc7, 0x3b, 0xd7, 0xef, 0xe6, 0x64, 0xf5, 0xe5,
+	0x24, 0xb7, 0x1d, 0x56, 0x92, 0x3b, 0x3c, 0xf9, 0x3b, 0x00, 0x11, 0xfb, 0x68, 0x75, 0x5d, 0x03,
+	0x00, 0x00,
+}
+
+func (m *Alert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Alert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Alert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAlert(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAlert(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAlert(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAlert(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Comparator != 0 {
+		i = encodeVarintAlert(dAtA, i, uint64(m.Comparator))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintAlert(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAlert(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAlert(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAlert(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAlert(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Alert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAlert(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAlert(uint64(l))
+	}
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovAlert(uint64(l))
+	}
+	if m.Comparator != 0 {
+		n += 1 + sovAlert(uint64(m.Comparator))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovAlert(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovAlert(uint64(l))
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovAlert(uint64(l))
+		}
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovAlert(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAlert(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAlert(x uint64) (n int) {
+	return sovAlert(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Alert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAlert
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Alert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Alert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comparator", wireType)
+			}
+			m.Comparator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Comparator |= Comparator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types1.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAlert(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAlert(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAlert
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAlert
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAlert
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAlert
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAlert        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAlert          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAlert = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,16 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/pricealert module sentinel errors
+var (
+	ErrInvalidSigner       = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidAlert        = sdkerrors.Register(ModuleName, 1101, "invalid alert")
+	ErrAlertNotFound       = sdkerrors.Register(ModuleName, 1102, "alert not found")
+	ErrNotAlertOwner       = sdkerrors.Register(ModuleName, 1103, "not the owner of the alert")
+	ErrInsufficientDeposit = sdkerrors.Register(ModuleName, 1104, "insufficient deposit")
+)
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	// this line is used by starport scaffolding # genesis/types/import
+)
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		AlertList: []Alert{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated ID in alert
+	alertIdMap := make(map[uint64]bool)
+	alertCount := gs.GetAlertCount()
+	for _, elem := range gs.AlertList {
+		if _, ok := alertIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for alert")
+		}
+		if elem.Id >= alertCount {
+			return fmt.Errorf("alert id should be lower or equal than the last id")
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid alert %d: %w", elem.Id, err)
+		}
+		alertIdMap[elem.Id] = true
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, alert := range gs.AlertList {
+		if err := alert.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stretchr/testify/require"
+	"rollinky/x/pricealert/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc:     "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AlertList: []types.Alert{
+					validAlert(0),
+					validAlert(1),
+				},
+				AlertCount: 2,
+				// this line is used by starport scaffolding # types/genesis/validField
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated alert",
+			genState: &types.GenesisState{
+				AlertList: []types.Alert{
+					validAlert(0),
+					validAlert(0),
+				},
+				AlertCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid alert count",
+			genState: &types.GenesisState{
+				AlertList: []types.Alert{
+					validAlert(1),
+				},
+				AlertCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid alert",
+			genState: &types.GenesisState{
+				AlertList: []types.Alert{
+					{Id: 0, Owner: sdk.AccAddress("owner").String(), CurrencyPair: "btc/usd"},
+				},
+				AlertCount: 1,
+			},
+			valid: false,
+		},
+		// this line is used by starport scaffolding # types/genesis/testcase
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func validAlert(id uint64) types.Alert {
+	return types.Alert{
+		Id:           id,
+		Owner:        sdk.AccAddress("owner").String(),
+		CurrencyPair: "BTC/USD",
+		Comparator:   types.COMPARATOR_ABOVE,
+		Threshold:    math.NewInt(100),
+	}
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// AlertKey is the prefix to retrieve all Alert
+	AlertKey = "Alert/value/"
+	// AlertCountKey is the key of the number of Alert
+	AlertCountKey = "Alert/count/"
+	// AlertTriggerKeyPrefix is the prefix of the index of alerts by currency
+	// pair, comparator and threshold, so that a price only iterates the
+	// alerts it triggers.
+	AlertTriggerKeyPrefix = "Alert/trigger/"
+	// AlertExpiryKeyPrefix is the prefix of the index of alerts by expiry.
+	AlertExpiryKeyPrefix = "Alert/expiry/"
+	// AlertOwnerKeyPrefix is the prefix of the index of alerts by owner.
+	AlertOwnerKeyPrefix = "Alert/owner/"
+)
+
+// thresholdLen is the length of an encoded threshold, math.Int has at most
+// 256 bits.
+const thresholdLen = 32
+
+// GetAlertIDBytes returns the byte representation of the ID
+func GetAlertIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetAlertIDFromBytes returns ID in uint64 format from a byte array
+func GetAlertIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// ThresholdBytes encodes a non-negative threshold so that thresholds sort as
+// their bytes do.
+func ThresholdBytes(threshold math.Int) []byte {
+	return threshold.BigInt().FillBytes(make([]byte, thresholdLen))
+}
+
+// AlertTriggerPrefix returns the prefix of the trigger index of the alerts of
+// a currency pair with a comparator.
+func AlertTriggerPrefix(currencyPair string, comparator Comparator) []byte {
+	var key []byte
+
+	key = append(key, []byte(currencyPair)...)
+	key = append(key, []byte("/")...)
+	key = append(key, byte(comparator))
+
+	return key
+}
+
+// AlertTriggerKey returns the key of an alert in the trigger index, relative
+// to AlertTriggerPrefix.
+func AlertTriggerKey(threshold math.Int, id uint64) []byte {
+	return append(ThresholdBytes(threshold), GetAlertIDBytes(id)...)
+}
+
+// AlertExpiryKey returns the key of an alert in the expiry index.
+func AlertExpiryKey(expiry time.Time, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(expiry), GetAlertIDBytes(id)...)
+}
+
+// AlertOwnerPrefix returns the prefix of the alerts of an owner in the owner
+// index.
+func AlertOwnerPrefix(owner string) []byte {
+	var key []byte
+
+	key = append(key, []byte(owner)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// AlertOwnerKey returns the key of an alert in the owner index.
+func AlertOwnerKey(owner string, id uint64) []byte {
+	return append(AlertOwnerPrefix(owner), GetAlertIDBytes(id)...)
+}
@@ -0,0 +1,20 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "pricealert"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_pricealert"
+)
+
+var (
+	ParamsKey = []byte("p_pricealert")
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
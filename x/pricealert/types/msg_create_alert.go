@@ -0,0 +1,50 @@
+package types
+
+import (
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ sdk.Msg = &MsgCreateAlert{}
+
+// NewMsgCreateAlert creates a MsgCreateAlert, packing msgs.
+func NewMsgCreateAlert(alert Alert, msgs ...sdk.Msg) (*MsgCreateAlert, error) {
+	anys, err := tx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgCreateAlert{
+		Owner:        alert.Owner,
+		CurrencyPair: alert.CurrencyPair,
+		Comparator:   alert.Comparator,
+		Threshold:    alert.Threshold,
+		Expiry:       alert.Expiry,
+		Deposit:      alert.Deposit,
+		Msgs:         anys,
+	}, nil
+}
+
+// GetMessages returns the messages the alert executes when it triggers.
+func (m MsgCreateAlert) GetMessages() ([]sdk.Msg, error) {
+	return tx.GetMsgs(m.Msgs, "MsgCreateAlert")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgCreateAlert) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return tx.UnpackInterfaces(unpacker, m.Msgs)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCreateAlert) ValidateBasic() error {
+	return Alert{
+		Owner:        m.Owner,
+		CurrencyPair: m.CurrencyPair,
+		Comparator:   m.Comparator,
+		Threshold:    m.Threshold,
+		Expiry:       m.Expiry,
+		Deposit:      m.Deposit,
+		Msgs:         m.Msgs,
+	}.Validate()
+}
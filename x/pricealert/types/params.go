@@ -0,0 +1,202 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyMinDeposit = []byte("MinDeposit")
+	// DefaultMinDeposit lets alerts be created without a deposit.
+	DefaultMinDeposit sdk.Coins = nil
+)
+
+var (
+	KeyMaxDuration = []byte("MaxDuration")
+	// DefaultMaxDuration is the default longest an alert may last.
+	DefaultMaxDuration = 30 * 24 * time.Hour
+)
+
+var (
+	KeyMaxMsgs = []byte("MaxMsgs")
+	// DefaultMaxMsgs is the default most messages an alert may execute.
+	DefaultMaxMsgs uint64 = 5
+)
+
+var (
+	KeyMaxTriggersPerBlock = []byte("MaxTriggersPerBlock")
+	// DefaultMaxTriggersPerBlock is the default most alerts triggered by the
+	// prices of a block.
+	DefaultMaxTriggersPerBlock uint64 = 100
+)
+
+var (
+	KeyMaxExecutionGas = []byte("MaxExecutionGas")
+	// DefaultMaxExecutionGas is the default most gas the messages of an alert
+	// may consume.
+	DefaultMaxExecutionGas uint64 = 1_000_000
+)
+
+var (
+	KeyExecutionFee = []byte("ExecutionFee")
+	// DefaultExecutionFee executes the messages of alerts for free.
+	DefaultExecutionFee sdk.Coins = nil
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	minDeposit sdk.Coins,
+	maxDuration time.Duration,
+	maxMsgs uint64,
+	maxTriggersPerBlock uint64,
+	maxExecutionGas uint64,
+	executionFee sdk.Coins,
+) Params {
+	return Params{
+		MinDeposit:          minDeposit,
+		MaxDuration:         maxDuration,
+		MaxMsgs:             maxMsgs,
+		MaxTriggersPerBlock: maxTriggersPerBlock,
+		MaxExecutionGas:     maxExecutionGas,
+		ExecutionFee:        executionFee,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultMinDeposit,
+		DefaultMaxDuration,
+		DefaultMaxMsgs,
+		DefaultMaxTriggersPerBlock,
+		DefaultMaxExecutionGas,
+		DefaultExecutionFee,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinDeposit, &p.MinDeposit, validateMinDeposit),
+		paramtypes.NewParamSetPair(KeyMaxDuration, &p.MaxDuration, validateMaxDuration),
+		paramtypes.NewParamSetPair(KeyMaxMsgs, &p.MaxMsgs, validateMaxMsgs),
+		paramtypes.NewParamSetPair(KeyMaxTriggersPerBlock, &p.MaxTriggersPerBlock, validateMaxTriggersPerBlock),
+		paramtypes.NewParamSetPair(KeyMaxExecutionGas, &p.MaxExecutionGas, validateMaxExecutionGas),
+		paramtypes.NewParamSetPair(KeyExecutionFee, &p.ExecutionFee, validateExecutionFee),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateMinDeposit(p.MinDeposit); err != nil {
+		return err
+	}
+
+	if err := validateMaxDuration(p.MaxDuration); err != nil {
+		return err
+	}
+
+	if err := validateMaxMsgs(p.MaxMsgs); err != nil {
+		return err
+	}
+
+	if err := validateMaxTriggersPerBlock(p.MaxTriggersPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateMaxExecutionGas(p.MaxExecutionGas); err != nil {
+		return err
+	}
+
+	return validateExecutionFee(p.ExecutionFee)
+}
+
+// validateMinDeposit validates the MinDeposit param
+func validateMinDeposit(v interface{}) error {
+	minDeposit, ok := v.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if err := minDeposit.Validate(); err != nil {
+		return fmt.Errorf("invalid min deposit: %w", err)
+	}
+
+	return nil
+}
+
+// validateMaxDuration validates the MaxDuration param
+func validateMaxDuration(v interface{}) error {
+	maxDuration, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxDuration <= 0 {
+		return fmt.Errorf("max duration must be positive: %s", maxDuration)
+	}
+
+	return nil
+}
+
+// validateMaxMsgs validates the MaxMsgs param
+func validateMaxMsgs(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxTriggersPerBlock validates the MaxTriggersPerBlock param
+func validateMaxTriggersPerBlock(v interface{}) error {
+	maxTriggersPerBlock, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxTriggersPerBlock == 0 {
+		return fmt.Errorf("max triggers per block must be positive")
+	}
+
+	return nil
+}
+
+// validateMaxExecutionGas validates the MaxExecutionGas param
+func validateMaxExecutionGas(v interface{}) error {
+	maxExecutionGas, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxExecutionGas == 0 {
+		return fmt.Errorf("max execution gas must be positive")
+	}
+
+	return nil
+}
+
+// validateExecutionFee validates the ExecutionFee param
+func validateExecutionFee(v interface{}) error {
+	executionFee, ok := v.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if err := executionFee.Validate(); err != nil {
+		return fmt.Errorf("invalid execution fee: %w", err)
+	}
+
+	return nil
+}
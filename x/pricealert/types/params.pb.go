@@ -0,0 +1,630 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollinky/pricealert/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// min_deposit is the least deposit an alert must be created with. It is
+	// refunded once the alert triggers, expires or is canceled.
+	MinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_deposit"`
+	// max_duration is how long after its creation an alert may expire.
+	MaxDuration time.Duration `protobuf:"bytes,2,opt,name=max_duration,json=maxDuration,proto3,stdduration" json:"max_duration"`
+	// max_msgs is the most messages an alert may execute when it triggers.
+	MaxMsgs uint64 `protobuf:"varint,3,opt,name=max_msgs,json=maxMsgs,proto3" json:"max_msgs,omitempty"`
+	// max_triggers_per_block is the most alerts triggered by the prices of a
+	// block. The others trigger with the next prices written.
+	MaxTriggersPerBlock uint64 `protobuf:"varint,4,opt,name=max_triggers_per_block,json=maxTriggersPerBlock,proto3" json:"max_triggers_per_block,omitempty"`
+	// max_execution_gas is the most gas the messages of an alert may consume
+	// when it triggers. Messages that run out of gas fail.
+	MaxExecutionGas uint64 `protobuf:"varint,5,opt,name=max_execution_gas,json=maxExecutionGas,proto3" json:"max_execution_gas,omitempty"`
+	// execution_fee is taken from the deposit of an alert with messages when it
+	// triggers, whether the messages succeed or not, and paid to the fee
+	// collector. An alert with messages must deposit at least this much.
+	ExecutionFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=execution_fee,json=executionFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"execution_fee"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47f0809ce06c809f, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetMaxDuration() time.Duration {
+	if m != nil {
+		return m.MaxDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMsgs() uint64 {
+	if m != nil {
+		return m.MaxMsgs
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTriggersPerBlock() uint64 {
+	if m != nil {
+		return m.MaxTriggersPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxExecutionGas() uint64 {
+	if m != nil {
+		return m.MaxExecutionGas
+	}
+	return 0
+}
+
+func (m *Params) GetExecutionFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ExecutionFee
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "rollinky.pricealert.Params")
+}
+
+func init() { proto.RegisterFile("rollinky/pricealert/params.proto", fileDescriptor_47f0809ce06c809f) }
+
+var fileDescriptor_47f0809ce06c809f = []byte{
+	// 435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0x31, 0x6f, 0xd4, 0x30,
+	0x1c, 0xc5, 0xcf, 0x5c, 0x39, 0x2a, 0x5f, 0x11, 0x6a, 0x8a, 0x50, 0x5a, 0x50, 0xee, 0x84, 0x84,
+	0x74, 0x3a, 0x09, 0x5b, 0xa5, 0xea, 0xc2, 0x18, 0x4a, 0x99, 0x90, 0xaa, 0x13, 0x13, 0x4b, 0xe4,
+	0xe4, 0xfe, 0x35, 0xd6, 0xc5, 0xf9, 0x07, 0x3b, 0x41, 0xe9, 0x57, 0x60, 0x62, 0x64, 0x64, 0x44,
+	0x4c, 0xfd, 0x18, 0x1d, 0x3b, 0xb2, 0x40, 0xd1, 0xdd, 0x50, 0x3e, 0x06, 0x8a, 0x93, 0xf4, 0x18,
+	0x58, 0x59, 0x12, 0xc7, 0xef, 0xbd, 0xfc, 0x9e, 0xec, 0x3f, 0x1d, 0x1b, 0x4c, 0x53, 0x95, 0x2d,
+	0xce, 0x78, 0x6e, 0x54, 0x02, 0x22, 0x05, 0x53, 0xf0, 0x5c, 0x18, 0xa1, 0x2d, 0xcb, 0x0d, 0x16,
+	0xe8, 0xed, 0x74, 0x0e, 0xb6, 0x76, 0xec, 0x6d, 0x0b, 0xad, 0x32, 0xe4, 0xee, 0xd9, 0xf8, 0xf6,
+	0x82, 0x04, 0xad, 0x46, 0xcb, 0x63, 0x61, 0x81, 0x7f, 0xd8, 0x8f, 0xa1, 0x10, 0xfb, 0x3c, 0x41,
+	0x95, 0xb5, 0xfa, 0x7d, 0x89, 0x12, 0xdd, 0x92, 0xd7, 0xab, 0x2e, 0x25, 0x11, 0x65, 0x0a, 0xdc,
+	0x7d, 0xc5, 0xe5, 0x29, 0x9f, 0x97, 0x46, 0x14, 0x0a, 0xdb, 0xd4, 0xe3, 0x1f, 0x7d, 0x3a, 0x38,
+	0x71, 0x75, 0xbc, 0xf7, 0x74, 0xa8, 0x55, 0x16, 0xcd, 0x21, 0x47, 0xab, 0x0a, 0x9f, 0x8c, 0xfb,
+	0x93, 0xe1, 0xb3, 0x5d, 0xd6, 0x60, 0x59, 0x8d, 0x65, 0x2d, 0x96, 0xbd, 0x40, 0x95, 0x85, 0x87,
+	0x17, 0x3f, 0x47, 0xbd, 0x6f, 0x57, 0xa3, 0x89, 0x54, 0xc5, 0xbb, 0x32, 0x66, 0x09, 0x6a, 0xde,
+	0x76, 0x6c, 0x5e, 0x4f, 0xed, 0x7c, 0xc1, 0x8b, 0xb3, 0x1c, 0xac, 0x0b, 0xd8, 0xaf, 0xd7, 0xe7,
+	0x53, 0x32, 0xa3, 0x5a, 0x65, 0x47, 0x0d, 0xc3, 0x3b, 0xa6, 0x5b, 0x5a, 0x54, 0x51, 0xd7, 0xc9,
+	0xbf, 0x35, 0x26, 0x8e, 0xd9, 0x94, 0x66, 0x5d, 0x69, 0x76, 0xd4, 0x1a, 0xc2, 0xcd, 0x9a, 0xf9,
+	0xf9, 0x6a, 0x44, 0x66, 0x43, 0x2d, 0xaa, 0x6e, 0xdb, 0xdb, 0xa5, 0x9b, 0xf5, 0x7f, 0xb4, 0x95,
+	0xd6, 0xef, 0x8f, 0xc9, 0x64, 0x63, 0x76, 0x47, 0x8b, 0xea, 0xb5, 0x95, 0xd6, 0x3b, 0xa0, 0x0f,
+	0x6a, 0xa9, 0x30, 0x4a, 0x4a, 0x30, 0x36, 0xca, 0xc1, 0x44, 0x71, 0x8a, 0xc9, 0xc2, 0xdf, 0x70,
+	0xc6, 0x1d, 0x2d, 0xaa, 0x37, 0xad, 0x78, 0x02, 0x26, 0xac, 0x25, 0x6f, 0x4a, 0xb7, 0xeb, 0x10,
+	0x54, 0x90, 0x94, 0x35, 0x20, 0x92, 0xc2, 0xfa, 0xb7, 0x9d, 0xff, 0x9e, 0x16, 0xd5, 0xcb, 0x6e,
+	0xff, 0x95, 0xb0, 0x5e, 0x49, 0xef, 0xae, 0x7d, 0xa7, 0x00, 0xfe, 0xe0, 0x3f, 0x1d, 0xdc, 0xd6,
+	0x0d, 0xe6, 0x18, 0xe0, 0xf9, 0x93, 0xdf, 0x5f, 0x46, 0xe4, 0xe3, 0xf5, 0xf9, 0xf4, 0xd1, 0xcd,
+	0x84, 0x55, 0x7f, 0xcf, 0x58, 0x73, 0xa9, 0xe1, 0xe1, 0xc5, 0x32, 0x20, 0x97, 0xcb, 0x80, 0xfc,
+	0x5a, 0x06, 0xe4, 0xd3, 0x2a, 0xe8, 0x5d, 0xae, 0x82, 0xde, 0xf7, 0x55, 0xd0, 0x7b, 0xfb, 0xf0,
+	0xdf, 0x39, 0x87, 0x8d, 0x07, 0xee, 0xe8, 0x0f, 0xfe, 0x0c, 0x00, 0x99, 0x9e, 0x95, 0xdc, 0xbf,
+	0x02, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.MinDeposit) != len(that1.MinDeposit) {
+		return false
+	}
+	for i := range this.MinDeposit {
+		if !this.MinDeposit[i].Equal(&that1.MinDeposit[i]) {
+			return false
+		}
+	}
+	if this.MaxDuration != that1.MaxDuration {
+		return false
+	}
+	if this.MaxMsgs != that1.MaxMsgs {
+		return false
+	}
+	if this.MaxTriggersPerBlock != that1.MaxTriggersPerBlock {
+		return false
+	}
+	if this.MaxExecutionGas != that1.MaxExecutionGas {
+		return false
+	}
+	if len(this.ExecutionFee) != len(that1.ExecutionFee) {
+		return false
+	}
+	for i := range this.ExecutionFee {
+		if !this.ExecutionFee[i].Equal(&that1.ExecutionFee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExecutionFee) > 0 {
+		for iNdEx := len(m.ExecutionFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxExecutionGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxExecutionGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxTriggersPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTriggersPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxMsgs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMsgs))
+		i--
+		dAtA[i] = 0x18
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxMsgs != 0 {
+		n += 1 + sovParams(uint64(m.MaxMsgs))
+	}
+	if m.MaxTriggersPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxTriggersPerBlock))
+	}
+	if m.MaxExecutionGas != 0 {
+		n += 1 + sovParams(uint64(m.MaxExecutionGas))
+	}
+	if len(m.ExecutionFee) > 0 {
+		for _, e := range m.ExecutionFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgs", wireType)
+			}
+			m.MaxMsgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTriggersPerBlock", wireType)
+			}
+			m.MaxTriggersPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTriggersPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionGas", wireType)
+			}
+			m.MaxExecutionGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionFee = append(m.ExecutionFee, types.Coin{})
+			if err := m.ExecutionFee[len(m.ExecutionFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
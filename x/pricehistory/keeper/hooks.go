@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+
+	attestationtypes "rollinky/x/attestation/types"
+	"rollinky/x/pricehistory/types"
+)
+
+var _ attestationtypes.OraclePriceHooks = Hooks{}
+
+// Hooks records the oracle prices written by the PreBlocker.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the oracle price hooks of the keeper.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterPricesUpdated records every written price.
+func (h Hooks) AfterPricesUpdated(ctx context.Context, prices map[connecttypes.CurrencyPair]oracletypes.QuotePrice) error {
+	for _, cp := range attestationtypes.SortedCurrencyPairs(prices) {
+		h.k.SetPriceRecord(ctx, types.PriceRecord{
+			CurrencyPair: cp.String(),
+			Price:        prices[cp].Price,
+			BlockHeight:  prices[cp].BlockHeight,
+			BlockTime:    prices[cp].BlockTimestamp,
+		})
+	}
+
+	return nil
+}
+
+// AfterPriceMissed does nothing, a missed price keeps the last one recorded.
+func (h Hooks) AfterPriceMissed(context.Context, connecttypes.CurrencyPair) error {
+	return nil
+}
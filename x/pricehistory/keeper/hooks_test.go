@@ -0,0 +1,27 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/x/pricehistory/types"
+)
+
+func TestHooksAfterPricesUpdated(t *testing.T) {
+	k, ctx := keepertest.PricehistoryKeeper(t)
+	require.NoError(t, k.Hooks().AfterPricesUpdated(ctx, map[connecttypes.CurrencyPair]oracletypes.QuotePrice{
+		connecttypes.NewCurrencyPair("BTC", "USD"): {Price: math.NewInt(100), BlockTimestamp: genesisTime, BlockHeight: 10},
+		connecttypes.NewCurrencyPair("ETH", "USD"): {Price: math.NewInt(200), BlockTimestamp: genesisTime, BlockHeight: 10},
+	}))
+	require.NoError(t, k.Hooks().AfterPriceMissed(ctx, connecttypes.NewCurrencyPair("SOL", "USD")))
+
+	require.ElementsMatch(t, []types.PriceRecord{
+		{CurrencyPair: "BTC/USD", Price: math.NewInt(100), BlockHeight: 10, BlockTime: genesisTime},
+		{CurrencyPair: "ETH/USD", Price: math.NewInt(200), BlockHeight: 10, BlockTime: genesisTime},
+	}, k.GetAllPriceRecord(ctx))
+}
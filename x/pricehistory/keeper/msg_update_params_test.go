@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/x/pricehistory/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	params := types.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, params))
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	update := func(authority string, params types.Params) error {
+		_, err := ms.UpdateParams(wctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+		return err
+	}
+	get := func() types.Params { return k.GetParams(wctx) }
+
+	keepertest.CheckMsgUpdateParams(t, k.GetAuthority(), update, get, []keepertest.UpdateParamsCase[types.Params]{
+		{
+			Name:      "retention without a prune limit",
+			Params:    types.NewParams(100, 0),
+			ExpErrMsg: "max pruned per block is zero",
+		},
+		{
+			Name:   "retention",
+			Params: types.NewParams(100, 10),
+		},
+		{
+			Name:   "all good",
+			Params: params,
+		},
+	})
+}
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/pricehistory/types"
+)
+
+// SetPriceRecord set a specific priceRecord in the store from its index, and
+// indexes it by time and height
+func (k Keeper) SetPriceRecord(ctx context.Context, priceRecord types.PriceRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	// a record overwritten with a different time must not stay in the time index.
+	if old, found := k.GetPriceRecord(ctx, priceRecord.CurrencyPair, priceRecord.BlockHeight); found {
+		timeStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceRecordTimeKeyPrefix))
+		timeStore.Delete(types.PriceRecordTimeKey(old.CurrencyPair, old.BlockTime, old.BlockHeight))
+	}
+
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceRecordKeyPrefix))
+	b := k.cdc.MustMarshal(&priceRecord)
+	store.Set(types.PriceRecordKey(
+		priceRecord.CurrencyPair,
+		priceRecord.BlockHeight,
+	), b)
+
+	timeStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceRecordTimeKeyPrefix))
+	timeStore.Set(types.PriceRecordTimeKey(
+		priceRecord.CurrencyPair,
+		priceRecord.BlockTime,
+		priceRecord.BlockHeight,
+	), binary.BigEndian.AppendUint64(nil, priceRecord.BlockHeight))
+
+	heightStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceRecordHeightKeyPrefix))
+	heightStore.Set(types.PriceRecordHeightKey(
+		priceRecord.BlockHeight,
+		priceRecord.CurrencyPair,
+	), []byte{})
+}
+
+// GetPriceRecord returns a priceRecord from its index
+func (k Keeper) GetPriceRecord(
+	ctx context.Context,
+	currencyPair string,
+	blockHeight uint64,
+) (val types.PriceRecord, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceRecordKeyPrefix))
+
+	b := store.Get(types.PriceRecordKey(
+		currencyPair,
+		blockHeight,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePriceRecord removes a priceRecord and its indexes from the store
+func (k Keeper) RemovePriceRecord(
+	ctx context.Context,
+	currencyPair string,
+	blockHeight uint64,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if val, found := k.GetPriceRecord(ctx, currencyPair, blockHeight); found {
+		timeStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceRecordTimeKeyPrefix))
+		timeStore.Delete(types.PriceRecordTimeKey(currencyPair, val.BlockTime, blockHeight))
+	}
+
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceRecordKeyPrefix))
+	store.Delete(types.PriceRecordKey(
+		currencyPair,
+		blockHeight,
+	))
+
+	heightStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceRecordHeightKeyPrefix))
+	heightStore.Delete(types.PriceRecordHeightKey(blockHeight, currencyPair))
+}
+
+// GetAllPriceRecord returns all priceRecord
+func (k Keeper) GetAllPriceRecord(ctx context.Context) (list []types.PriceRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceRecordKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PriceRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPriceAtHeight returns the last priceRecord of a currency pair written at
+// or before a height
+func (k Keeper) GetPriceAtHeight(
+	ctx context.Context,
+	currencyPair string,
+	blockHeight uint64,
+) (val types.PriceRecord, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceRecordKeyPrefix))
+
+	// the end is exclusive, so the record at the height itself needs its
+	// prefix end.
+	iterator := store.ReverseIterator(
+		types.PriceRecordPairKey(currencyPair),
+		storetypes.PrefixEndBytes(types.PriceRecordKey(currencyPair, blockHeight)),
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(iterator.Value(), &val)
+	return val, true
+}
+
+// GetPriceAtTime returns the last priceRecord of a currency pair written in a
+// block at or before a time
+func (k Keeper) GetPriceAtTime(
+	ctx context.Context,
+	currencyPair string,
+	blockTime time.Time,
+) (val types.PriceRecord, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	timeStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceRecordTimeKeyPrefix))
+
+	pairKey := types.PriceRecordPairKey(currencyPair)
+	iterator := timeStore.ReverseIterator(
+		pairKey,
+		storetypes.PrefixEndBytes(append(pairKey, sdk.FormatTimeBytes(blockTime)...)),
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, false
+	}
+
+	return k.GetPriceRecord(ctx, currencyPair, binary.BigEndian.Uint64(iterator.Value()))
+}
+
+// PrunePriceRecords removes the priceRecords older than the retention, counted
+// back from the current height, at most max_pruned_per_block of them per call.
+// Whatever is left is pruned in the next blocks.
+func (k Keeper) PrunePriceRecords(ctx context.Context) {
+	params := k.GetParams(ctx)
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec
+	if params.RetentionBlocks == 0 || height < params.RetentionBlocks {
+		return
+	}
+
+	// the height index is keyed by big endian height, so the expired records
+	// come first.
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	heightStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceRecordHeightKeyPrefix))
+	iterator := heightStore.Iterator(nil, binary.BigEndian.AppendUint64(nil, height-params.RetentionBlocks+1))
+
+	var expired [][]byte
+	for ; iterator.Valid() && uint64(len(expired)) < params.MaxPrunedPerBlock; iterator.Next() {
+		expired = append(expired, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range expired {
+		blockHeight, currencyPair := types.ParsePriceRecordHeightKey(key)
+		k.RemovePriceRecord(ctx, currencyPair, blockHeight)
+	}
+}
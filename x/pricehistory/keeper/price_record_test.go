@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/testutil/nullify"
+	"rollinky/x/pricehistory/keeper"
+	"rollinky/x/pricehistory/types"
+)
+
+// genesisTime is the time of the first block of the test records, every next
+// block is one second later.
+var genesisTime = time.Unix(1700000000, 0).UTC()
+
+func createNPriceRecord(keeper keeper.Keeper, ctx context.Context, currencyPair string, n int) []types.PriceRecord {
+	items := make([]types.PriceRecord, n)
+	for i := range items {
+		items[i].CurrencyPair = currencyPair
+		items[i].Price = math.NewInt(int64(100 + i))
+		items[i].BlockHeight = uint64(i + 1)
+		items[i].BlockTime = genesisTime.Add(time.Duration(i) * time.Second)
+		keeper.SetPriceRecord(ctx, items[i])
+	}
+	return items
+}
+
+func TestPriceRecordGet(t *testing.T) {
+	keeper, ctx := keepertest.PricehistoryKeeper(t)
+	items := createNPriceRecord(keeper, ctx, "BTC/USD", 10)
+	for _, item := range items {
+		rst, found := keeper.GetPriceRecord(ctx,
+			item.CurrencyPair,
+			item.BlockHeight,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestPriceRecordRemove(t *testing.T) {
+	keeper, ctx := keepertest.PricehistoryKeeper(t)
+	items := createNPriceRecord(keeper, ctx, "BTC/USD", 10)
+	for _, item := range items {
+		keeper.RemovePriceRecord(ctx,
+			item.CurrencyPair,
+			item.BlockHeight,
+		)
+		_, found := keeper.GetPriceRecord(ctx,
+			item.CurrencyPair,
+			item.BlockHeight,
+		)
+		require.False(t, found)
+		_, found = keeper.GetPriceAtTime(ctx, item.CurrencyPair, item.BlockTime)
+		require.False(t, found)
+	}
+}
+
+func TestPriceRecordGetAll(t *testing.T) {
+	keeper, ctx := keepertest.PricehistoryKeeper(t)
+	items := createNPriceRecord(keeper, ctx, "BTC/USD", 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllPriceRecord(ctx)),
+	)
+}
+
+func TestGetPriceAtHeight(t *testing.T) {
+	k, ctx := keepertest.PricehistoryKeeper(t)
+	items := createNPriceRecord(k, ctx, "BTC/USD", 300)
+	createNPriceRecord(k, ctx, "BTC/USDT", 300)
+
+	// no price was written at height 150, the one before it counts.
+	k.RemovePriceRecord(ctx, "BTC/USD", 150)
+
+	for _, tc := range []struct {
+		height uint64
+		want   int
+	}{
+		{height: 1, want: 0},
+		{height: 149, want: 148},
+		{height: 150, want: 148},
+		{height: 256, want: 255},
+		{height: 1000, want: 299},
+	} {
+		rst, found := k.GetPriceAtHeight(ctx, "BTC/USD", tc.height)
+		require.True(t, found)
+		require.Equal(t, nullify.Fill(&items[tc.want]), nullify.Fill(&rst))
+	}
+
+	_, found := k.GetPriceAtHeight(ctx, "BTC/USD", 0)
+	require.False(t, found)
+	_, found = k.GetPriceAtHeight(ctx, "ETH/USD", 10)
+	require.False(t, found)
+}
+
+func TestGetPriceAtTime(t *testing.T) {
+	k, ctx := keepertest.PricehistoryKeeper(t)
+	items := createNPriceRecord(k, ctx, "BTC/USD", 10)
+
+	for _, tc := range []struct {
+		time time.Time
+		want int
+	}{
+		{time: genesisTime, want: 0},
+		{time: genesisTime.Add(4 * time.Second), want: 4},
+		{time: genesisTime.Add(4*time.Second + time.Millisecond), want: 4},
+		{time: genesisTime.Add(time.Hour), want: 9},
+	} {
+		rst, found := k.GetPriceAtTime(ctx, "BTC/USD", tc.time)
+		require.True(t, found)
+		require.Equal(t, nullify.Fill(&items[tc.want]), nullify.Fill(&rst))
+	}
+
+	_, found := k.GetPriceAtTime(ctx, "BTC/USD", genesisTime.Add(-time.Nanosecond))
+	require.False(t, found)
+}
+
+func TestPrunePriceRecords(t *testing.T) {
+	k, ctx := keepertest.PricehistoryKeeper(t)
+	btc := createNPriceRecord(k, ctx, "BTC/USD", 300)
+	eth := createNPriceRecord(k, ctx, "ETH/USD", 300)
+
+	// nothing is pruned without a retention.
+	ctx = sdk.UnwrapSDKContext(ctx).WithBlockHeight(300)
+	k.PrunePriceRecords(ctx)
+	require.Len(t, k.GetAllPriceRecord(ctx), 600)
+
+	params := types.DefaultParams()
+	params.RetentionBlocks = 100
+	params.MaxPrunedPerBlock = 150
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// the oldest heights go first, across pairs.
+	k.PrunePriceRecords(ctx)
+	require.Len(t, k.GetAllPriceRecord(ctx), 450)
+	_, found := k.GetPriceRecord(ctx, "ETH/USD", 75)
+	require.False(t, found)
+	_, found = k.GetPriceRecord(ctx, "ETH/USD", 76)
+	require.True(t, found)
+
+	// the rest is pruned in the next blocks.
+	k.PrunePriceRecords(ctx)
+	k.PrunePriceRecords(ctx)
+	require.ElementsMatch(t,
+		nullify.Fill(append(btc[200:], eth[200:]...)),
+		nullify.Fill(k.GetAllPriceRecord(ctx)),
+	)
+	_, found = k.GetPriceAtTime(ctx, "BTC/USD", genesisTime.Add(time.Second))
+	require.False(t, found)
+}
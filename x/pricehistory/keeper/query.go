@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"rollinky/x/pricehistory/types"
+)
+
+var _ types.QueryServer = Keeper{}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rollinky/x/pricehistory/types"
+)
+
+func (k Keeper) PriceAtHeight(ctx context.Context, req *types.QueryPriceAtHeightRequest) (*types.QueryPriceAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetPriceAtHeight(
+		ctx,
+		req.CurrencyPair,
+		req.BlockHeight,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryPriceAtHeightResponse{PriceRecord: val}, nil
+}
+
+func (k Keeper) PriceAtTime(ctx context.Context, req *types.QueryPriceAtTimeRequest) (*types.QueryPriceAtTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetPriceAtTime(
+		ctx,
+		req.CurrencyPair,
+		req.Time,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryPriceAtTimeResponse{PriceRecord: val}, nil
+}
+
+// PriceRange pages through the time index of the currency pair between the
+// start and end time. query.Paginate can't stop at the end of the range, so
+// only key based pagination is supported.
+func (k Keeper) PriceRange(ctx context.Context, req *types.QueryPriceRangeRequest) (*types.QueryPriceRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.EndTime.Before(req.StartTime) {
+		return nil, status.Error(codes.InvalidArgument, "end time is before start time")
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 || pageReq.CountTotal || pageReq.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "only key and limit pagination is supported")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	timeStore := prefix.NewStore(
+		prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceRecordTimeKeyPrefix)),
+		types.PriceRecordPairKey(req.CurrencyPair),
+	)
+
+	start := sdk.FormatTimeBytes(req.StartTime)
+	if len(pageReq.Key) > 0 {
+		start = pageReq.Key
+	}
+	iterator := timeStore.Iterator(start, sdk.FormatTimeBytes(req.EndTime))
+	defer iterator.Close()
+
+	var (
+		priceRecords []types.PriceRecord
+		pageRes      = &query.PageResponse{}
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(priceRecords)) == limit {
+			pageRes.NextKey = iterator.Key()
+			break
+		}
+
+		val, found := k.GetPriceRecord(ctx, req.CurrencyPair, binary.BigEndian.Uint64(iterator.Value()))
+		if !found {
+			return nil, status.Error(codes.Internal, "price record missing for time index")
+		}
+		priceRecords = append(priceRecords, val)
+	}
+
+	return &types.QueryPriceRangeResponse{PriceRecord: priceRecords, Pagination: pageRes}, nil
+}
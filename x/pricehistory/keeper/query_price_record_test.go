@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/testutil/nullify"
+	"rollinky/x/pricehistory/types"
+)
+
+func TestPriceAtHeightQuery(t *testing.T) {
+	keeper, ctx := keepertest.PricehistoryKeeper(t)
+	msgs := createNPriceRecord(keeper, ctx, "BTC/USD", 2)
+	tests := []struct {
+		desc     string
+		request  *types.QueryPriceAtHeightRequest
+		response *types.QueryPriceAtHeightResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryPriceAtHeightRequest{CurrencyPair: "BTC/USD", BlockHeight: 1},
+			response: &types.QueryPriceAtHeightResponse{PriceRecord: msgs[0]},
+		},
+		{
+			desc:     "After last",
+			request:  &types.QueryPriceAtHeightRequest{CurrencyPair: "BTC/USD", BlockHeight: 100},
+			response: &types.QueryPriceAtHeightResponse{PriceRecord: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryPriceAtHeightRequest{CurrencyPair: "ETH/USD", BlockHeight: 1},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.PriceAtHeight(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestPriceAtTimeQuery(t *testing.T) {
+	keeper, ctx := keepertest.PricehistoryKeeper(t)
+	msgs := createNPriceRecord(keeper, ctx, "BTC/USD", 2)
+	tests := []struct {
+		desc     string
+		request  *types.QueryPriceAtTimeRequest
+		response *types.QueryPriceAtTimeResponse
+		err      error
+	}{
+		{
+			desc:     "Between blocks",
+			request:  &types.QueryPriceAtTimeRequest{CurrencyPair: "BTC/USD", Time: genesisTime.Add(500 * time.Millisecond)},
+			response: &types.QueryPriceAtTimeResponse{PriceRecord: msgs[0]},
+		},
+		{
+			desc:    "Before first",
+			request: &types.QueryPriceAtTimeRequest{CurrencyPair: "BTC/USD", Time: genesisTime.Add(-time.Second)},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.PriceAtTime(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestPriceRangeQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.PricehistoryKeeper(t)
+	msgs := createNPriceRecord(keeper, ctx, "BTC/USD", 10)
+	createNPriceRecord(keeper, ctx, "BTC/USDT", 10)
+
+	// [2s, 8s) covers the records 2 to 7.
+	request := func(next []byte, limit uint64) *types.QueryPriceRangeRequest {
+		return &types.QueryPriceRangeRequest{
+			CurrencyPair: "BTC/USD",
+			StartTime:    genesisTime.Add(2 * time.Second),
+			EndTime:      genesisTime.Add(8 * time.Second),
+			Pagination: &query.PageRequest{
+				Key:   next,
+				Limit: limit,
+			},
+		}
+	}
+	t.Run("ByKey", func(t *testing.T) {
+		var next []byte
+		var got []types.PriceRecord
+		for i := 0; i < 3; i++ {
+			resp, err := keeper.PriceRange(ctx, request(next, 2))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.PriceRecord), 2)
+			got = append(got, resp.PriceRecord...)
+			next = resp.Pagination.NextKey
+		}
+		require.Nil(t, next)
+		require.Equal(t,
+			nullify.Fill(msgs[2:8]),
+			nullify.Fill(got),
+		)
+	})
+	t.Run("All", func(t *testing.T) {
+		resp, err := keeper.PriceRange(ctx, request(nil, 0))
+		require.NoError(t, err)
+		require.Equal(t,
+			nullify.Fill(msgs[2:8]),
+			nullify.Fill(resp.PriceRecord),
+		)
+	})
+	t.Run("InvalidRange", func(t *testing.T) {
+		_, err := keeper.PriceRange(ctx, &types.QueryPriceRangeRequest{
+			CurrencyPair: "BTC/USD",
+			StartTime:    genesisTime.Add(time.Second),
+			EndTime:      genesisTime,
+		})
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "end time is before start time"))
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.PriceRange(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,53 @@
+package pricehistory
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "rollinky/api/rollinky/pricehistory"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod:      "PriceAtHeight",
+					Use:            "price-at-height [currency-pair] [block-height]",
+					Short:          "Shows the price of a currency pair at a height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "currency_pair"}, {ProtoField: "block_height"}},
+				},
+				{
+					RpcMethod:      "PriceAtTime",
+					Use:            "price-at-time [currency-pair] [time]",
+					Short:          "Shows the price of a currency pair at a time (RFC 3339)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "currency_pair"}, {ProtoField: "time"}},
+				},
+				{
+					RpcMethod:      "PriceRange",
+					Use:            "price-range [currency-pair] [start-time] [end-time]",
+					Short:          "List the prices of a currency pair between two times (RFC 3339), oldest first",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "currency_pair"}, {ProtoField: "start_time"}, {ProtoField: "end_time"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package pricehistory_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/testutil/nullify"
+	pricehistory "rollinky/x/pricehistory/module"
+	"rollinky/x/pricehistory/types"
+)
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+
+		PriceRecordList: []types.PriceRecord{
+			{
+				CurrencyPair: "BTC/USD",
+				Price:        math.NewInt(100),
+				BlockHeight:  1,
+				BlockTime:    time.Unix(1700000000, 0).UTC(),
+			},
+			{
+				CurrencyPair: "BTC/USD",
+				Price:        math.NewInt(101),
+				BlockHeight:  2,
+				BlockTime:    time.Unix(1700000001, 0).UTC(),
+			},
+		},
+		// this line is used by starport scaffolding # genesis/test/state
+	}
+
+	k, ctx := keepertest.PricehistoryKeeper(t)
+	pricehistory.InitGenesis(ctx, k, genesisState)
+	got := pricehistory.ExportGenesis(ctx, k)
+	require.NotNil(t, got)
+
+	nullify.Fill(&genesisState)
+	nullify.Fill(got)
+
+	require.ElementsMatch(t, genesisState.PriceRecordList, got.PriceRecordList)
+	// this line is used by starport scaffolding # genesis/test/assert
+}
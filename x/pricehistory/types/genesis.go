@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+	// this line is used by starport scaffolding # genesis/types/import
+)
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		PriceRecordList: []PriceRecord{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in priceRecord
+	priceRecordIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PriceRecordList {
+		index := string(PriceRecordKey(elem.CurrencyPair, elem.BlockHeight))
+		if _, ok := priceRecordIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for priceRecord")
+		}
+		priceRecordIndexMap[index] = struct{}{}
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
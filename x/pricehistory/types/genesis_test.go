@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"rollinky/x/pricehistory/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PriceRecordList: []types.PriceRecord{
+					{
+						CurrencyPair: "BTC/USD",
+						BlockHeight:  1,
+					},
+					{
+						CurrencyPair: "ETH/USD",
+						BlockHeight:  1,
+					},
+				},
+				// this line is used by starport scaffolding # types/genesis/validField
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated priceRecord",
+			genState: &types.GenesisState{
+				PriceRecordList: []types.PriceRecord{
+					{
+						CurrencyPair: "BTC/USD",
+						BlockHeight:  1,
+					},
+					{
+						CurrencyPair: "BTC/USD",
+						BlockHeight:  1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "retention without pruning",
+			genState: &types.GenesisState{
+				Params: types.NewParams(100, 0),
+			},
+			valid: false,
+		},
+		// this line is used by starport scaffolding # types/genesis/testcase
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PriceRecordKeyPrefix is the prefix to retrieve all PriceRecord
+	PriceRecordKeyPrefix = "PriceRecord/value/"
+	// PriceRecordTimeKeyPrefix is the prefix of the index of PriceRecord by
+	// currency pair and block time
+	PriceRecordTimeKeyPrefix = "PriceRecord/time/"
+	// PriceRecordHeightKeyPrefix is the prefix of the index of PriceRecord by
+	// block height, used for pruning
+	PriceRecordHeightKeyPrefix = "PriceRecord/height/"
+)
+
+// PriceRecordPairKey returns the key prefix of all the PriceRecord of a
+// currency pair, in the value and time indexes
+func PriceRecordPairKey(
+	currencyPair string,
+) []byte {
+	var key []byte
+
+	currencyPairBytes := []byte(currencyPair)
+	key = append(key, currencyPairBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// PriceRecordKey returns the store key to retrieve a PriceRecord from the index
+// fields. Heights are big endian, so the records of a pair are iterated in
+// height order.
+func PriceRecordKey(
+	currencyPair string,
+	blockHeight uint64,
+) []byte {
+	return binary.BigEndian.AppendUint64(PriceRecordPairKey(currencyPair), blockHeight)
+}
+
+// PriceRecordTimeKey returns the key of a PriceRecord in the time index. Times
+// are formatted with a fixed width, so the records of a pair are iterated in
+// time order.
+func PriceRecordTimeKey(
+	currencyPair string,
+	blockTime time.Time,
+	blockHeight uint64,
+) []byte {
+	key := append(PriceRecordPairKey(currencyPair), sdk.FormatTimeBytes(blockTime)...)
+	return binary.BigEndian.AppendUint64(key, blockHeight)
+}
+
+// PriceRecordHeightKey returns the key of a PriceRecord in the height index.
+func PriceRecordHeightKey(
+	blockHeight uint64,
+	currencyPair string,
+) []byte {
+	return append(binary.BigEndian.AppendUint64(nil, blockHeight), []byte(currencyPair)...)
+}
+
+// ParsePriceRecordHeightKey returns the index fields of a height index key.
+func ParsePriceRecordHeightKey(key []byte) (blockHeight uint64, currencyPair string) {
+	return binary.BigEndian.Uint64(key[:8]), string(key[8:])
+}
@@ -0,0 +1,20 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "pricehistory"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_pricehistory"
+)
+
+var (
+	ParamsKey = []byte("p_pricehistory")
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyRetentionBlocks = []byte("RetentionBlocks")
+	// DefaultRetentionBlocks keeps the prices of all heights.
+	DefaultRetentionBlocks uint64 = 0
+)
+
+var (
+	KeyMaxPrunedPerBlock = []byte("MaxPrunedPerBlock")
+	// DefaultMaxPrunedPerBlock is the default cap of prices pruned per block.
+	DefaultMaxPrunedPerBlock uint64 = 1000
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	retentionBlocks uint64,
+	maxPrunedPerBlock uint64,
+) Params {
+	return Params{
+		RetentionBlocks:   retentionBlocks,
+		MaxPrunedPerBlock: maxPrunedPerBlock,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultRetentionBlocks,
+		DefaultMaxPrunedPerBlock,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRetentionBlocks, &p.RetentionBlocks, validateRetentionBlocks),
+		paramtypes.NewParamSetPair(KeyMaxPrunedPerBlock, &p.MaxPrunedPerBlock, validateMaxPrunedPerBlock),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateRetentionBlocks(p.RetentionBlocks); err != nil {
+		return err
+	}
+
+	if err := validateMaxPrunedPerBlock(p.MaxPrunedPerBlock); err != nil {
+		return err
+	}
+
+	if p.RetentionBlocks > 0 && p.MaxPrunedPerBlock == 0 {
+		return fmt.Errorf("retention blocks is set but max pruned per block is zero, nothing would be pruned")
+	}
+
+	return nil
+}
+
+// validateRetentionBlocks validates the RetentionBlocks param
+func validateRetentionBlocks(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxPrunedPerBlock validates the MaxPrunedPerBlock param
+func validateMaxPrunedPerBlock(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,471 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollinky/pricehistory/price_record.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PriceRecord is a price written to the oracle module at a height.
+type PriceRecord struct {
+	// currency_pair is the currency pair, e.g. "BTC/USD".
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// price is the price written to the oracle module.
+	Price cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.Int" json:"price"`
+	// block_height is the height the price was written at.
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_time is the time of the block the price was written at.
+	BlockTime time.Time `protobuf:"bytes,4,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+}
+
+func (m *PriceRecord) Reset()         { *m = PriceRecord{} }
+func (m *PriceRecord) String() string { return proto.CompactTextString(m) }
+func (*PriceRecord) ProtoMessage()    {}
+func (*PriceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87813e4df11eb5ed, []int{0}
+}
+func (m *PriceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceRecord.Merge(m, src)
+}
+func (m *PriceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceRecord proto.InternalMessageInfo
+
+func (m *PriceRecord) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *PriceRecord) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PriceRecord) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*PriceRecord)(nil), "rollinky.pricehistory.PriceRecord")
+}
+
+func init() {
+	proto.RegisterFile("rollinky/pricehistory/price_record.proto", fileDescriptor_87813e4df11eb5ed)
+}
+
+var fileDescriptor_87813e4df11eb5ed = []byte{
+	// 319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0xc1, 0x4a, 0xf3, 0x40,
+	0x18, 0xcc, 0xfe, 0x7f, 0x15, 0xbb, 0xad, 0x97, 0x60, 0x21, 0x16, 0x4c, 0xaa, 0x5e, 0x02, 0xe2,
+	0x06, 0xf4, 0xe0, 0xd9, 0x7a, 0xb1, 0xb7, 0x12, 0x3c, 0x79, 0x09, 0x69, 0xba, 0x26, 0x4b, 0x93,
+	0x7c, 0x61, 0x77, 0x0b, 0xf6, 0x2d, 0xfa, 0x30, 0x3e, 0x44, 0x8f, 0xc5, 0x93, 0x08, 0x56, 0x69,
+	0x5f, 0x44, 0x76, 0x37, 0x11, 0xbd, 0xed, 0xcc, 0xce, 0x30, 0x33, 0x7c, 0xd8, 0xe7, 0x90, 0xe7,
+	0xac, 0x9c, 0x2d, 0x82, 0x8a, 0xb3, 0x84, 0x66, 0x4c, 0x48, 0xe0, 0x35, 0x88, 0x38, 0x4d, 0x80,
+	0x4f, 0x49, 0xc5, 0x41, 0x82, 0xdd, 0x6b, 0x94, 0xe4, 0xb7, 0xb2, 0x7f, 0x9c, 0x80, 0x28, 0x40,
+	0x44, 0x5a, 0x14, 0x18, 0x60, 0x1c, 0xfd, 0xa3, 0x14, 0x52, 0x30, 0xbc, 0x7a, 0xd5, 0xac, 0x97,
+	0x02, 0xa4, 0x39, 0x0d, 0x34, 0x9a, 0xcc, 0x9f, 0x02, 0xc9, 0x0a, 0x2a, 0x64, 0x5c, 0x54, 0x46,
+	0x70, 0xf6, 0x81, 0x70, 0x67, 0xac, 0x22, 0x42, 0x1d, 0x6f, 0x9f, 0xe3, 0xc3, 0x64, 0xce, 0x39,
+	0x2d, 0x93, 0x45, 0x54, 0xc5, 0x8c, 0x3b, 0x68, 0x80, 0xfc, 0x76, 0xd8, 0x6d, 0xc8, 0x71, 0xcc,
+	0xb8, 0x7d, 0x8b, 0xf7, 0x74, 0x2d, 0xe7, 0x9f, 0xfa, 0x1c, 0x5e, 0xac, 0x36, 0x9e, 0xf5, 0xbe,
+	0xf1, 0x7a, 0xa6, 0x90, 0x98, 0xce, 0x08, 0x83, 0xa0, 0x88, 0x65, 0x46, 0x46, 0xa5, 0x7c, 0x7d,
+	0xb9, 0xc4, 0x75, 0xd3, 0x51, 0x29, 0x43, 0xe3, 0xb4, 0x4f, 0x71, 0x77, 0x92, 0x43, 0x32, 0x8b,
+	0x32, 0xca, 0xd2, 0x4c, 0x3a, 0xff, 0x07, 0xc8, 0x6f, 0x85, 0x1d, 0xcd, 0xdd, 0x6b, 0xca, 0xbe,
+	0xc3, 0xd8, 0x48, 0x54, 0x67, 0xa7, 0x35, 0x40, 0x7e, 0xe7, 0xaa, 0x4f, 0xcc, 0x20, 0xd2, 0x0c,
+	0x22, 0x0f, 0xcd, 0xa0, 0xe1, 0x81, 0xaa, 0xb1, 0xfc, 0xf4, 0x50, 0xd8, 0xd6, 0x3e, 0xf5, 0x33,
+	0xbc, 0x59, 0x6d, 0x5d, 0xb4, 0xde, 0xba, 0xe8, 0x6b, 0xeb, 0xa2, 0xe5, 0xce, 0xb5, 0xd6, 0x3b,
+	0xd7, 0x7a, 0xdb, 0xb9, 0xd6, 0xe3, 0xc9, 0xcf, 0x31, 0x9e, 0xff, 0x9e, 0x43, 0x2e, 0x2a, 0x2a,
+	0x26, 0xfb, 0x3a, 0xe1, 0xfa, 0x7b, 0x00, 0x79, 0x43, 0x0e, 0xe8, 0xb4, 0x01, 0x00, 0x00,
+}
+
+func (m *PriceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPriceRecord(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.BlockHeight != 0 {
+		i = encodeVarintPriceRecord(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPriceRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintPriceRecord(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPriceRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPriceRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PriceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovPriceRecord(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovPriceRecord(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovPriceRecord(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovPriceRecord(uint64(l))
+	return n
+}
+
+func sovPriceRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPriceRecord(x uint64) (n int) {
+	return sovPriceRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PriceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPriceRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPriceRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPriceRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPriceRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPriceRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPriceRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPriceRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPriceRecord = fmt.Errorf("proto: unexpected end of group")
+)
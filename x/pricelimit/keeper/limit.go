@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+
+	attestationtypes "rollinky/x/attestation/types"
+	"rollinky/x/pricelimit/types"
+)
+
+// LimitPrice returns whether price may be written for the currency pair, and
+// updates the limit state of the pair as if the price is written when it
+// may. Pairs without a limit accept every price.
+//
+// A price that changes more than max_block_change from the last written
+// price, or more than max_minute_change from the reference price, is
+// withheld. The move is accepted once confirmations more consecutive updates
+// of the pair are beyond the limits on the same side of the last written
+// price. A price within the limits drops a pending move.
+func (k Keeper) LimitPrice(ctx context.Context, cp connecttypes.CurrencyPair, price math.Int) bool {
+	limit, found := k.GetPriceLimit(ctx, cp.String())
+	if !found {
+		return true
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	state, found := k.GetPriceLimitState(ctx, cp.String())
+	if !found {
+		state = types.PriceLimitState{CurrencyPair: cp.String(), ReferencePrice: math.ZeroInt()}
+	}
+
+	// the first price of a pair has nothing to be measured against.
+	last, err := k.oracleKeeper.GetPriceForCurrencyPair(ctx, cp)
+	if err != nil {
+		k.acceptPrice(ctx, state, price)
+		return true
+	}
+
+	// the reference moves to the last written price once it is a minute old.
+	if !found || sdkCtx.BlockTime().Sub(state.ReferenceTime) >= types.MinuteWindow {
+		state.ReferencePrice = last.Price
+		state.ReferenceTime = last.BlockTimestamp
+	}
+
+	broken, reference, change := brokenLimit(limit, last.Price, state.ReferencePrice, price)
+	if broken == "" {
+		state.PendingUpdates = 0
+		state.PendingPrice = math.ZeroInt()
+		k.SetPriceLimitState(ctx, state)
+		return true
+	}
+
+	if state.PendingUpdates > 0 && price.GT(last.Price) == state.PendingPrice.GT(last.Price) {
+		state.PendingUpdates++
+	} else {
+		state.PendingUpdates = 1
+	}
+	state.PendingPrice = price
+
+	if state.PendingUpdates > limit.Confirmations {
+		attestationtypes.EmitEvent(ctx, k.Logger(), &types.EventPriceMoveConfirmed{
+			CurrencyPair:    cp.String(),
+			Price:           price,
+			WithheldUpdates: state.PendingUpdates - 1,
+		})
+		k.acceptPrice(ctx, state, price)
+		return true
+	}
+
+	state.WithheldCount++
+	state.LastWithheldHeight = sdkCtx.BlockHeight()
+	k.SetPriceLimitState(ctx, state)
+
+	k.Logger().Info(
+		"price withheld",
+		"currency_pair", cp.String(),
+		"price", price.String(),
+		"limit", broken,
+		"change", change.String(),
+	)
+	attestationtypes.EmitEvent(ctx, k.Logger(), &types.EventPriceWithheld{
+		CurrencyPair:   cp.String(),
+		Price:          price,
+		Limit:          broken,
+		ReferencePrice: reference,
+		Change:         change,
+		PendingUpdates: state.PendingUpdates,
+		Confirmations:  limit.Confirmations,
+	})
+
+	return false
+}
+
+// acceptPrice makes price the reference of the pair, with no pending move.
+// The change per minute of the next prices is measured from it.
+func (k Keeper) acceptPrice(ctx context.Context, state types.PriceLimitState, price math.Int) {
+	state.ReferencePrice = price
+	state.ReferenceTime = sdk.UnwrapSDKContext(ctx).BlockTime()
+	state.PendingUpdates = 0
+	state.PendingPrice = math.ZeroInt()
+	k.SetPriceLimitState(ctx, state)
+}
+
+// brokenLimit returns the limit price breaks, if any, with the price the
+// change is measured from and the change.
+func brokenLimit(limit types.PriceLimit, last, reference, price math.Int) (string, math.Int, math.LegacyDec) {
+	if limit.MaxBlockChange.IsPositive() {
+		if change, ok := relativeChange(last, price); ok && change.GT(limit.MaxBlockChange) {
+			return types.LimitBlock, last, change
+		}
+	}
+
+	if limit.MaxMinuteChange.IsPositive() {
+		if change, ok := relativeChange(reference, price); ok && change.GT(limit.MaxMinuteChange) {
+			return types.LimitMinute, reference, change
+		}
+	}
+
+	return "", math.Int{}, math.LegacyDec{}
+}
+
+// relativeChange returns the change from from to to, as a fraction of from.
+// A change from zero can't be measured.
+func relativeChange(from, to math.Int) (math.LegacyDec, bool) {
+	if !from.IsPositive() {
+		return math.LegacyDec{}, false
+	}
+
+	return math.LegacyNewDecFromInt(to.Sub(from).Abs()).QuoInt(from), true
+}
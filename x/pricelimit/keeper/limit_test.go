@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/x/pricelimit/keeper"
+	"rollinky/x/pricelimit/types"
+)
+
+var (
+	blockTime = time.Unix(1700000000, 0).UTC()
+	btcUSD    = connecttypes.NewCurrencyPair("BTC", "USD")
+	ethUSD    = connecttypes.NewCurrencyPair("ETH", "USD")
+)
+
+func btcLimit() types.PriceLimit {
+	return types.PriceLimit{
+		CurrencyPair:    "BTC/USD",
+		MaxBlockChange:  math.LegacyNewDecWithPrec(1, 1),
+		MaxMinuteChange: math.LegacyNewDecWithPrec(2, 1),
+		Confirmations:   2,
+	}
+}
+
+func setupLimits(t *testing.T) (keeper.Keeper, sdk.Context, *keepertest.MockOracleKeeper) {
+	t.Helper()
+
+	ok := &keepertest.MockOracleKeeper{Prices: map[connecttypes.CurrencyPair]oracletypes.QuotePrice{}}
+	k, ctx := keepertest.PricelimitKeeperWithMocks(t, ok, keepertest.MockMarketMapKeeper{
+		"BTC/USD": {Ticker: mmtypes.Ticker{CurrencyPair: btcUSD, Decimals: 8}},
+	})
+	k.SetPriceLimit(ctx, btcLimit())
+
+	return k, ctx.WithBlockHeader(cmtproto.Header{Height: 1, Time: blockTime}), ok
+}
+
+// limitPrice limits price at after seconds, and writes it to the oracle if
+// it is accepted.
+func limitPrice(
+	k keeper.Keeper,
+	ctx sdk.Context,
+	ok *keepertest.MockOracleKeeper,
+	cp connecttypes.CurrencyPair,
+	after time.Duration,
+	price int64,
+) (sdk.Context, bool) {
+	ctx = ctx.
+		WithBlockHeader(cmtproto.Header{Height: ctx.BlockHeight() + 1, Time: blockTime.Add(after)}).
+		WithEventManager(sdk.NewEventManager())
+
+	accepted := k.LimitPrice(ctx, cp, math.NewInt(price))
+	if accepted {
+		ok.Prices[cp] = oracletypes.QuotePrice{
+			Price:          math.NewInt(price),
+			BlockTimestamp: ctx.BlockTime(),
+			BlockHeight:    uint64(ctx.BlockHeight()),
+		}
+	}
+
+	return ctx, accepted
+}
+
+func typedEvent(t *testing.T, event sdk.Event) interface{} {
+	t.Helper()
+
+	msg, err := sdk.ParseTypedEvent(abci.Event(event))
+	require.NoError(t, err)
+	return msg
+}
+
+func TestLimitPrice(t *testing.T) {
+	k, ctx, ok := setupLimits(t)
+
+	for _, tc := range []struct {
+		desc     string
+		after    time.Duration
+		price    int64
+		accepted bool
+		pending  uint64
+	}{
+		{desc: "first price", after: 0, price: 100, accepted: true},
+		{desc: "within limits", after: 10 * time.Second, price: 105, accepted: true},
+		{desc: "within limits from the reference", after: 20 * time.Second, price: 115, accepted: true},
+		{desc: "beyond the minute limit", after: 30 * time.Second, price: 125, pending: 1},
+		{desc: "first confirmation", after: 40 * time.Second, price: 126, pending: 2},
+		{desc: "move confirmed", after: 50 * time.Second, price: 127, accepted: true},
+		{desc: "beyond the block limit", after: 60 * time.Second, price: 60, pending: 1},
+		{desc: "back within limits", after: 70 * time.Second, price: 128, accepted: true},
+		{desc: "beyond the block limit up", after: 80 * time.Second, price: 200, pending: 1},
+		{desc: "beyond the block limit down restarts the move", after: 90 * time.Second, price: 60, pending: 1},
+		// the reference moved to 128, 20% from it is within the limits.
+		{desc: "within limits from the moved reference", after: 3 * time.Minute, price: 140, accepted: true},
+		{desc: "within limits a minute later", after: 3*time.Minute + 10*time.Second, price: 153, accepted: true},
+	} {
+		var accepted bool
+		ctx, accepted = limitPrice(k, ctx, ok, btcUSD, tc.after, tc.price)
+		require.Equal(t, tc.accepted, accepted, tc.desc)
+
+		state, found := k.GetPriceLimitState(ctx, "BTC/USD")
+		require.True(t, found, tc.desc)
+		require.Equal(t, tc.pending, state.PendingUpdates, tc.desc)
+	}
+
+	state, _ := k.GetPriceLimitState(ctx, "BTC/USD")
+	require.Equal(t, uint64(5), state.WithheldCount)
+	require.Equal(t, int64(11), state.LastWithheldHeight)
+
+	// pairs without a limit accept every price.
+	_, accepted := limitPrice(k, ctx, ok, ethUSD, 0, 100)
+	require.True(t, accepted)
+	_, accepted = limitPrice(k, ctx, ok, ethUSD, time.Second, 1)
+	require.True(t, accepted)
+	_, found := k.GetPriceLimitState(ctx, "ETH/USD")
+	require.False(t, found)
+}
+
+func TestLimitPriceEvents(t *testing.T) {
+	k, ctx, ok := setupLimits(t)
+
+	ctx, _ = limitPrice(k, ctx, ok, btcUSD, 0, 100)
+	require.Empty(t, ctx.EventManager().Events())
+
+	ctx, _ = limitPrice(k, ctx, ok, btcUSD, time.Second, 150)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, &types.EventPriceWithheld{
+		CurrencyPair:   "BTC/USD",
+		Price:          math.NewInt(150),
+		Limit:          types.LimitBlock,
+		ReferencePrice: math.NewInt(100),
+		Change:         math.LegacyNewDecWithPrec(5, 1),
+		PendingUpdates: 1,
+		Confirmations:  2,
+	}, typedEvent(t, ctx.EventManager().Events()[0]))
+
+	ctx, _ = limitPrice(k, ctx, ok, btcUSD, 2*time.Second, 150)
+	ctx, _ = limitPrice(k, ctx, ok, btcUSD, 3*time.Second, 151)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, &types.EventPriceMoveConfirmed{
+		CurrencyPair:    "BTC/USD",
+		Price:           math.NewInt(151),
+		WithheldUpdates: 2,
+	}, typedEvent(t, ctx.EventManager().Events()[0]))
+}
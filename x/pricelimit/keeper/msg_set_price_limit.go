@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/pricelimit/types"
+)
+
+func (k msgServer) SetPriceLimit(goCtx context.Context, req *types.MsgSetPriceLimit) (*types.MsgSetPriceLimitResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if err := req.PriceLimit.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.marketMapKeeper.GetMarket(ctx, req.PriceLimit.CurrencyPair); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrCurrencyPairUnknown, "%s: %s", req.PriceLimit.CurrencyPair, err)
+	}
+
+	k.Keeper.SetPriceLimit(ctx, req.PriceLimit)
+
+	return &types.MsgSetPriceLimitResponse{}, nil
+}
+
+func (k msgServer) RemovePriceLimit(goCtx context.Context, req *types.MsgRemovePriceLimit) (*types.MsgRemovePriceLimitResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetPriceLimit(ctx, req.CurrencyPair); !found {
+		return nil, errorsmod.Wrapf(types.ErrPriceLimitNotFound, "%s", req.CurrencyPair)
+	}
+
+	// a pending move is dropped with the limit.
+	k.Keeper.RemovePriceLimit(ctx, req.CurrencyPair)
+	k.Keeper.RemovePriceLimitState(ctx, req.CurrencyPair)
+
+	return &types.MsgRemovePriceLimitResponse{}, nil
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"rollinky/x/pricelimit/keeper"
+	"rollinky/x/pricelimit/types"
+)
+
+func TestMsgSetPriceLimit(t *testing.T) {
+	k, ctx, _ := setupLimits(t)
+	ms := keeper.NewMsgServerImpl(k)
+	limit := btcLimit()
+	limit.Confirmations = 3
+
+	_, err := ms.SetPriceLimit(ctx, &types.MsgSetPriceLimit{Authority: "invalid", PriceLimit: limit})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = ms.SetPriceLimit(ctx, &types.MsgSetPriceLimit{
+		Authority: k.GetAuthority(),
+		PriceLimit: types.PriceLimit{
+			CurrencyPair:    "BTC/USD",
+			MaxBlockChange:  math.LegacyZeroDec(),
+			MaxMinuteChange: math.LegacyZeroDec(),
+			Confirmations:   1,
+		},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidPriceLimit)
+
+	unknown := limit
+	unknown.CurrencyPair = "ETH/USD"
+	_, err = ms.SetPriceLimit(ctx, &types.MsgSetPriceLimit{Authority: k.GetAuthority(), PriceLimit: unknown})
+	require.ErrorIs(t, err, types.ErrCurrencyPairUnknown)
+
+	_, err = ms.SetPriceLimit(ctx, &types.MsgSetPriceLimit{Authority: k.GetAuthority(), PriceLimit: limit})
+	require.NoError(t, err)
+	got, found := k.GetPriceLimit(ctx, "BTC/USD")
+	require.True(t, found)
+	require.Equal(t, limit, got)
+
+	k.SetPriceLimitState(ctx, types.PriceLimitState{CurrencyPair: "BTC/USD", ReferencePrice: math.NewInt(100)})
+
+	_, err = ms.RemovePriceLimit(ctx, &types.MsgRemovePriceLimit{Authority: "invalid", CurrencyPair: "BTC/USD"})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = ms.RemovePriceLimit(ctx, &types.MsgRemovePriceLimit{Authority: k.GetAuthority(), CurrencyPair: "BTC/USD"})
+	require.NoError(t, err)
+	_, found = k.GetPriceLimit(ctx, "BTC/USD")
+	require.False(t, found)
+	_, found = k.GetPriceLimitState(ctx, "BTC/USD")
+	require.False(t, found)
+
+	_, err = ms.RemovePriceLimit(ctx, &types.MsgRemovePriceLimit{Authority: k.GetAuthority(), CurrencyPair: "BTC/USD"})
+	require.ErrorIs(t, err, types.ErrPriceLimitNotFound)
+}
@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/x/pricelimit/types"
+)
+
+// The limits are set per pair with MsgSetPriceLimit (see
+// msg_set_price_limit_test.go), the params have nothing to reject.
+func TestMsgUpdateParams(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	params := types.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, params))
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	update := func(authority string, params types.Params) error {
+		_, err := ms.UpdateParams(wctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+		return err
+	}
+	get := func() types.Params { return k.GetParams(wctx) }
+
+	keepertest.CheckMsgUpdateParams(t, k.GetAuthority(), update, get, []keepertest.UpdateParamsCase[types.Params]{
+		{
+			Name:   "all good",
+			Params: params,
+		},
+	})
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"rollinky/x/pricelimit/types"
+)
+
+// SetPriceLimit set a specific priceLimit in the store from its index
+func (k Keeper) SetPriceLimit(ctx context.Context, priceLimit types.PriceLimit) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceLimitKeyPrefix))
+	b := k.cdc.MustMarshal(&priceLimit)
+	store.Set(types.PriceLimitKey(
+		priceLimit.CurrencyPair,
+	), b)
+}
+
+// GetPriceLimit returns a priceLimit from its index
+func (k Keeper) GetPriceLimit(
+	ctx context.Context,
+	currencyPair string,
+) (val types.PriceLimit, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceLimitKeyPrefix))
+
+	b := store.Get(types.PriceLimitKey(
+		currencyPair,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePriceLimit removes a priceLimit from the store
+func (k Keeper) RemovePriceLimit(
+	ctx context.Context,
+	currencyPair string,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceLimitKeyPrefix))
+	store.Delete(types.PriceLimitKey(
+		currencyPair,
+	))
+}
+
+// GetAllPriceLimit returns all priceLimit
+func (k Keeper) GetAllPriceLimit(ctx context.Context) (list []types.PriceLimit) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceLimitKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PriceLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"rollinky/x/pricelimit/types"
+)
+
+// SetPriceLimitState set a specific priceLimitState in the store from its index
+func (k Keeper) SetPriceLimitState(ctx context.Context, priceLimitState types.PriceLimitState) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceLimitStateKeyPrefix))
+	b := k.cdc.MustMarshal(&priceLimitState)
+	store.Set(types.PriceLimitStateKey(
+		priceLimitState.CurrencyPair,
+	), b)
+}
+
+// GetPriceLimitState returns a priceLimitState from its index
+func (k Keeper) GetPriceLimitState(
+	ctx context.Context,
+	currencyPair string,
+) (val types.PriceLimitState, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceLimitStateKeyPrefix))
+
+	b := store.Get(types.PriceLimitStateKey(
+		currencyPair,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePriceLimitState removes a priceLimitState from the store
+func (k Keeper) RemovePriceLimitState(
+	ctx context.Context,
+	currencyPair string,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceLimitStateKeyPrefix))
+	store.Delete(types.PriceLimitStateKey(
+		currencyPair,
+	))
+}
+
+// GetAllPriceLimitState returns all priceLimitState
+func (k Keeper) GetAllPriceLimitState(ctx context.Context) (list []types.PriceLimitState) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PriceLimitStateKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PriceLimitState
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rollinky/x/pricelimit/types"
+)
+
+func (k Keeper) PriceLimitAll(ctx context.Context, req *types.QueryAllPriceLimitRequest) (*types.QueryAllPriceLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var priceLimits []types.PriceLimit
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	priceLimitStore := prefix.NewStore(store, types.KeyPrefix(types.PriceLimitKeyPrefix))
+
+	pageRes, err := query.Paginate(priceLimitStore, req.Pagination, func(key []byte, value []byte) error {
+		var priceLimit types.PriceLimit
+		if err := k.cdc.Unmarshal(value, &priceLimit); err != nil {
+			return err
+		}
+
+		priceLimits = append(priceLimits, priceLimit)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPriceLimitResponse{PriceLimit: priceLimits, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PriceLimit(ctx context.Context, req *types.QueryGetPriceLimitRequest) (*types.QueryGetPriceLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetPriceLimit(
+		ctx,
+		req.CurrencyPair,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPriceLimitResponse{PriceLimit: val}, nil
+}
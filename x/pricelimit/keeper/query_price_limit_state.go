@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rollinky/x/pricelimit/types"
+)
+
+func (k Keeper) PriceLimitStateAll(ctx context.Context, req *types.QueryAllPriceLimitStateRequest) (*types.QueryAllPriceLimitStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var priceLimitStates []types.PriceLimitState
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	priceLimitStateStore := prefix.NewStore(store, types.KeyPrefix(types.PriceLimitStateKeyPrefix))
+
+	pageRes, err := query.Paginate(priceLimitStateStore, req.Pagination, func(key []byte, value []byte) error {
+		var priceLimitState types.PriceLimitState
+		if err := k.cdc.Unmarshal(value, &priceLimitState); err != nil {
+			return err
+		}
+
+		priceLimitStates = append(priceLimitStates, priceLimitState)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPriceLimitStateResponse{PriceLimitState: priceLimitStates, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PriceLimitState(ctx context.Context, req *types.QueryGetPriceLimitStateRequest) (*types.QueryGetPriceLimitStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetPriceLimitState(
+		ctx,
+		req.CurrencyPair,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPriceLimitStateResponse{PriceLimitState: val}, nil
+}
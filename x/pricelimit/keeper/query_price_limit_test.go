@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rollinky/x/pricelimit/types"
+)
+
+func TestPriceLimitQueries(t *testing.T) {
+	k, ctx, ok := setupLimits(t)
+	ctx, _ = limitPrice(k, ctx, ok, btcUSD, 0, 100)
+	ctx, _ = limitPrice(k, ctx, ok, btcUSD, 0, 200)
+
+	_, err := k.PriceLimit(ctx, nil)
+	require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+	_, err = k.PriceLimitAll(ctx, nil)
+	require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+	_, err = k.PriceLimitState(ctx, nil)
+	require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+	_, err = k.PriceLimitStateAll(ctx, nil)
+	require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+
+	res, err := k.PriceLimit(ctx, &types.QueryGetPriceLimitRequest{CurrencyPair: "BTC/USD"})
+	require.NoError(t, err)
+	require.Equal(t, btcLimit(), res.PriceLimit)
+
+	_, err = k.PriceLimit(ctx, &types.QueryGetPriceLimitRequest{CurrencyPair: "ETH/USD"})
+	require.Equal(t, status.Error(codes.NotFound, "not found"), err)
+
+	all, err := k.PriceLimitAll(ctx, &types.QueryAllPriceLimitRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PriceLimit{btcLimit()}, all.PriceLimit)
+
+	state, err := k.PriceLimitState(ctx, &types.QueryGetPriceLimitStateRequest{CurrencyPair: "BTC/USD"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), state.PriceLimitState.WithheldCount)
+	require.Equal(t, uint64(1), state.PriceLimitState.PendingUpdates)
+
+	_, err = k.PriceLimitState(ctx, &types.QueryGetPriceLimitStateRequest{CurrencyPair: "ETH/USD"})
+	require.Equal(t, status.Error(codes.NotFound, "not found"), err)
+
+	states, err := k.PriceLimitStateAll(ctx, &types.QueryAllPriceLimitStateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PriceLimitState{state.PriceLimitState}, states.PriceLimitState)
+}
@@ -0,0 +1,65 @@
+package pricelimit
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "rollinky/api/rollinky/pricelimit"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "PriceLimitAll",
+					Use:       "list-price-limit",
+					Short:     "List all the limits of price changes",
+				},
+				{
+					RpcMethod:      "PriceLimit",
+					Use:            "show-price-limit [currency-pair]",
+					Short:          "Shows the limits of the price changes of a currency pair",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "currency_pair"}},
+				},
+				{
+					RpcMethod: "PriceLimitStateAll",
+					Use:       "list-price-limit-state",
+					Short:     "List the pending moves and withheld prices of all limited currency pairs",
+				},
+				{
+					RpcMethod:      "PriceLimitState",
+					Use:            "show-price-limit-state [currency-pair]",
+					Short:          "Shows the pending move and withheld prices of a currency pair",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "currency_pair"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SetPriceLimit",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemovePriceLimit",
+					Skip:      true, // skipped because authority gated
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package pricelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/pricelimit/keeper"
+	"rollinky/x/pricelimit/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the priceLimit
+	for _, elem := range genState.PriceLimitList {
+		k.SetPriceLimit(ctx, elem)
+	}
+	// Set all the priceLimitState
+	for _, elem := range genState.PriceLimitStateList {
+		k.SetPriceLimitState(ctx, elem)
+	}
+	// this line is used by starport scaffolding # genesis/module/init
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	genesis.PriceLimitList = k.GetAllPriceLimit(ctx)
+	genesis.PriceLimitStateList = k.GetAllPriceLimitState(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
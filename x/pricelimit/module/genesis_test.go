@@ -0,0 +1,59 @@
+package pricelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+
+	"github.com/stretchr/testify/require"
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/testutil/nullify"
+	pricelimit "rollinky/x/pricelimit/module"
+	"rollinky/x/pricelimit/types"
+)
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+
+		PriceLimitList: []types.PriceLimit{
+			{
+				CurrencyPair:    "BTC/USD",
+				MaxBlockChange:  math.LegacyNewDecWithPrec(1, 1),
+				MaxMinuteChange: math.LegacyZeroDec(),
+				Confirmations:   2,
+			},
+			{
+				CurrencyPair:    "ETH/USD",
+				MaxBlockChange:  math.LegacyZeroDec(),
+				MaxMinuteChange: math.LegacyNewDecWithPrec(2, 1),
+				Confirmations:   1,
+			},
+		},
+		PriceLimitStateList: []types.PriceLimitState{
+			{
+				CurrencyPair:       "BTC/USD",
+				ReferencePrice:     math.NewInt(100),
+				ReferenceTime:      time.Unix(1700000000, 0).UTC(),
+				PendingPrice:       math.NewInt(150),
+				PendingUpdates:     1,
+				WithheldCount:      3,
+				LastWithheldHeight: 10,
+			},
+		},
+		// this line is used by starport scaffolding # genesis/test/state
+	}
+
+	k, ctx := keepertest.PricelimitKeeper(t)
+	pricelimit.InitGenesis(ctx, k, genesisState)
+	got := pricelimit.ExportGenesis(ctx, k)
+	require.NotNil(t, got)
+
+	nullify.Fill(&genesisState)
+	nullify.Fill(got)
+
+	require.ElementsMatch(t, genesisState.PriceLimitList, got.PriceLimitList)
+	require.ElementsMatch(t, genesisState.PriceLimitStateList, got.PriceLimitStateList)
+	// this line is used by starport scaffolding # genesis/test/assert
+}
@@ -0,0 +1,15 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/pricelimit module sentinel errors
+var (
+	ErrInvalidSigner       = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidPriceLimit   = sdkerrors.Register(ModuleName, 1101, "invalid price limit")
+	ErrPriceLimitNotFound  = sdkerrors.Register(ModuleName, 1102, "price limit not found")
+	ErrCurrencyPairUnknown = sdkerrors.Register(ModuleName, 1103, "currency pair not in the market map")
+)
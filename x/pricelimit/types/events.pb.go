@@ -0,0 +1,864 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollinky/pricelimit/events.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventPriceWithheld is emitted when a price moves beyond the limits of its
+// currency pair and isn't written.
+type EventPriceWithheld struct {
+	// currency_pair is the currency pair of the price, e.g. "BTC/USD".
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// price is the withheld price.
+	Price cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.Int" json:"price"`
+	// limit is the limit the price broke, "block" or "minute".
+	Limit string `protobuf:"bytes,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// reference_price is the price the broken limit measures the change from.
+	ReferencePrice cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=reference_price,json=referencePrice,proto3,customtype=cosmossdk.io/math.Int" json:"reference_price"`
+	// change is the change from reference_price, as a fraction.
+	Change cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=change,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"change"`
+	// pending_updates is the number of consecutive updates withheld for the
+	// move, this one included.
+	PendingUpdates uint64 `protobuf:"varint,6,opt,name=pending_updates,json=pendingUpdates,proto3" json:"pending_updates,omitempty"`
+	// confirmations is the number of updates that must confirm the move.
+	Confirmations uint64 `protobuf:"varint,7,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+}
+
+func (m *EventPriceWithheld) Reset()         { *m = EventPriceWithheld{} }
+func (m *EventPriceWithheld) String() string { return proto.CompactTextString(m) }
+func (*EventPriceWithheld) ProtoMessage()    {}
+func (*EventPriceWithheld) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67b21347907b27db, []int{0}
+}
+func (m *EventPriceWithheld) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPriceWithheld) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPriceWithheld.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPriceWithheld) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPriceWithheld.Merge(m, src)
+}
+func (m *EventPriceWithheld) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPriceWithheld) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPriceWithheld.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPriceWithheld proto.InternalMessageInfo
+
+func (m *EventPriceWithheld) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *EventPriceWithheld) GetLimit() string {
+	if m != nil {
+		return m.Limit
+	}
+	return ""
+}
+
+func (m *EventPriceWithheld) GetPendingUpdates() uint64 {
+	if m != nil {
+		return m.PendingUpdates
+	}
+	return 0
+}
+
+func (m *EventPriceWithheld) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+// EventPriceMoveConfirmed is emitted when enough updates confirmed a move
+// beyond the limits of a currency pair, and its price is written.
+type EventPriceMoveConfirmed struct {
+	// currency_pair is the currency pair of the price, e.g. "BTC/USD".
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// price is the written price.
+	Price cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.Int" json:"price"`
+	// withheld_updates is the number of updates withheld before the move was
+	// accepted.
+	WithheldUpdates uint64 `protobuf:"varint,3,opt,name=withheld_updates,json=withheldUpdates,proto3" json:"withheld_updates,omitempty"`
+}
+
+func (m *EventPriceMoveConfirmed) Reset()         { *m = EventPriceMoveConfirmed{} }
+func (m *EventPriceMoveConfirmed) String() string { return proto.CompactTextString(m) }
+func (*EventPriceMoveConfirmed) ProtoMessage()    {}
+func (*EventPriceMoveConfirmed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67b21347907b27db, []int{1}
+}
+func (m *EventPriceMoveConfirmed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPriceMoveConfirmed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPriceMoveConfirmed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPriceMoveConfirmed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPriceMoveConfirmed.Merge(m, src)
+}
+func (m *EventPriceMoveConfirmed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPriceMoveConfirmed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPriceMoveConfirmed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPriceMoveConfirmed proto.InternalMessageInfo
+
+func (m *EventPriceMoveConfirmed) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *EventPriceMoveConfirmed) GetWithheldUpdates() uint64 {
+	if m != nil {
+		return m.WithheldUpdates
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventPriceWithheld)(nil), "rollinky.pricelimit.EventPriceWithheld")
+	proto.RegisterType((*EventPriceMoveConfirmed)(nil), "rollinky.pricelimit.EventPriceMoveConfirmed")
+}
+
+func init() { proto.RegisterFile("rollinky/pricelimit/events.proto", fileDescriptor_67b21347907b27db) }
+
+var fileDescriptor_67b21347907b27db = []byte{
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xdf, 0x8a, 0xd3, 0x40,
+	0x14, 0xc6, 0x33, 0x76, 0x5b, 0x71, 0x70, 0xb7, 0x3a, 0xae, 0x18, 0x77, 0x21, 0x5b, 0x56, 0xc1,
+	0x55, 0x30, 0x11, 0x44, 0x1f, 0xa0, 0x56, 0xa1, 0xa0, 0x52, 0x0a, 0x22, 0x7a, 0x13, 0xc6, 0xc9,
+	0x69, 0x32, 0x34, 0x99, 0x09, 0x93, 0x69, 0x35, 0x6f, 0xa1, 0x6f, 0xe1, 0xa5, 0x82, 0x0f, 0xd1,
+	0xcb, 0xe2, 0x95, 0x78, 0x51, 0xa4, 0xbd, 0xf0, 0x35, 0x24, 0x33, 0xe9, 0x1f, 0xf1, 0xce, 0x8b,
+	0xbd, 0x09, 0x39, 0xdf, 0x39, 0xf3, 0xfb, 0xce, 0xe1, 0x1c, 0xdc, 0x51, 0x32, 0x4d, 0xb9, 0x18,
+	0x97, 0x41, 0xae, 0x38, 0x83, 0x94, 0x67, 0x5c, 0x07, 0x30, 0x05, 0xa1, 0x0b, 0x3f, 0x57, 0x52,
+	0x4b, 0x72, 0x6d, 0x5d, 0xe1, 0x6f, 0x2b, 0x8e, 0xae, 0xd2, 0x8c, 0x0b, 0x19, 0x98, 0xaf, 0xad,
+	0x3b, 0xba, 0xc9, 0x64, 0x91, 0xc9, 0x22, 0x34, 0x51, 0x60, 0x83, 0x3a, 0x75, 0x18, 0xcb, 0x58,
+	0x5a, 0xbd, 0xfa, 0xb3, 0xea, 0xe9, 0xa7, 0x06, 0x26, 0x4f, 0x2b, 0xa7, 0x41, 0xc5, 0x7d, 0xcd,
+	0x75, 0x92, 0x40, 0x1a, 0x91, 0x5b, 0x78, 0x9f, 0x4d, 0x94, 0x02, 0xc1, 0xca, 0x30, 0xa7, 0x5c,
+	0xb9, 0xa8, 0x83, 0xce, 0x2e, 0x0d, 0x2f, 0xaf, 0xc5, 0x01, 0xe5, 0x8a, 0x3c, 0xc3, 0x4d, 0xd3,
+	0x8d, 0x7b, 0xa1, 0x4a, 0x76, 0x1f, 0xcc, 0x16, 0x27, 0xce, 0xcf, 0xc5, 0xc9, 0x75, 0x6b, 0x5b,
+	0x44, 0x63, 0x9f, 0xcb, 0x20, 0xa3, 0x3a, 0xf1, 0xfb, 0x42, 0x7f, 0xff, 0x76, 0x1f, 0xd7, 0xfd,
+	0xf4, 0x85, 0xfe, 0xfc, 0xfb, 0xcb, 0x3d, 0x34, 0xb4, 0xcf, 0xc9, 0x21, 0x6e, 0x9a, 0x81, 0xdc,
+	0x86, 0x31, 0xb1, 0x01, 0x79, 0x83, 0xdb, 0x0a, 0x46, 0x50, 0xd9, 0x41, 0x68, 0x7d, 0xf6, 0xfe,
+	0xd3, 0xe7, 0x60, 0x03, 0x32, 0x53, 0x92, 0x97, 0xb8, 0xc5, 0x12, 0x2a, 0x62, 0x70, 0x9b, 0x86,
+	0xf8, 0xb8, 0x26, 0x1e, 0xff, 0x4b, 0x7c, 0x0e, 0x31, 0x65, 0x65, 0x0f, 0xd8, 0x0e, 0xb7, 0x07,
+	0xcc, 0x72, 0x6b, 0x0a, 0xb9, 0x83, 0xdb, 0x39, 0x88, 0x88, 0x8b, 0x38, 0x9c, 0xe4, 0x11, 0xd5,
+	0x50, 0xb8, 0xad, 0x0e, 0x3a, 0xdb, 0x1b, 0x1e, 0xd4, 0xf2, 0x2b, 0xab, 0x92, 0xdb, 0x78, 0x9f,
+	0x49, 0x31, 0xe2, 0x2a, 0xa3, 0x9a, 0x4b, 0x51, 0xb8, 0x17, 0x4d, 0xd9, 0xdf, 0xe2, 0xe9, 0x57,
+	0x84, 0x6f, 0x6c, 0x77, 0xf2, 0x42, 0x4e, 0xe1, 0x89, 0xcd, 0xc3, 0x39, 0x2f, 0xe6, 0x2e, 0xbe,
+	0xf2, 0xbe, 0xbe, 0x88, 0xcd, 0x60, 0x0d, 0xd3, 0x71, 0x7b, 0xad, 0xd7, 0x93, 0x75, 0x1f, 0xcd,
+	0x96, 0x1e, 0x9a, 0x2f, 0x3d, 0xf4, 0x6b, 0xe9, 0xa1, 0x8f, 0x2b, 0xcf, 0x99, 0xaf, 0x3c, 0xe7,
+	0xc7, 0xca, 0x73, 0xde, 0x1e, 0x6f, 0x8e, 0xfb, 0xc3, 0xee, 0x79, 0xeb, 0x32, 0x87, 0xe2, 0x5d,
+	0xcb, 0x5c, 0xe1, 0xc3, 0x3f, 0x03, 0x00, 0xa6, 0x98, 0xcd, 0xbe, 0x02, 0x03, 0x00, 0x00,
+}
+
+func (m *EventPriceWithheld) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPriceWithheld) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPriceWithheld) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Confirmations != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Confirmations))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PendingUpdates != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PendingUpdates))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Change.Size()
+		i -= size
+		if _, err := m.Change.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ReferencePrice.Size()
+		i -= size
+		if _, err := m.ReferencePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Limit) > 0 {
+		i -= len(m.Limit)
+		copy(dAtA[i:], m.Limit)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Limit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPriceMoveConfirmed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPriceMoveConfirmed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPriceMoveConfirmed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithheldUpdates != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WithheldUpdates))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventPriceWithheld) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Limit)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ReferencePrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Change.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.PendingUpdates != 0 {
+		n += 1 + sovEvents(uint64(m.PendingUpdates))
+	}
+	if m.Confirmations != 0 {
+		n += 1 + sovEvents(uint64(m.Confirmations))
+	}
+	return n
+}
+
+func (m *EventPriceMoveConfirmed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.WithheldUpdates != 0 {
+		n += 1 + sovEvents(uint64(m.WithheldUpdates))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventPriceWithheld) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPriceWithheld: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPriceWithheld: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferencePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Change.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUpdates", wireType)
+			}
+			m.PendingUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			m.Confirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirmations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPriceMoveConfirmed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPriceMoveConfirmed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPriceMoveConfirmed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithheldUpdates", wireType)
+			}
+			m.WithheldUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithheldUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	// this line is used by starport scaffolding # genesis/types/import
+)
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		PriceLimitList:      []PriceLimit{},
+		PriceLimitStateList: []PriceLimitState{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in priceLimit
+	priceLimitIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PriceLimitList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		index := string(PriceLimitKey(elem.CurrencyPair))
+		if _, ok := priceLimitIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for priceLimit")
+		}
+		priceLimitIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in priceLimitState
+	priceLimitStateIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PriceLimitStateList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		index := string(PriceLimitStateKey(elem.CurrencyPair))
+		if _, ok := priceLimitStateIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for priceLimitState")
+		}
+		priceLimitStateIndexMap[index] = struct{}{}
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
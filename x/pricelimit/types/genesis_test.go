@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+
+	"github.com/stretchr/testify/require"
+	"rollinky/x/pricelimit/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PriceLimitList: []types.PriceLimit{
+					validLimit("BTC/USD"),
+					validLimit("ETH/USD"),
+				},
+				PriceLimitStateList: []types.PriceLimitState{
+					{CurrencyPair: "BTC/USD", ReferencePrice: math.NewInt(100)},
+				},
+				// this line is used by starport scaffolding # types/genesis/validField
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated priceLimit",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PriceLimitList: []types.PriceLimit{
+					validLimit("BTC/USD"),
+					validLimit("BTC/USD"),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "priceLimit without confirmations",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PriceLimitList: []types.PriceLimit{
+					{
+						CurrencyPair:    "BTC/USD",
+						MaxBlockChange:  math.LegacyNewDecWithPrec(1, 1),
+						MaxMinuteChange: math.LegacyZeroDec(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated priceLimitState",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PriceLimitStateList: []types.PriceLimitState{
+					{CurrencyPair: "BTC/USD", ReferencePrice: math.NewInt(100)},
+					{CurrencyPair: "BTC/USD", ReferencePrice: math.NewInt(100)},
+				},
+			},
+			valid: false,
+		},
+		// this line is used by starport scaffolding # types/genesis/testcase
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func validLimit(currencyPair string) types.PriceLimit {
+	return types.PriceLimit{
+		CurrencyPair:    currencyPair,
+		MaxBlockChange:  math.LegacyNewDecWithPrec(1, 1),
+		MaxMinuteChange: math.LegacyNewDecWithPrec(2, 1),
+		Confirmations:   2,
+	}
+}
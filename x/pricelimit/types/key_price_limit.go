@@ -0,0 +1,29 @@
+package types
+
+const (
+	// PriceLimitKeyPrefix is the prefix to retrieve all PriceLimit
+	PriceLimitKeyPrefix = "PriceLimit/value/"
+
+	// PriceLimitStateKeyPrefix is the prefix to retrieve all PriceLimitState
+	PriceLimitStateKeyPrefix = "PriceLimitState/value/"
+)
+
+// PriceLimitKey returns the store key to retrieve a PriceLimit from the index fields
+func PriceLimitKey(
+	currencyPair string,
+) []byte {
+	var key []byte
+
+	currencyPairBytes := []byte(currencyPair)
+	key = append(key, currencyPairBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// PriceLimitStateKey returns the store key to retrieve a PriceLimitState from the index fields
+func PriceLimitStateKey(
+	currencyPair string,
+) []byte {
+	return PriceLimitKey(currencyPair)
+}
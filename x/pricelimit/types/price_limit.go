@@ -0,0 +1,71 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// MinuteWindow is how long the reference price the change per minute is
+// measured from is kept.
+const MinuteWindow = time.Minute
+
+// The limits a withheld price may have broken.
+const (
+	LimitBlock  = "block"
+	LimitMinute = "minute"
+)
+
+// Validate checks the currency pair is valid and at least one limit is set.
+func (pl PriceLimit) Validate() error {
+	if err := validateCurrencyPair(pl.CurrencyPair); err != nil {
+		return err
+	}
+
+	if pl.MaxBlockChange.IsNil() || pl.MaxBlockChange.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidPriceLimit, "max block change must not be negative: %s", pl.MaxBlockChange)
+	}
+	if pl.MaxMinuteChange.IsNil() || pl.MaxMinuteChange.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidPriceLimit, "max minute change must not be negative: %s", pl.MaxMinuteChange)
+	}
+	if pl.MaxBlockChange.IsZero() && pl.MaxMinuteChange.IsZero() {
+		return errorsmod.Wrap(ErrInvalidPriceLimit, "max block change or max minute change must be set")
+	}
+
+	// without confirmations, a move beyond the limits would be accepted
+	// right away.
+	if pl.Confirmations == 0 {
+		return errorsmod.Wrap(ErrInvalidPriceLimit, "confirmations must be positive")
+	}
+
+	return nil
+}
+
+// Validate checks the currency pair and prices of the state.
+func (s PriceLimitState) Validate() error {
+	if err := validateCurrencyPair(s.CurrencyPair); err != nil {
+		return err
+	}
+
+	if s.ReferencePrice.IsNil() || s.ReferencePrice.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidPriceLimit, "reference price must not be negative: %s", s.ReferencePrice)
+	}
+	if s.PendingUpdates > 0 && (s.PendingPrice.IsNil() || s.PendingPrice.IsNegative()) {
+		return errorsmod.Wrapf(ErrInvalidPriceLimit, "pending price must not be negative: %s", s.PendingPrice)
+	}
+
+	return nil
+}
+
+func validateCurrencyPair(pair string) error {
+	cp, err := connecttypes.CurrencyPairFromString(pair)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidPriceLimit, "invalid currency pair %q: %s", pair, err)
+	}
+	if cp.String() != pair {
+		return errorsmod.Wrapf(ErrInvalidPriceLimit, "currency pair %q must be written as %q", pair, cp.String())
+	}
+
+	return nil
+}
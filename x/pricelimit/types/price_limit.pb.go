@@ -0,0 +1,919 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollinky/pricelimit/price_limit.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PriceLimit bounds how far the price of a currency pair may move before the
+// move is withheld until further updates confirm it.
+type PriceLimit struct {
+	// currency_pair is the currency pair of the oracle the limit applies to,
+	// e.g. "BTC/USD".
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// max_block_change is the largest change from the last written price a
+	// price may make, as a fraction (e.g. 0.05 for 5%). Zero disables it.
+	MaxBlockChange cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_block_change,json=maxBlockChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_block_change"`
+	// max_minute_change is the largest change a price may make from the price
+	// written about a minute before, as a fraction. Zero disables it.
+	MaxMinuteChange cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_minute_change,json=maxMinuteChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_minute_change"`
+	// confirmations is the number of consecutive updates beyond the limits, in
+	// the same direction, that must follow a withheld price before the move is
+	// accepted.
+	Confirmations uint64 `protobuf:"varint,4,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+}
+
+func (m *PriceLimit) Reset()         { *m = PriceLimit{} }
+func (m *PriceLimit) String() string { return proto.CompactTextString(m) }
+func (*PriceLimit) ProtoMessage()    {}
+func (*PriceLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8413d9ed5cf03f, []int{0}
+}
+func (m *PriceLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceLimit.Merge(m, src)
+}
+func (m *PriceLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceLimit proto.InternalMessageInfo
+
+func (m *PriceLimit) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *PriceLimit) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+// PriceLimitState is what the limit of a currency pair measures prices
+// against.
+type PriceLimitState struct {
+	// currency_pair is the currency pair of the oracle, e.g. "BTC/USD".
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// reference_price is the written price the change per minute is measured
+	// from. It moves to the last written price once it is a minute old.
+	ReferencePrice cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=reference_price,json=referencePrice,proto3,customtype=cosmossdk.io/math.Int" json:"reference_price"`
+	// reference_time is the block time reference_price was written at.
+	ReferenceTime time.Time `protobuf:"bytes,3,opt,name=reference_time,json=referenceTime,proto3,stdtime" json:"reference_time"`
+	// pending_price is the latest withheld price of the move waiting for
+	// confirmations, if pending_updates is not zero.
+	PendingPrice cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=pending_price,json=pendingPrice,proto3,customtype=cosmossdk.io/math.Int" json:"pending_price"`
+	// pending_updates is the number of consecutive updates withheld for the
+	// move, zero if no move is pending.
+	PendingUpdates uint64 `protobuf:"varint,5,opt,name=pending_updates,json=pendingUpdates,proto3" json:"pending_updates,omitempty"`
+	// withheld_count is the number of prices withheld for the currency pair.
+	WithheldCount uint64 `protobuf:"varint,6,opt,name=withheld_count,json=withheldCount,proto3" json:"withheld_count,omitempty"`
+	// last_withheld_height is the height of the last price withheld.
+	LastWithheldHeight int64 `protobuf:"varint,7,opt,name=last_withheld_height,json=lastWithheldHeight,proto3" json:"last_withheld_height,omitempty"`
+}
+
+func (m *PriceLimitState) Reset()         { *m = PriceLimitState{} }
+func (m *PriceLimitState) String() string { return proto.CompactTextString(m) }
+func (*PriceLimitState) ProtoMessage()    {}
+func (*PriceLimitState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8413d9ed5cf03f, []int{1}
+}
+func (m *PriceLimitState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceLimitState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceLimitState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceLimitState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceLimitState.Merge(m, src)
+}
+func (m *PriceLimitState) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceLimitState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceLimitState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceLimitState proto.InternalMessageInfo
+
+func (m *PriceLimitState) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *PriceLimitState) GetReferenceTime() time.Time {
+	if m != nil {
+		return m.ReferenceTime
+	}
+	return time.Time{}
+}
+
+func (m *PriceLimitState) GetPendingUpdates() uint64 {
+	if m != nil {
+		return m.PendingUpdates
+	}
+	return 0
+}
+
+func (m *PriceLimitState) GetWithheldCount() uint64 {
+	if m != nil {
+		return m.WithheldCount
+	}
+	return 0
+}
+
+func (m *PriceLimitState) GetLastWithheldHeight() int64 {
+	if m != nil {
+		return m.LastWithheldHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PriceLimit)(nil), "rollinky.pricelimit.PriceLimit")
+	proto.RegisterType((*PriceLimitState)(nil), "rollinky.pricelimit.PriceLimitState")
+}
+
+func init() {
+	proto.RegisterFile("rollinky/pricelimit/price_limit.proto", fileDescriptor_ac8413d9ed5cf03f)
+}
+
+var fileDescriptor_ac8413d9ed5cf03f = []byte{
+	// 521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x9b, 0x7c, 0xfd, 0x60, 0x68, 0x12, 0x6a, 0x8a, 0x64, 0x52, 0xc9, 0x89, 0x0a, 0x15,
+	0x11, 0x12, 0x76, 0x05, 0x82, 0x07, 0x48, 0xbb, 0xa0, 0xa2, 0x48, 0x95, 0xa1, 0x42, 0xb0, 0x31,
+	0x93, 0xc9, 0xc4, 0x1e, 0xc5, 0x33, 0x63, 0x8d, 0xc7, 0x22, 0x79, 0x8b, 0xbe, 0x00, 0x7b, 0x96,
+	0x2c, 0x90, 0x78, 0x85, 0x2e, 0x2b, 0x56, 0x88, 0x45, 0x41, 0xc9, 0x82, 0xd7, 0x40, 0xf3, 0xe3,
+	0xa4, 0x88, 0x0d, 0x82, 0x8d, 0x35, 0xf7, 0xdc, 0xeb, 0x7b, 0xce, 0x9c, 0xa3, 0x01, 0xbb, 0x82,
+	0x67, 0x19, 0x61, 0x93, 0x59, 0x98, 0x0b, 0x82, 0x70, 0x46, 0x28, 0x91, 0xe6, 0x18, 0xeb, 0x73,
+	0x90, 0x0b, 0x2e, 0xb9, 0x7b, 0xa3, 0x1a, 0x0b, 0x56, 0x63, 0x9d, 0x4d, 0x48, 0x09, 0xe3, 0xa1,
+	0xfe, 0x9a, 0xb9, 0xce, 0x2d, 0xc4, 0x0b, 0xca, 0x8b, 0x58, 0x57, 0xa1, 0x29, 0x6c, 0x6b, 0x2b,
+	0xe1, 0x09, 0x37, 0xb8, 0x3a, 0x59, 0xb4, 0x9b, 0x70, 0x9e, 0x64, 0x38, 0xd4, 0xd5, 0xb0, 0x1c,
+	0x87, 0x92, 0x50, 0x5c, 0x48, 0x48, 0x73, 0x33, 0xb0, 0xf3, 0x6e, 0x0d, 0x80, 0x63, 0xc5, 0x79,
+	0xa4, 0x38, 0xdd, 0xdb, 0xa0, 0x89, 0x4a, 0x21, 0x30, 0x43, 0xb3, 0x38, 0x87, 0x44, 0x78, 0x4e,
+	0xcf, 0xe9, 0x5f, 0x8d, 0x36, 0x2a, 0xf0, 0x18, 0x12, 0xe1, 0xbe, 0x01, 0xd7, 0x29, 0x9c, 0xc6,
+	0xc3, 0x8c, 0xa3, 0x49, 0x8c, 0x52, 0xc8, 0x12, 0xec, 0xad, 0xa9, 0xb9, 0xc1, 0xe3, 0xb3, 0x8b,
+	0x6e, 0xed, 0xeb, 0x45, 0x77, 0xdb, 0x48, 0x2b, 0x46, 0x93, 0x80, 0xf0, 0x90, 0x42, 0x99, 0x06,
+	0x47, 0x38, 0x81, 0x68, 0x76, 0x80, 0xd1, 0xe7, 0x8f, 0xf7, 0x81, 0x55, 0x7e, 0x80, 0xd1, 0xfb,
+	0x1f, 0x1f, 0xee, 0x39, 0x51, 0x8b, 0xc2, 0xe9, 0x40, 0xad, 0xdb, 0xd7, 0xdb, 0xdc, 0x21, 0xd8,
+	0x54, 0x0c, 0x94, 0xb0, 0x52, 0xe2, 0x8a, 0xa2, 0xfe, 0x4f, 0x14, 0x6d, 0x0a, 0xa7, 0xcf, 0xf4,
+	0x3e, 0xcb, 0x71, 0x07, 0x34, 0x11, 0x67, 0x63, 0x22, 0x28, 0x94, 0x84, 0xb3, 0xc2, 0x6b, 0xf4,
+	0x9c, 0x7e, 0x23, 0xfa, 0x15, 0xdc, 0xf9, 0x54, 0x07, 0xed, 0x95, 0x3f, 0xcf, 0x25, 0x94, 0xf8,
+	0xcf, 0x4c, 0x7a, 0x05, 0xda, 0x02, 0x8f, 0xb1, 0x02, 0x70, 0xac, 0x53, 0xb5, 0x1e, 0xed, 0xd9,
+	0x0b, 0xdc, 0xfc, 0xfd, 0x02, 0x87, 0x4c, 0x5e, 0x92, 0x7e, 0xc8, 0xa4, 0x75, 0x67, 0xb9, 0x48,
+	0x2b, 0x71, 0x9f, 0x82, 0x15, 0x12, 0xab, 0x40, 0xb5, 0x35, 0xd7, 0x1e, 0x74, 0x02, 0x93, 0x76,
+	0x50, 0xa5, 0x1d, 0xbc, 0xa8, 0xd2, 0x1e, 0x5c, 0x51, 0xac, 0xa7, 0xdf, 0xba, 0x4e, 0xd4, 0x5c,
+	0xfe, 0xab, 0xba, 0xee, 0x09, 0x68, 0xe6, 0x98, 0x8d, 0x08, 0x4b, 0xac, 0xca, 0xc6, 0x5f, 0xaa,
+	0xdc, 0xb0, 0x6b, 0x8c, 0xc6, 0xbb, 0xa0, 0x5d, 0xad, 0x2d, 0xf3, 0x11, 0x94, 0xb8, 0xf0, 0xfe,
+	0xd3, 0xfe, 0xb6, 0x2c, 0x7c, 0x62, 0x50, 0x77, 0x17, 0xb4, 0xde, 0x12, 0x99, 0xa6, 0x38, 0x1b,
+	0xc5, 0x88, 0x97, 0x4c, 0x7a, 0xeb, 0x26, 0x87, 0x0a, 0xdd, 0x57, 0xa0, 0xbb, 0x07, 0xb6, 0x32,
+	0x58, 0xc8, 0x78, 0x39, 0x9b, 0x62, 0x92, 0xa4, 0xd2, 0xfb, 0xbf, 0xe7, 0xf4, 0xeb, 0x91, 0xab,
+	0x7a, 0x2f, 0x6d, 0xeb, 0x89, 0xee, 0x0c, 0x1e, 0x9d, 0xcd, 0x7d, 0xe7, 0x7c, 0xee, 0x3b, 0xdf,
+	0xe7, 0xbe, 0x73, 0xba, 0xf0, 0x6b, 0xe7, 0x0b, 0xbf, 0xf6, 0x65, 0xe1, 0xd7, 0x5e, 0x6f, 0x2f,
+	0x1f, 0xe5, 0xf4, 0xf2, 0xb3, 0x94, 0xb3, 0x1c, 0x17, 0xc3, 0x75, 0x6d, 0xde, 0xc3, 0x9f, 0x03,
+	0x00, 0x5e, 0xcb, 0xf5, 0x52, 0xba, 0x03, 0x00, 0x00,
+}
+
+func (m *PriceLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Confirmations != 0 {
+		i = encodeVarintPriceLimit(dAtA, i, uint64(m.Confirmations))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MaxMinuteChange.Size()
+		i -= size
+		if _, err := m.MaxMinuteChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPriceLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxBlockChange.Size()
+		i -= size
+		if _, err := m.MaxBlockChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPriceLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintPriceLimit(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceLimitState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceLimitState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceLimitState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastWithheldHeight != 0 {
+		i = encodeVarintPriceLimit(dAtA, i, uint64(m.LastWithheldHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.WithheldCount != 0 {
+		i = encodeVarintPriceLimit(dAtA, i, uint64(m.WithheldCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PendingUpdates != 0 {
+		i = encodeVarintPriceLimit(dAtA, i, uint64(m.PendingUpdates))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.PendingPrice.Size()
+		i -= size
+		if _, err := m.PendingPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPriceLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReferenceTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReferenceTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPriceLimit(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ReferencePrice.Size()
+		i -= size
+		if _, err := m.ReferencePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPriceLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintPriceLimit(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPriceLimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPriceLimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PriceLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovPriceLimit(uint64(l))
+	}
+	l = m.MaxBlockChange.Size()
+	n += 1 + l + sovPriceLimit(uint64(l))
+	l = m.MaxMinuteChange.Size()
+	n += 1 + l + sovPriceLimit(uint64(l))
+	if m.Confirmations != 0 {
+		n += 1 + sovPriceLimit(uint64(m.Confirmations))
+	}
+	return n
+}
+
+func (m *PriceLimitState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovPriceLimit(uint64(l))
+	}
+	l = m.ReferencePrice.Size()
+	n += 1 + l + sovPriceLimit(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReferenceTime)
+	n += 1 + l + sovPriceLimit(uint64(l))
+	l = m.PendingPrice.Size()
+	n += 1 + l + sovPriceLimit(uint64(l))
+	if m.PendingUpdates != 0 {
+		n += 1 + sovPriceLimit(uint64(m.PendingUpdates))
+	}
+	if m.WithheldCount != 0 {
+		n += 1 + sovPriceLimit(uint64(m.WithheldCount))
+	}
+	if m.LastWithheldHeight != 0 {
+		n += 1 + sovPriceLimit(uint64(m.LastWithheldHeight))
+	}
+	return n
+}
+
+func sovPriceLimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPriceLimit(x uint64) (n int) {
+	return sovPriceLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PriceLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBlockChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMinuteChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMinuteChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			m.Confirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirmations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceLimitState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceLimitState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceLimitState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferencePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReferenceTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUpdates", wireType)
+			}
+			m.PendingUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithheldCount", wireType)
+			}
+			m.WithheldCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithheldCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWithheldHeight", wireType)
+			}
+			m.LastWithheldHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastWithheldHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPriceLimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPriceLimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPriceLimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPriceLimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPriceLimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPriceLimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPriceLimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPriceLimit = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,2238 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollinky/pricelimit/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_393fd6314d9c4db6, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_393fd6314d9c4db6, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryGetPriceLimitRequest struct {
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+}
+
+func (m *QueryGetPriceLimitRequest) Reset()         { *m = QueryGetPriceLimitRequest{} }
+func (m *QueryGetPriceLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPriceLimitRequest) ProtoMessage()    {}
+func (*QueryGetPriceLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_393fd6314d9c4db6, []int{2}
+}
+func (m *QueryGetPriceLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPriceLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPriceLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPriceLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPriceLimitRequest.Merge(m, src)
+}
+func (m *QueryGetPriceLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPriceLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPriceLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPriceLimitRequest proto.InternalMessageInfo
+
+func (m *QueryGetPriceLimitRequest) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+type QueryGetPriceLimitResponse struct {
+	PriceLimit PriceLimit `protobuf:"bytes,1,opt,name=price_limit,json=priceLimit,proto3" json:"price_limit"`
+}
+
+func (m *QueryGetPriceLimitResponse) Reset()         { *m = QueryGetPriceLimitResponse{} }
+func (m *QueryGetPriceLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPriceLimitResponse) ProtoMessage()    {}
+func (*QueryGetPriceLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_393fd6314d9c4db6, []int{3}
+}
+func (m *QueryGetPriceLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPriceLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPriceLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPriceLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPriceLimitResponse.Merge(m, src)
+}
+func (m *QueryGetPriceLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPriceLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPriceLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPriceLimitResponse proto.InternalMessageInfo
+
+func (m *QueryGetPriceLimitResponse) GetPriceLimit() PriceLimit {
+	if m != nil {
+		return m.PriceLimit
+	}
+	return PriceLimit{}
+}
+
+type QueryAllPriceLimitRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPriceLimitRequest) Reset()         { *m = QueryAllPriceLimitRequest{} }
+func (m *QueryAllPriceLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPriceLimitRequest) ProtoMessage()    {}
+func (*QueryAllPriceLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_393fd6314d9c4db6, []int{4}
+}
+func (m *QueryAllPriceLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPriceLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPriceLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPriceLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPriceLimitRequest.Merge(m, src)
+}
+func (m *QueryAllPriceLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPriceLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPriceLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPriceLimitRequest proto.InternalMessageInfo
+
+func (m *QueryAllPriceLimitRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllPriceLimitResponse struct {
+	PriceLimit []PriceLimit        `protobuf:"bytes,1,rep,name=price_limit,json=priceLimit,proto3" json:"price_limit"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPriceLimitResponse) Reset()         { *m = QueryAllPriceLimitResponse{} }
+func (m *QueryAllPriceLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPriceLimitResponse) ProtoMessage()    {}
+func (*QueryAllPriceLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_393fd6314d9c4db6, []int{5}
+}
+func (m *QueryAllPriceLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPriceLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPriceLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPriceLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPriceLimitResponse.Merge(m, src)
+}
+func (m *QueryAllPriceLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPriceLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPriceLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPriceLimitResponse proto.InternalMessageInfo
+
+func (m *QueryAllPriceLimitResponse) GetPriceLimit() []PriceLimit {
+	if m != nil {
+		return m.PriceLimit
+	}
+	return nil
+}
+
+func (m *QueryAllPriceLimitResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetPriceLimitStateRequest struct {
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+}
+
+func (m *QueryGetPriceLimitStateRequest) Reset()         { *m = QueryGetPriceLimitStateRequest{} }
+func (m *QueryGetPriceLimitStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPriceLimitStateRequest) ProtoMessage()    {}
+func (*QueryGetPriceLimitStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_393fd6314d9c4db6, []int{6}
+}
+func (m *QueryGetPriceLimitStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPriceLimitStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPriceLimitStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPriceLimitStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPriceLimitStateRequest.Merge(m, src)
+}
+func (m *QueryGetPriceLimitStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPriceLimitStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPriceLimitStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPriceLimitStateRequest proto.InternalMessageInfo
+
+func (m *QueryGetPriceLimitStateRequest) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+type QueryGetPriceLimitStateResponse struct {
+	PriceLimitState PriceLimitState `protobuf:"bytes,1,opt,name=price_limit_state,json=priceLimitState,proto3" json:"price_limit_state"`
+}
+
+func (m *QueryGetPriceLimitStateResponse) Reset()         { *m = QueryGetPriceLimitStateResponse{} }
+func (m *QueryGetPriceLimitStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPriceLimitStateResponse) ProtoMessage()    {}
+func (*QueryGetPriceLimitStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_393fd6314d9c4db6, []int{7}
+}
+func (m *QueryGetPriceLimitStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPriceLimitStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPriceLimitStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPriceLimitStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPriceLimitStateResponse.Merge(m, src)
+}
+func (m *QueryGetPriceLimitStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPriceLimitStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPriceLimitStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPriceLimitStateResponse proto.InternalMessageInfo
+
+func (m *QueryGetPriceLimitStateResponse) GetPriceLimitState() PriceLimitState {
+	if m != nil {
+		return m.PriceLimitState
+	}
+	return PriceLimitState{}
+}
+
+type QueryAllPriceLimitStateRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPriceLimitStateRequest) Reset()         { *m = QueryAllPriceLimitStateRequest{} }
+func (m *QueryAllPriceLimitStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPriceLimitStateRequest) ProtoMessage()    {}
+func (*QueryAllPriceLimitStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_393fd6314d9c4db6, []int{8}
+}
+func (m *QueryAllPriceLimitStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPriceLimitStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPriceLimitStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPriceLimitStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPriceLimitStateRequest.Merge(m, src)
+}
+func (m *QueryAllPriceLimitStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPriceLimitStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPriceLimitStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPriceLimitStateRequest proto.InternalMessageInfo
+
+func (m *QueryAllPriceLimitStateRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllPriceLimitStateResponse struct {
+	PriceLimitState []PriceLimitState   `protobuf:"bytes,1,rep,name=price_limit_state,json=priceLimitState,proto3" json:"price_limit_state"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPriceLimitStateResponse) Reset()         { *m = QueryAllPriceLimitStateResponse{} }
+func (m *QueryAllPriceLimitStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPriceLimitStateResponse) ProtoMessage()    {}
+func (*QueryAllPriceLimitStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_393fd6314d9c4db6, []int{9}
+}
+func (m *QueryAllPriceLimitStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPriceLimitStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPriceLimitStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPriceLimitStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPriceLimitStateResponse.Merge(m, src)
+}
+func (m *QueryAllPriceLimitStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPriceLimitStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPriceLimitStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPriceLimitStateResponse proto.InternalMessageInfo
+
+func (m *QueryAllPriceLimitStateResponse) GetPriceLimitState() []PriceLimitState {
+	if m != nil {
+		return m.PriceLimitState
+	}
+	return nil
+}
+
+func (m *QueryAllPriceLimitStateResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "rollinky.pricelimit.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "rollinky.pricelimit.QueryParamsResponse")
+	proto.RegisterType((*QueryGetPriceLimitRequest)(nil), "rollinky.pricelimit.QueryGetPriceLimitRequest")
+	proto.RegisterType((*QueryGetPriceLimitResponse)(nil), "rollinky.pricelimit.QueryGetPriceLimitResponse")
+	proto.RegisterType((*QueryAllPriceLimitRequest)(nil), "rollinky.pricelimit.QueryAllPriceLimitRequest")
+	proto.RegisterType((*QueryAllPriceLimitResponse)(nil), "rollinky.pricelimit.QueryAllPriceLimitResponse")
+	proto.RegisterType((*QueryGetPriceLimitStateRequest)(nil), "rollinky.pricelimit.QueryGetPriceLimitStateRequest")
+	proto.RegisterType((*QueryGetPriceLimitStateResponse)(nil), "rollinky.pricelimit.QueryGetPriceLimitStateResponse")
+	proto.RegisterType((*QueryAllPriceLimitStateRequest)(nil), "rollinky.pricelimit.QueryAllPriceLimitStateRequest")
+	proto.RegisterType((*QueryAllPriceLimitStateResponse)(nil), "rollinky.pricelimit.QueryAllPriceLimitStateResponse")
+}
+
+func init() { proto.RegisterFile("rollinky/pricelimit/query.proto", fileDescriptor_393fd6314d9c4db6) }
+
+var fileDescriptor_393fd6314d9c4db6 = []byte{
+	// 636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0x2d, 0x44, 0xea, 0x2b, 0x55, 0xd5, 0x6b, 0x07, 0x70, 0xc0, 0x09, 0x0e, 0x34,
+	0x69, 0x07, 0x9f, 0xda, 0xc0, 0x8a, 0x68, 0x24, 0xda, 0x85, 0x21, 0x04, 0xc1, 0xc0, 0x12, 0x5d,
+	0xc2, 0xc9, 0x58, 0x38, 0x3e, 0xd7, 0x76, 0x10, 0xd9, 0x10, 0x7f, 0x01, 0x12, 0x03, 0x03, 0x2b,
+	0x03, 0x03, 0x48, 0xfd, 0x1b, 0x98, 0x3a, 0x56, 0x62, 0x61, 0x42, 0x28, 0x41, 0xe2, 0xdf, 0x40,
+	0xbe, 0xbb, 0xfc, 0x6a, 0xec, 0xda, 0x85, 0x2e, 0xd1, 0xe9, 0xfc, 0x7d, 0xef, 0x7d, 0xde, 0xf7,
+	0xdd, 0x5d, 0xa0, 0xe8, 0x73, 0xc7, 0xb1, 0xdd, 0x97, 0x7d, 0xe2, 0xf9, 0x76, 0x87, 0x39, 0x76,
+	0xd7, 0x0e, 0xc9, 0x61, 0x8f, 0xf9, 0x7d, 0xd3, 0xf3, 0x79, 0xc8, 0xf1, 0xfa, 0x48, 0x60, 0x4e,
+	0x04, 0xda, 0x1a, 0xed, 0xda, 0x2e, 0x27, 0xe2, 0x57, 0xea, 0xb4, 0x0d, 0x8b, 0x5b, 0x5c, 0x2c,
+	0x49, 0xb4, 0x52, 0xbb, 0xd7, 0x2d, 0xce, 0x2d, 0x87, 0x11, 0xea, 0xd9, 0x84, 0xba, 0x2e, 0x0f,
+	0x69, 0x68, 0x73, 0x37, 0x50, 0x5f, 0xb7, 0x3b, 0x3c, 0xe8, 0xf2, 0x80, 0xb4, 0x69, 0xc0, 0x64,
+	0x51, 0xf2, 0x6a, 0xa7, 0xcd, 0x42, 0xba, 0x43, 0x3c, 0x6a, 0xd9, 0xae, 0x10, 0x2b, 0x6d, 0x29,
+	0x0e, 0xd4, 0xa3, 0x3e, 0xed, 0x8e, 0xb2, 0xdd, 0x8e, 0x55, 0x44, 0xcb, 0x96, 0x58, 0x4b, 0x99,
+	0xb1, 0x01, 0xf8, 0x51, 0x54, 0xaa, 0x21, 0x62, 0x9b, 0xec, 0xb0, 0xc7, 0x82, 0xd0, 0x78, 0x02,
+	0xeb, 0x33, 0xbb, 0x81, 0xc7, 0xdd, 0x80, 0xe1, 0x7b, 0x90, 0x97, 0x35, 0xae, 0xa2, 0x12, 0xaa,
+	0x2e, 0xef, 0x16, 0xcc, 0x18, 0x3b, 0x4c, 0x19, 0x54, 0x5f, 0x3a, 0xfe, 0x59, 0xcc, 0x7d, 0xfe,
+	0x73, 0xb4, 0x8d, 0x9a, 0x2a, 0xca, 0xb8, 0x0f, 0xd7, 0x44, 0xda, 0x03, 0x16, 0x36, 0x22, 0xfd,
+	0xc3, 0x48, 0xaf, 0x6a, 0xe2, 0x32, 0xac, 0x74, 0x7a, 0xbe, 0xcf, 0xdc, 0x4e, 0xbf, 0xe5, 0x51,
+	0xdb, 0x17, 0x35, 0x96, 0x9a, 0x57, 0x46, 0x9b, 0x0d, 0x6a, 0xfb, 0xc6, 0x73, 0xd0, 0xe2, 0x32,
+	0x28, 0xbe, 0x7d, 0x58, 0x9e, 0xea, 0x50, 0x41, 0x16, 0xe3, 0x21, 0xc7, 0xd1, 0xf5, 0x4b, 0x11,
+	0x68, 0x13, 0xbc, 0xf1, 0x8e, 0xd1, 0x51, 0x9c, 0x7b, 0x8e, 0x33, 0xcf, 0xb9, 0x0f, 0x30, 0x19,
+	0x87, 0xaa, 0xb1, 0x69, 0xca, 0xd9, 0x99, 0xd1, 0xec, 0x4c, 0x79, 0x60, 0xd4, 0xec, 0xcc, 0x06,
+	0xb5, 0x98, 0x8a, 0x6d, 0x4e, 0x45, 0x1a, 0x5f, 0x11, 0x68, 0x71, 0x55, 0x92, 0x7a, 0x59, 0xfc,
+	0xa7, 0x5e, 0xf0, 0xc1, 0x0c, 0xee, 0x82, 0xc0, 0xad, 0xa4, 0xe2, 0x4a, 0x88, 0x19, 0xde, 0x07,
+	0xa0, 0xcf, 0x5b, 0xff, 0x38, 0xa4, 0x21, 0x3b, 0xd7, 0x04, 0xfb, 0x50, 0x4c, 0x4c, 0xa3, 0x5a,
+	0x7f, 0x0a, 0x6b, 0x53, 0xad, 0xb7, 0x82, 0xe8, 0xa3, 0x32, 0xfa, 0x56, 0x8a, 0x01, 0x22, 0x91,
+	0x72, 0x61, 0xd5, 0x9b, 0xdd, 0x36, 0x5e, 0x80, 0x3e, 0x6f, 0xf8, 0x4c, 0x07, 0x17, 0x35, 0xdb,
+	0x6f, 0x08, 0x8a, 0x89, 0xa5, 0xce, 0xee, 0x72, 0xf1, 0x3f, 0xbb, 0xbc, 0xb0, 0x81, 0xef, 0x7e,
+	0xca, 0xc3, 0x65, 0xd1, 0x04, 0x7e, 0x83, 0x20, 0x2f, 0x6f, 0x35, 0xae, 0xc4, 0xa2, 0xcd, 0x3f,
+	0x21, 0x5a, 0x35, 0x5d, 0x28, 0x6b, 0x1a, 0xe5, 0xb7, 0xdf, 0x7f, 0xbf, 0x5f, 0xb8, 0x81, 0x0b,
+	0x24, 0xf9, 0x51, 0xc3, 0x1f, 0x10, 0xc0, 0xc4, 0x00, 0x6c, 0x26, 0x67, 0x8f, 0x7b, 0x5c, 0x34,
+	0x92, 0x59, 0xaf, 0xa0, 0xaa, 0x02, 0xca, 0xc0, 0x25, 0x92, 0xf2, 0x8e, 0xe2, 0x8f, 0x08, 0x56,
+	0x26, 0x09, 0xf6, 0x1c, 0xe7, 0x2c, 0xb8, 0xb8, 0x17, 0x45, 0x23, 0x99, 0xf5, 0x0a, 0x6e, 0x4b,
+	0xc0, 0x95, 0xf1, 0xcd, 0x34, 0xb8, 0x00, 0x7f, 0x41, 0xb0, 0x7a, 0xea, 0xe0, 0xe0, 0x5a, 0x46,
+	0x33, 0xa6, 0xaf, 0x86, 0x76, 0xe7, 0x7c, 0x41, 0x8a, 0xd4, 0x14, 0xa4, 0x55, 0xbc, 0x99, 0x46,
+	0x2a, 0xcf, 0x3f, 0x3e, 0x42, 0x80, 0x4f, 0xe5, 0x8a, 0x1c, 0xad, 0x65, 0x74, 0x28, 0x2b, 0x71,
+	0xf2, 0xb5, 0x34, 0x88, 0x20, 0xde, 0xc2, 0x95, 0x6c, 0xc4, 0x41, 0xfd, 0xee, 0xf1, 0x40, 0x47,
+	0x27, 0x03, 0x1d, 0xfd, 0x1a, 0xe8, 0xe8, 0xdd, 0x50, 0xcf, 0x9d, 0x0c, 0xf5, 0xdc, 0x8f, 0xa1,
+	0x9e, 0x7b, 0x56, 0x18, 0x67, 0x78, 0x3d, 0x9d, 0x23, 0xec, 0x7b, 0x2c, 0x68, 0xe7, 0xc5, 0xff,
+	0x6f, 0xed, 0xef, 0x00, 0xc5, 0xf6, 0x34, 0xb7, 0x73, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a PriceLimit by currency pair.
+	PriceLimit(ctx context.Context, in *QueryGetPriceLimitRequest, opts ...grpc.CallOption) (*QueryGetPriceLimitResponse, error)
+	// Queries a list of PriceLimit items.
+	PriceLimitAll(ctx context.Context, in *QueryAllPriceLimitRequest, opts ...grpc.CallOption) (*QueryAllPriceLimitResponse, error)
+	// Queries a PriceLimitState by currency pair, with the withheld prices of
+	// the pair.
+	PriceLimitState(ctx context.Context, in *QueryGetPriceLimitStateRequest, opts ...grpc.CallOption) (*QueryGetPriceLimitStateResponse, error)
+	// Queries a list of PriceLimitState items.
+	PriceLimitStateAll(ctx context.Context, in *QueryAllPriceLimitStateRequest, opts ...grpc.CallOption) (*QueryAllPriceLimitStateResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/rollinky.pricelimit.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceLimit(ctx context.Context, in *QueryGetPriceLimitRequest, opts ...grpc.CallOption) (*QueryGetPriceLimitResponse, error) {
+	out := new(QueryGetPriceLimitResponse)
+	err := c.cc.Invoke(ctx, "/rollinky.pricelimit.Query/PriceLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceLimitAll(ctx context.Context, in *QueryAllPriceLimitRequest, opts ...grpc.CallOption) (*QueryAllPriceLimitResponse, error) {
+	out := new(QueryAllPriceLimitResponse)
+	err := c.cc.Invoke(ctx, "/rollinky.pricelimit.Query/PriceLimitAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceLimitState(ctx context.Context, in *QueryGetPriceLimitStateRequest, opts ...grpc.CallOption) (*QueryGetPriceLimitStateResponse, error) {
+	out := new(QueryGetPriceLimitStateResponse)
+	err := c.cc.Invoke(ctx, "/rollinky.pricelimit.Query/PriceLimitState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceLimitStateAll(ctx context.Context, in *QueryAllPriceLimitStateRequest, opts ...grpc.CallOption) (*QueryAllPriceLimitStateResponse, error) {
+	out := new(QueryAllPriceLimitStateResponse)
+	err := c.cc.Invoke(ctx, "/rollinky.pricelimit.Query/PriceLimitStateAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a PriceLimit by currency pair.
+	PriceLimit(context.Context, *QueryGetPriceLimitRequest) (*QueryGetPriceLimitResponse, error)
+	// Queries a list of PriceLimit items.
+	PriceLimitAll(context.Context, *QueryAllPriceLimitRequest) (*QueryAllPriceLimitResponse, error)
+	// Queries a PriceLimitState by currency pair, with the withheld prices of
+	// the pair.
+	PriceLimitState(context.Context, *QueryGetPriceLimitStateRequest) (*QueryGetPriceLimitStateResponse, error)
+	// Queries a list of PriceLimitState items.
+	PriceLimitStateAll(context.Context, *QueryAllPriceLimitStateRequest) (*QueryAllPriceLimitStateResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) PriceLimit(ctx context.Context, req *QueryGetPriceLimitRequest) (*QueryGetPriceLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceLimit not implemented")
+}
+func (*UnimplementedQueryServer) PriceLimitAll(ctx context.Context, req *QueryAllPriceLimitRequest) (*QueryAllPriceLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceLimitAll not implemented")
+}
+func (*UnimplementedQueryServer) PriceLimitState(ctx context.Context, req *QueryGetPriceLimitStateRequest) (*QueryGetPriceLimitStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceLimitState not implemented")
+}
+func (*UnimplementedQueryServer) PriceLimitStateAll(ctx context.Context, req *QueryAllPriceLimitStateRequest) (*QueryAllPriceLimitStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceLimitStateAll not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollinky.pricelimit.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPriceLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollinky.pricelimit.Query/PriceLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceLimit(ctx, req.(*QueryGetPriceLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceLimitAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPriceLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceLimitAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollinky.pricelimit.Query/PriceLimitAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceLimitAll(ctx, req.(*QueryAllPriceLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceLimitState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPriceLimitStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceLimitState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollinky.pricelimit.Query/PriceLimitState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceLimitState(ctx, req.(*QueryGetPriceLimitStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceLimitStateAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPriceLimitStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceLimitStateAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollinky.pricelimit.Query/PriceLimitStateAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceLimitStateAll(ctx, req.(*QueryAllPriceLimitStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rollinky.pricelimit.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PriceLimit",
+			Handler:    _Query_PriceLimit_Handler,
+		},
+		{
+			MethodName: "PriceLimitAll",
+			Handler:    _Query_PriceLimitAll_Handler,
+		},
+		{
+			MethodName: "PriceLimitState",
+			Handler:    _Query_PriceLimitState_Handler,
+		},
+		{
+			MethodName: "PriceLimitStateAll",
+			Handler:    _Query_PriceLimitStateAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rollinky/pricelimit/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPriceLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPriceLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPriceLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPriceLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPriceLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPriceLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PriceLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPriceLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPriceLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPriceLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPriceLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPriceLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPriceLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PriceLimit) > 0 {
+		for iNdEx := len(m.PriceLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPriceLimitStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPriceLimitStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPriceLimitStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPriceLimitStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPriceLimitStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPriceLimitStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PriceLimitState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPriceLimitStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPriceLimitStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPriceLimitStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPriceLimitStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPriceLimitStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPriceLimitStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PriceLimitState) > 0 {
+		for iNdEx := len(m.PriceLimitState) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceLimitState[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPriceLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPriceLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PriceLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPriceLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPriceLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceLimit) > 0 {
+		for _, e := range m.PriceLimit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPriceLimitStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPriceLimitStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PriceLimitState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPriceLimitStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPriceLimitStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceLimitState) > 0 {
+		for _, e := range m.PriceLimitState {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPriceLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPriceLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPriceLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPriceLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPriceLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPriceLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPriceLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPriceLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPriceLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPriceLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPriceLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPriceLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceLimit = append(m.PriceLimit, PriceLimit{})
+			if err := m.PriceLimit[len(m.PriceLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPriceLimitStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPriceLimitStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPriceLimitStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPriceLimitStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPriceLimitStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPriceLimitStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceLimitState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceLimitState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPriceLimitStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPriceLimitStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPriceLimitStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPriceLimitStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPriceLimitStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPriceLimitStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceLimitState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceLimitState = append(m.PriceLimitState, PriceLimitState{})
+			if err := m.PriceLimitState[len(m.PriceLimitState)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/twap/types"
+)
+
+// SetAccumulator set a specific accumulator in the store from its index
+func (k Keeper) SetAccumulator(ctx context.Context, accumulator types.Accumulator) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AccumulatorKeyPrefix))
+	b := k.cdc.MustMarshal(&accumulator)
+	store.Set(types.AccumulatorKey(
+		accumulator.CurrencyPair,
+		accumulator.BlockTime,
+	), b)
+}
+
+// GetAccumulator returns an accumulator from its index
+func (k Keeper) GetAccumulator(
+	ctx context.Context,
+	currencyPair string,
+	blockTime time.Time,
+) (val types.Accumulator, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AccumulatorKeyPrefix))
+
+	b := store.Get(types.AccumulatorKey(
+		currencyPair,
+		blockTime,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAccumulator removes an accumulator from the store
+func (k Keeper) RemoveAccumulator(
+	ctx context.Context,
+	currencyPair string,
+	blockTime time.Time,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AccumulatorKeyPrefix))
+	store.Delete(types.AccumulatorKey(
+		currencyPair,
+		blockTime,
+	))
+}
+
+// GetAllAccumulator returns all accumulator
+func (k Keeper) GetAllAccumulator(ctx context.Context) (list []types.Accumulator) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AccumulatorKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Accumulator
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAccumulatorAtTime returns the last accumulator of a currency pair at or
+// before a time
+func (k Keeper) GetAccumulatorAtTime(
+	ctx context.Context,
+	currencyPair string,
+	blockTime time.Time,
+) (val types.Accumulator, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AccumulatorKeyPrefix))
+
+	iterator := store.ReverseIterator(
+		types.AccumulatorPairKey(currencyPair),
+		storetypes.PrefixEndBytes(types.AccumulatorKey(currencyPair, blockTime)),
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(iterator.Value(), &val)
+	return val, true
+}
+
+// pruneAccumulators removes the accumulators of a currency pair that no
+// window starting at or after cutoff needs: all but the last one at or before
+// it.
+func (k Keeper) pruneAccumulators(ctx context.Context, currencyPair string, cutoff time.Time) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AccumulatorKeyPrefix))
+	iterator := store.Iterator(
+		types.AccumulatorPairKey(currencyPair),
+		storetypes.PrefixEndBytes(types.AccumulatorKey(currencyPair, cutoff)),
+	)
+
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expired = append(expired, iterator.Key())
+	}
+	iterator.Close()
+
+	if len(expired) == 0 {
+		return
+	}
+	for _, key := range expired[:len(expired)-1] {
+		store.Delete(key)
+	}
+}
+
+// blockTime returns the time of the current block.
+func blockTime(ctx context.Context) time.Time {
+	return sdk.UnwrapSDKContext(ctx).BlockTime()
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/testutil/nullify"
+	"rollinky/x/twap/keeper"
+	"rollinky/x/twap/types"
+)
+
+// genesisTime is the time of the first test block.
+var genesisTime = time.Unix(1700000000, 0).UTC()
+
+func createNAccumulator(keeper keeper.Keeper, ctx context.Context, n int) []types.Accumulator {
+	items := make([]types.Accumulator, n)
+	for i := range items {
+		items[i].CurrencyPair = "BTC/USD"
+		items[i].BlockTime = genesisTime.Add(time.Duration(i) * time.Second)
+		items[i].BlockHeight = uint64(i + 1)
+		items[i].Price = math.NewInt(int64(100 + i))
+		items[i].CumulativePrice = math.NewInt(int64(1000 * i))
+		keeper.SetAccumulator(ctx, items[i])
+	}
+	return items
+}
+
+func TestAccumulatorGet(t *testing.T) {
+	keeper, ctx := keepertest.TwapKeeper(t)
+	items := createNAccumulator(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetAccumulator(ctx,
+			item.CurrencyPair,
+			item.BlockTime,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestAccumulatorRemove(t *testing.T) {
+	keeper, ctx := keepertest.TwapKeeper(t)
+	items := createNAccumulator(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveAccumulator(ctx,
+			item.CurrencyPair,
+			item.BlockTime,
+		)
+		_, found := keeper.GetAccumulator(ctx,
+			item.CurrencyPair,
+			item.BlockTime,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestAccumulatorGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TwapKeeper(t)
+	items := createNAccumulator(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllAccumulator(ctx)),
+	)
+}
+
+func TestGetAccumulatorAtTime(t *testing.T) {
+	keeper, ctx := keepertest.TwapKeeper(t)
+	items := createNAccumulator(keeper, ctx, 10)
+
+	rst, found := keeper.GetAccumulatorAtTime(ctx, "BTC/USD", genesisTime.Add(4500*time.Millisecond))
+	require.True(t, found)
+	require.Equal(t, nullify.Fill(&items[4]), nullify.Fill(&rst))
+
+	rst, found = keeper.GetAccumulatorAtTime(ctx, "BTC/USD", genesisTime.Add(5*time.Second))
+	require.True(t, found)
+	require.Equal(t, nullify.Fill(&items[5]), nullify.Fill(&rst))
+
+	_, found = keeper.GetAccumulatorAtTime(ctx, "BTC/USD", genesisTime.Add(-time.Second))
+	require.False(t, found)
+	_, found = keeper.GetAccumulatorAtTime(ctx, "BTC/USDT", genesisTime.Add(time.Hour))
+	require.False(t, found)
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"rollinky/x/twap/types"
+)
+
+// SetEMA set a specific ema in the store from its index
+func (k Keeper) SetEMA(ctx context.Context, ema types.EMA) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EMAKeyPrefix))
+	b := k.cdc.MustMarshal(&ema)
+	store.Set(types.EMAKey(
+		ema.CurrencyPair,
+		ema.Period,
+	), b)
+}
+
+// GetEMA returns an ema from its index
+func (k Keeper) GetEMA(
+	ctx context.Context,
+	currencyPair string,
+	period time.Duration,
+) (val types.EMA, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EMAKeyPrefix))
+
+	b := store.Get(types.EMAKey(
+		currencyPair,
+		period,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveEMA removes an ema from the store
+func (k Keeper) RemoveEMA(
+	ctx context.Context,
+	currencyPair string,
+	period time.Duration,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EMAKeyPrefix))
+	store.Delete(types.EMAKey(
+		currencyPair,
+		period,
+	))
+}
+
+// GetAllEMA returns all ema
+func (k Keeper) GetAllEMA(ctx context.Context) (list []types.EMA) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EMAKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.EMA
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
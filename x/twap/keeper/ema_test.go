@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/testutil/nullify"
+	"rollinky/x/twap/keeper"
+	"rollinky/x/twap/types"
+)
+
+func createNEMA(keeper keeper.Keeper, ctx context.Context, n int) []types.EMA {
+	items := make([]types.EMA, n)
+	for i := range items {
+		items[i].CurrencyPair = "BTC/USD"
+		items[i].Period = time.Duration(i+1) * time.Minute
+		items[i].Value = math.LegacyNewDec(int64(100 + i))
+		items[i].BlockTime = genesisTime
+		keeper.SetEMA(ctx, items[i])
+	}
+	return items
+}
+
+func TestEMAGet(t *testing.T) {
+	keeper, ctx := keepertest.TwapKeeper(t)
+	items := createNEMA(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetEMA(ctx,
+			item.CurrencyPair,
+			item.Period,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestEMARemove(t *testing.T) {
+	keeper, ctx := keepertest.TwapKeeper(t)
+	items := createNEMA(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveEMA(ctx,
+			item.CurrencyPair,
+			item.Period,
+		)
+		_, found := keeper.GetEMA(ctx,
+			item.CurrencyPair,
+			item.Period,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestEMAGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TwapKeeper(t)
+	items := createNEMA(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllEMA(ctx)),
+	)
+}
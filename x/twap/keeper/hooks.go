@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+
+	attestationtypes "rollinky/x/attestation/types"
+)
+
+var _ attestationtypes.OraclePriceHooks = Hooks{}
+
+// Hooks averages in the oracle prices written by the PreBlocker.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the oracle price hooks of the keeper.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterPricesUpdated averages in every written price.
+func (h Hooks) AfterPricesUpdated(ctx context.Context, prices map[connecttypes.CurrencyPair]oracletypes.QuotePrice) error {
+	for _, cp := range attestationtypes.SortedCurrencyPairs(prices) {
+		h.k.RecordPrice(ctx, cp.String(), prices[cp].Price)
+	}
+
+	return nil
+}
+
+// AfterPriceMissed does nothing, the last price lasts until the next one.
+func (h Hooks) AfterPriceMissed(context.Context, connecttypes.CurrencyPair) error {
+	return nil
+}
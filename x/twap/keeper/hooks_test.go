@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+)
+
+func TestHooksAfterPricesUpdated(t *testing.T) {
+	k, ctx := keepertest.TwapKeeper(t)
+	ctx = ctx.WithBlockTime(genesisTime)
+	require.NoError(t, k.Hooks().AfterPricesUpdated(ctx, map[connecttypes.CurrencyPair]oracletypes.QuotePrice{
+		connecttypes.NewCurrencyPair("BTC", "USD"): {Price: math.NewInt(100), BlockTimestamp: genesisTime},
+	}))
+	require.NoError(t, k.Hooks().AfterPriceMissed(ctx, connecttypes.NewCurrencyPair("SOL", "USD")))
+
+	price, err := k.GetEMAPrice(ctx, "BTC/USD", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), price)
+	_, found := k.GetAccumulator(ctx, "BTC/USD", genesisTime)
+	require.True(t, found)
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/x/twap/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	params := types.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, params))
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	update := func(authority string, params types.Params) error {
+		_, err := ms.UpdateParams(wctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+		return err
+	}
+	get := func() types.Params { return k.GetParams(wctx) }
+
+	keepertest.CheckMsgUpdateParams(t, k.GetAuthority(), update, get, []keepertest.UpdateParamsCase[types.Params]{
+		{
+			Name:      "zero twap window",
+			Params:    types.NewParams([]time.Duration{0}, params.EmaPeriods),
+			ExpErrMsg: "invalid twap windows",
+		},
+		{
+			Name:      "sub millisecond twap window",
+			Params:    types.NewParams([]time.Duration{time.Minute + time.Microsecond}, params.EmaPeriods),
+			ExpErrMsg: "invalid twap windows",
+		},
+		{
+			Name:      "duplicated twap window",
+			Params:    types.NewParams([]time.Duration{time.Minute, time.Minute}, params.EmaPeriods),
+			ExpErrMsg: "duplicated duration",
+		},
+		{
+			Name:      "negative ema period",
+			Params:    types.NewParams(params.TwapWindows, []time.Duration{-time.Minute}),
+			ExpErrMsg: "invalid ema periods",
+		},
+		{
+			Name:      "duplicated ema period",
+			Params:    types.NewParams(params.TwapWindows, []time.Duration{time.Hour, time.Hour}),
+			ExpErrMsg: "duplicated duration",
+		},
+		{
+			Name:   "other windows",
+			Params: types.NewParams([]time.Duration{time.Minute}, []time.Duration{time.Minute, time.Hour}),
+		},
+		{
+			Name:   "all good",
+			Params: params,
+		},
+	})
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/twap/types"
+)
+
+// RecordPrice adds the price of a currency pair written in the current block
+// to its averages. It adds an accumulator for the block, prunes the
+// accumulators the longest TWAP window no longer needs and moves the EMA of
+// every period.
+func (k Keeper) RecordPrice(ctx context.Context, currencyPair string, price math.Int) {
+	params := k.GetParams(ctx)
+	now := blockTime(ctx)
+
+	accumulator := types.Accumulator{
+		CurrencyPair:    currencyPair,
+		BlockTime:       now,
+		BlockHeight:     uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()), //nolint:gosec
+		Price:           price,
+		CumulativePrice: math.ZeroInt(),
+	}
+	if last, found := k.GetAccumulatorAtTime(ctx, currencyPair, now); found {
+		accumulator.CumulativePrice = last.CumulativePriceAt(now)
+	}
+	k.SetAccumulator(ctx, accumulator)
+	k.pruneAccumulators(ctx, currencyPair, now.Add(-params.MaxTwapWindow()))
+
+	for _, period := range params.EmaPeriods {
+		ema, found := k.GetEMA(ctx, currencyPair, period)
+		if !found {
+			ema = types.EMA{
+				CurrencyPair: currencyPair,
+				Period:       period,
+				Value:        math.LegacyNewDecFromInt(price),
+			}
+		} else {
+			ema.Value = types.NextEMA(ema.Value, price, now.Sub(ema.BlockTime), period)
+		}
+		ema.BlockTime = now
+		k.SetEMA(ctx, ema)
+	}
+}
+
+// GetTWAP returns the time-weighted average price of a currency pair over a
+// window ending at the current block. The window must be one of the TWAP
+// windows, and the pair must have had a price since before the window started.
+func (k Keeper) GetTWAP(ctx context.Context, currencyPair string, window time.Duration) (math.Int, error) {
+	if !k.GetParams(ctx).HasTwapWindow(window) {
+		return math.Int{}, errorsmod.Wrapf(types.ErrWindowNotConfigured, "%s", window)
+	}
+
+	end := blockTime(ctx)
+	start := end.Add(-window)
+
+	endAccumulator, found := k.GetAccumulatorAtTime(ctx, currencyPair, end)
+	if !found {
+		return math.Int{}, errorsmod.Wrap(types.ErrNoPrice, currencyPair)
+	}
+	startAccumulator, found := k.GetAccumulatorAtTime(ctx, currencyPair, start)
+	if !found {
+		return math.Int{}, errorsmod.Wrapf(types.ErrInsufficientHistory, "%s over %s", currencyPair, window)
+	}
+
+	return types.TWAP(startAccumulator, start, endAccumulator, end), nil
+}
+
+// GetEMAPrice returns the exponential moving average of a currency pair over
+// a period, truncated to an integer price. The period must be one of the EMA
+// periods.
+func (k Keeper) GetEMAPrice(ctx context.Context, currencyPair string, period time.Duration) (math.Int, error) {
+	if !k.GetParams(ctx).HasEmaPeriod(period) {
+		return math.Int{}, errorsmod.Wrapf(types.ErrPeriodNotConfigured, "%s", period)
+	}
+
+	ema, found := k.GetEMA(ctx, currencyPair, period)
+	if !found {
+		return math.Int{}, errorsmod.Wrap(types.ErrNoPrice, currencyPair)
+	}
+
+	return ema.Value.TruncateInt(), nil
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/x/twap/types"
+)
+
+func TestRecordPrice(t *testing.T) {
+	k, ctx := keepertest.TwapKeeper(t)
+	at := func(height int64, elapsed time.Duration) sdk.Context {
+		return ctx.WithBlockHeight(height).WithBlockTime(genesisTime.Add(elapsed))
+	}
+
+	// 100 lasts one minute, then 200 lasts five.
+	k.RecordPrice(at(1, 0), "BTC/USD", math.NewInt(100))
+	k.RecordPrice(at(2, time.Minute), "BTC/USD", math.NewInt(200))
+	k.RecordPrice(at(3, 6*time.Minute), "BTC/USD", math.NewInt(300))
+
+	// the price of the current block doesn't weigh in yet.
+	ctx = at(3, 6*time.Minute)
+	twap, err := k.GetTWAP(ctx, "BTC/USD", 5*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(200), twap)
+
+	// a minute later, 300 weighs one fifth of the window.
+	ctx = at(4, 7*time.Minute)
+	twap, err = k.GetTWAP(ctx, "BTC/USD", 5*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(220), twap)
+
+	_, err = k.GetTWAP(ctx, "BTC/USD", time.Hour)
+	require.ErrorIs(t, err, types.ErrInsufficientHistory)
+	_, err = k.GetTWAP(ctx, "BTC/USD", 2*time.Minute)
+	require.ErrorIs(t, err, types.ErrWindowNotConfigured)
+	_, err = k.GetTWAP(ctx, "ETH/USD", 5*time.Minute)
+	require.ErrorIs(t, err, types.ErrNoPrice)
+
+	// 200 moved the 5 minute average a fifth of the way, 300 came a whole
+	// period later and replaced it.
+	ema, found := k.GetEMA(ctx, "BTC/USD", 5*time.Minute)
+	require.True(t, found)
+	require.Equal(t, math.LegacyNewDec(300), ema.Value)
+	require.Equal(t, genesisTime.Add(6*time.Minute), ema.BlockTime)
+
+	price, err := k.GetEMAPrice(ctx, "BTC/USD", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(118), price)
+
+	_, err = k.GetEMAPrice(ctx, "BTC/USD", 2*time.Minute)
+	require.ErrorIs(t, err, types.ErrPeriodNotConfigured)
+	_, err = k.GetEMAPrice(ctx, "ETH/USD", time.Hour)
+	require.ErrorIs(t, err, types.ErrNoPrice)
+
+	// only the last accumulator before the longest window is kept.
+	k.RecordPrice(at(5, 2*time.Hour), "BTC/USD", math.NewInt(400))
+	accumulators := k.GetAllAccumulator(ctx)
+	require.Len(t, accumulators, 2)
+	require.Equal(t, genesisTime.Add(6*time.Minute), accumulators[0].BlockTime)
+	require.Equal(t, math.NewInt(66_000_000), accumulators[0].CumulativePrice)
+	require.Equal(t, math.NewInt(66_000_000+300*(2*time.Hour-6*time.Minute).Milliseconds()), accumulators[1].CumulativePrice)
+
+	twap, err = k.GetTWAP(at(5, 2*time.Hour), "BTC/USD", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(300), twap)
+}
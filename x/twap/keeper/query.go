@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"rollinky/x/twap/types"
+)
+
+var _ types.QueryServer = Keeper{}
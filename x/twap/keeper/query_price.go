@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rollinky/x/twap/types"
+)
+
+func (k Keeper) TWAP(ctx context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	price, err := k.GetTWAP(ctx, req.CurrencyPair, req.Window)
+	if err != nil {
+		return nil, queryError(err)
+	}
+
+	end := blockTime(ctx)
+	return &types.QueryTWAPResponse{
+		Price:     price,
+		StartTime: end.Add(-req.Window),
+		EndTime:   end,
+	}, nil
+}
+
+func (k Keeper) EMA(ctx context.Context, req *types.QueryEMARequest) (*types.QueryEMAResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !k.GetParams(ctx).HasEmaPeriod(req.Period) {
+		return nil, queryError(types.ErrPeriodNotConfigured)
+	}
+
+	val, found := k.GetEMA(ctx, req.CurrencyPair, req.Period)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryEMAResponse{Ema: val}, nil
+}
+
+// queryError maps the errors of the keeper API to gRPC status errors.
+func queryError(err error) error {
+	switch {
+	case errors.Is(err, types.ErrWindowNotConfigured), errors.Is(err, types.ErrPeriodNotConfigured):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, types.ErrNoPrice), errors.Is(err, types.ErrInsufficientHistory):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
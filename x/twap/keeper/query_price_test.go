@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/x/twap/types"
+)
+
+func TestTWAPQuery(t *testing.T) {
+	keeper, ctx := keepertest.TwapKeeper(t)
+	keeper.RecordPrice(ctx.WithBlockTime(genesisTime), "BTC/USD", math.NewInt(100))
+	ctx = ctx.WithBlockTime(genesisTime.Add(time.Hour))
+
+	response, err := keeper.TWAP(ctx, &types.QueryTWAPRequest{CurrencyPair: "BTC/USD", Window: 5 * time.Minute})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryTWAPResponse{
+		Price:     math.NewInt(100),
+		StartTime: genesisTime.Add(55 * time.Minute),
+		EndTime:   genesisTime.Add(time.Hour),
+	}, response)
+
+	_, err = keeper.TWAP(ctx, &types.QueryTWAPRequest{CurrencyPair: "BTC/USD", Window: time.Minute})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = keeper.TWAP(ctx, &types.QueryTWAPRequest{CurrencyPair: "ETH/USD", Window: 5 * time.Minute})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = keeper.TWAP(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestEMAQuery(t *testing.T) {
+	keeper, ctx := keepertest.TwapKeeper(t)
+	keeper.RecordPrice(ctx.WithBlockTime(genesisTime), "BTC/USD", math.NewInt(100))
+
+	response, err := keeper.EMA(ctx, &types.QueryEMARequest{CurrencyPair: "BTC/USD", Period: time.Hour})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryEMAResponse{Ema: types.EMA{
+		CurrencyPair: "BTC/USD",
+		Period:       time.Hour,
+		Value:        math.LegacyNewDec(100),
+		BlockTime:    genesisTime,
+	}}, response)
+
+	_, err = keeper.EMA(ctx, &types.QueryEMARequest{CurrencyPair: "BTC/USD", Period: time.Minute})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = keeper.EMA(ctx, &types.QueryEMARequest{CurrencyPair: "ETH/USD", Period: time.Hour})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+	_, err = keeper.EMA(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -0,0 +1,47 @@
+package twap
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "rollinky/api/rollinky/twap"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod:      "TWAP",
+					Use:            "twap [currency-pair] [window]",
+					Short:          "Shows the time-weighted average price of a currency pair over a window, e.g. 1h",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "currency_pair"}, {ProtoField: "window"}},
+				},
+				{
+					RpcMethod:      "EMA",
+					Use:            "ema [currency-pair] [period]",
+					Short:          "Shows the exponential moving average of a currency pair over a period, e.g. 1h",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "currency_pair"}, {ProtoField: "period"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package twap
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rollinky/x/twap/keeper"
+	"rollinky/x/twap/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the accumulator
+	for _, elem := range genState.AccumulatorList {
+		k.SetAccumulator(ctx, elem)
+	}
+	// Set all the ema
+	for _, elem := range genState.EmaList {
+		k.SetEMA(ctx, elem)
+	}
+	// this line is used by starport scaffolding # genesis/module/init
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	genesis.AccumulatorList = k.GetAllAccumulator(ctx)
+	genesis.EmaList = k.GetAllEMA(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
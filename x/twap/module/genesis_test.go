@@ -0,0 +1,52 @@
+package twap_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	keepertest "rollinky/testutil/keeper"
+	"rollinky/testutil/nullify"
+	twap "rollinky/x/twap/module"
+	"rollinky/x/twap/types"
+)
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+
+		AccumulatorList: []types.Accumulator{
+			{
+				CurrencyPair: "BTC/USD",
+				BlockTime:    time.Unix(1700000000, 0).UTC(),
+			},
+			{
+				CurrencyPair: "BTC/USD",
+				BlockTime:    time.Unix(1700000001, 0).UTC(),
+			},
+		},
+		EmaList: []types.EMA{
+			{
+				CurrencyPair: "BTC/USD",
+				Period:       time.Minute,
+			},
+			{
+				CurrencyPair: "BTC/USD",
+				Period:       time.Hour,
+			},
+		},
+		// this line is used by starport scaffolding # genesis/test/state
+	}
+
+	k, ctx := keepertest.TwapKeeper(t)
+	twap.InitGenesis(ctx, k, genesisState)
+	got := twap.ExportGenesis(ctx, k)
+	require.NotNil(t, got)
+
+	nullify.Fill(&genesisState)
+	nullify.Fill(got)
+
+	require.ElementsMatch(t, genesisState.AccumulatorList, got.AccumulatorList)
+	require.ElementsMatch(t, genesisState.EmaList, got.EmaList)
+	// this line is used by starport scaffolding # genesis/test/assert
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// CumulativePriceAt returns the cumulative price at t, assuming the price of
+// the accumulator lasts until then. t must not be before the accumulator.
+func (a Accumulator) CumulativePriceAt(t time.Time) math.Int {
+	elapsed := math.NewInt(t.Sub(a.BlockTime).Milliseconds())
+	return a.CumulativePrice.Add(a.Price.Mul(elapsed))
+}
+
+// TWAP returns the time-weighted average price between the cumulative prices
+// of two accumulators, at start and end.
+func TWAP(start Accumulator, startTime time.Time, end Accumulator, endTime time.Time) math.Int {
+	elapsed := math.NewInt(endTime.Sub(startTime).Milliseconds())
+	return end.CumulativePriceAt(endTime).Sub(start.CumulativePriceAt(startTime)).Quo(elapsed)
+}
+
+// NextEMA returns the moving average value after averaging in price, which
+// came elapsed after the previous price. The price moves the average by the
+// fraction of the period that elapsed, a price a whole period or more after
+// the previous one replaces it.
+func NextEMA(value math.LegacyDec, price math.Int, elapsed, period time.Duration) math.LegacyDec {
+	priceDec := math.LegacyNewDecFromInt(price)
+	if elapsed >= period {
+		return priceDec
+	}
+	if elapsed <= 0 {
+		return value
+	}
+
+	weight := math.LegacyNewDec(elapsed.Milliseconds()).QuoInt64(period.Milliseconds())
+	return value.Add(priceDec.Sub(value).Mul(weight))
+}
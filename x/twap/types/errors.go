@@ -0,0 +1,16 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/twap module sentinel errors
+var (
+	ErrInvalidSigner       = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrWindowNotConfigured = sdkerrors.Register(ModuleName, 1101, "window is not a configured twap window")
+	ErrPeriodNotConfigured = sdkerrors.Register(ModuleName, 1102, "period is not a configured ema period")
+	ErrNoPrice             = sdkerrors.Register(ModuleName, 1103, "no price for currency pair")
+	ErrInsufficientHistory = sdkerrors.Register(ModuleName, 1104, "price history does not cover the window")
+)
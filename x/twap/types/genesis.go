@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+	// this line is used by starport scaffolding # genesis/types/import
+)
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		AccumulatorList: []Accumulator{},
+		EmaList:         []EMA{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in accumulator
+	accumulatorIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.AccumulatorList {
+		index := string(AccumulatorKey(elem.CurrencyPair, elem.BlockTime))
+		if _, ok := accumulatorIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for accumulator")
+		}
+		accumulatorIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in ema
+	emaIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.EmaList {
+		index := string(EMAKey(elem.CurrencyPair, elem.Period))
+		if _, ok := emaIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for ema")
+		}
+		emaIndexMap[index] = struct{}{}
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,108 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"rollinky/x/twap/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccumulatorList: []types.Accumulator{
+					{
+						CurrencyPair: "BTC/USD",
+						BlockTime:    time.Unix(1, 0),
+					},
+					{
+						CurrencyPair: "BTC/USD",
+						BlockTime:    time.Unix(2, 0),
+					},
+				},
+				EmaList: []types.EMA{
+					{
+						CurrencyPair: "BTC/USD",
+						Period:       time.Minute,
+					},
+					{
+						CurrencyPair: "ETH/USD",
+						Period:       time.Minute,
+					},
+				},
+				// this line is used by starport scaffolding # types/genesis/validField
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated accumulator",
+			genState: &types.GenesisState{
+				AccumulatorList: []types.Accumulator{
+					{
+						CurrencyPair: "BTC/USD",
+						BlockTime:    time.Unix(1, 0),
+					},
+					{
+						CurrencyPair: "BTC/USD",
+						BlockTime:    time.Unix(1, 0),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated ema",
+			genState: &types.GenesisState{
+				EmaList: []types.EMA{
+					{
+						CurrencyPair: "BTC/USD",
+						Period:       time.Minute,
+					},
+					{
+						CurrencyPair: "BTC/USD",
+						Period:       time.Minute,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated twap window",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]time.Duration{time.Hour, time.Hour}, nil),
+			},
+			valid: false,
+		},
+		{
+			desc: "sub-millisecond ema period",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, []time.Duration{time.Microsecond}),
+			},
+			valid: false,
+		},
+		// this line is used by starport scaffolding # types/genesis/testcase
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
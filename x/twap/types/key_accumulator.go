@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// AccumulatorKeyPrefix is the prefix to retrieve all Accumulator
+	AccumulatorKeyPrefix = "Accumulator/value/"
+	// EMAKeyPrefix is the prefix to retrieve all EMA
+	EMAKeyPrefix = "EMA/value/"
+)
+
+// AccumulatorPairKey returns the key prefix of all the Accumulator of a
+// currency pair
+func AccumulatorPairKey(
+	currencyPair string,
+) []byte {
+	var key []byte
+
+	currencyPairBytes := []byte(currencyPair)
+	key = append(key, currencyPairBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// AccumulatorKey returns the store key to retrieve an Accumulator from the
+// index fields. Times are formatted with a fixed width, so the accumulators of
+// a pair are iterated in time order.
+func AccumulatorKey(
+	currencyPair string,
+	blockTime time.Time,
+) []byte {
+	return append(AccumulatorPairKey(currencyPair), sdk.FormatTimeBytes(blockTime)...)
+}
+
+// EMAKey returns the store key to retrieve an EMA from the index fields
+func EMAKey(
+	currencyPair string,
+	period time.Duration,
+) []byte {
+	key := AccumulatorPairKey(currencyPair)
+	return binary.BigEndian.AppendUint64(key, uint64(period)) //nolint:gosec
+}
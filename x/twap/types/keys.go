@@ -0,0 +1,20 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "twap"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_twap"
+)
+
+var (
+	ParamsKey = []byte("p_twap")
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyTwapWindows = []byte("TwapWindows")
+	// DefaultTwapWindows are the default TWAP windows.
+	DefaultTwapWindows = []time.Duration{5 * time.Minute, time.Hour}
+)
+
+var (
+	KeyEmaPeriods = []byte("EmaPeriods")
+	// DefaultEmaPeriods are the default EMA periods.
+	DefaultEmaPeriods = []time.Duration{5 * time.Minute, time.Hour}
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	twapWindows []time.Duration,
+	emaPeriods []time.Duration,
+) Params {
+	return Params{
+		TwapWindows: twapWindows,
+		EmaPeriods:  emaPeriods,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultTwapWindows,
+		DefaultEmaPeriods,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyTwapWindows, &p.TwapWindows, validateDurations),
+		paramtypes.NewParamSetPair(KeyEmaPeriods, &p.EmaPeriods, validateDurations),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateDurations(p.TwapWindows); err != nil {
+		return fmt.Errorf("invalid twap windows: %w", err)
+	}
+
+	if err := validateDurations(p.EmaPeriods); err != nil {
+		return fmt.Errorf("invalid ema periods: %w", err)
+	}
+
+	return nil
+}
+
+// HasTwapWindow reports whether window is one of the TWAP windows.
+func (p Params) HasTwapWindow(window time.Duration) bool {
+	return containsDuration(p.TwapWindows, window)
+}
+
+// HasEmaPeriod reports whether period is one of the EMA periods.
+func (p Params) HasEmaPeriod(period time.Duration) bool {
+	return containsDuration(p.EmaPeriods, period)
+}
+
+// MaxTwapWindow returns the longest TWAP window, zero if there is none.
+func (p Params) MaxTwapWindow() time.Duration {
+	var longest time.Duration
+	for _, window := range p.TwapWindows {
+		longest = max(longest, window)
+	}
+
+	return longest
+}
+
+// validateDurations validates a list of windows or periods: they must be
+// positive, whole milliseconds and unique.
+func validateDurations(v interface{}) error {
+	durations, ok := v.([]time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[time.Duration]struct{}, len(durations))
+	for _, d := range durations {
+		if d < time.Millisecond || d%time.Millisecond != 0 {
+			return fmt.Errorf("duration must be a positive number of milliseconds: %s", d)
+		}
+		if _, ok := seen[d]; ok {
+			return fmt.Errorf("duplicated duration: %s", d)
+		}
+		seen[d] = struct{}{}
+	}
+
+	return nil
+}
+
+func containsDuration(durations []time.Duration, d time.Duration) bool {
+	for _, candidate := range durations {
+		if candidate == d {
+			return true
+		}
+	}
+
+	return false
+}